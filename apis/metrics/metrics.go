@@ -39,6 +39,18 @@ var (
 
 	// EngineVersion records the version and commit information of the engine process.
 	EngineVersion = metrics.NewLabelGauge(subsystemPouch, "engine", "The version and commit information of the engine process", "commit", "version", "kernel")
+
+	// ContainerDiskQuotaBytes records the used bytes and limits of disk quotas set on containers.
+	ContainerDiskQuotaBytes = metrics.NewLabelGauge(subsystemPouch, "container_disk_quota_bytes", "The used bytes and limits of disk quotas set on containers", "container", "quota_id", "device", "type")
+
+	// ContainerDiskQuotaInodes records the used inodes and limits of disk quotas set on containers.
+	ContainerDiskQuotaInodes = metrics.NewLabelGauge(subsystemPouch, "container_disk_quota_inodes", "The used inodes and limits of disk quotas set on containers", "container", "quota_id", "device", "type")
+
+	// ImageGCRemovedCounter records the number of images removed by image gc.
+	ImageGCRemovedCounter = metrics.NewLabelCounter(subsystemPouch, "image_gc_removed_counter", "The number of images removed by image gc", "result")
+
+	// ImageGCReclaimedBytes records the bytes of images removed by image gc.
+	ImageGCReclaimedBytes = metrics.NewLabelCounter(subsystemPouch, "image_gc_reclaimed_bytes", "The bytes of images removed by image gc")
 )
 
 var registerMetrics sync.Once
@@ -57,5 +69,9 @@ func Register() {
 		registry.MustRegister(ImageSuccessActionsCounter)
 		registry.MustRegister(ContainerActionsTimer)
 		registry.MustRegister(ImageActionsTimer)
+		registry.MustRegister(ContainerDiskQuotaBytes)
+		registry.MustRegister(ContainerDiskQuotaInodes)
+		registry.MustRegister(ImageGCRemovedCounter)
+		registry.MustRegister(ImageGCReclaimedBytes)
 	})
 }
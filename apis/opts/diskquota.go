@@ -5,6 +5,9 @@ import (
 	"strings"
 )
 
+// diskQuotaInodesPrefix is the prefix of disk quota which sets the inode limit.
+const diskQuotaInodesPrefix = "inodes="
+
 // ParseDiskQuota parses diskquota configurations of container.
 func ParseDiskQuota(quotas []string) (map[string]string, error) {
 	var quotaMaps = make(map[string]string)
@@ -36,6 +39,15 @@ func ValidateDiskQuota(quotaMaps map[string]string) error {
 
 // ParseQuotaID parses quota id configurations of container.
 func ParseQuotaID(id string, quotas []string) (string, error) {
+	// the inode limit is not a disk quota of dir.
+	var sizeQuotas []string
+	for _, quota := range quotas {
+		if !strings.HasPrefix(quota, diskQuotaInodesPrefix) {
+			sizeQuotas = append(sizeQuotas, quota)
+		}
+	}
+	quotas = sizeQuotas
+
 	switch len(quotas) {
 	case 0:
 		if isSetQuotaID(id) {
@@ -22,6 +22,7 @@ func TestParseDiskQuota(t *testing.T) {
 		{name: "test3", args: args{diskquota: []string{"foo"}}, want: map[string]string{".*": "foo"}, wantErr: false},
 		{name: "test4", args: args{diskquota: []string{"foo=foo"}}, want: map[string]string{"foo": "foo"}, wantErr: false},
 		{name: "test5", args: args{diskquota: []string{"foo=foo", "bar=bar"}}, want: map[string]string{"foo": "foo", "bar": "bar"}, wantErr: false},
+		{name: "test6", args: args{diskquota: []string{"foo", "inodes=1000"}}, want: map[string]string{".*": "foo", "inodes": "1000"}, wantErr: false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -56,6 +57,8 @@ func TestParseQuotaID(t *testing.T) {
 		{id: "1", quota: []string{}, expectID: "", expectErr: fmt.Errorf("invalid to set quota id(1) without disk-quota")},
 		{id: "1", quota: []string{"20G"}, expectID: "1", expectErr: nil},
 		{id: "1", quota: []string{"20G", "/abc=10G"}, expectID: "", expectErr: fmt.Errorf("invalid to set quota id(1) for multi disk-quota")},
+		{id: "", quota: []string{"20G", "inodes=1000"}, expectID: "-1", expectErr: nil},
+		{id: "1", quota: []string{"20G", "inodes=1000"}, expectID: "1", expectErr: nil},
 	}
 	for _, tt := range tests {
 		got, err := ParseQuotaID(tt.id, tt.quota)
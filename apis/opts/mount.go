@@ -0,0 +1,141 @@
+package opts
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	units "github.com/docker/go-units"
+)
+
+// ParseMounts parses the mount params of container, each of them is a comma
+// separated list of key=value pairs, such as:
+// type=volume,source=v1,target=/data,volume-driver=local,volume-opt=size=10g.
+func ParseMounts(mounts []string) ([]*types.Mount, error) {
+	var results []*types.Mount
+
+	for _, m := range mounts {
+		mount, err := parseMount(m)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, mount)
+	}
+
+	return results, nil
+}
+
+func parseMount(value string) (*types.Mount, error) {
+	fields, err := csv.NewReader(strings.NewReader(value)).Read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid mount %s: %v", value, err)
+	}
+
+	mount := &types.Mount{
+		// volume is the default mount type.
+		Type: types.MountTypeVolume,
+	}
+
+	volumeOptions := func() *types.MountVolumeOptions {
+		if mount.VolumeOptions == nil {
+			mount.VolumeOptions = &types.MountVolumeOptions{}
+		}
+		return mount.VolumeOptions
+	}
+
+	driverConfig := func() *types.MountVolumeOptionsDriverConfig {
+		if volumeOptions().DriverConfig == nil {
+			mount.VolumeOptions.DriverConfig = &types.MountVolumeOptionsDriverConfig{}
+		}
+		return mount.VolumeOptions.DriverConfig
+	}
+
+	tmpfsOptions := func() *types.MountTmpfsOptions {
+		if mount.TmpfsOptions == nil {
+			mount.TmpfsOptions = &types.MountTmpfsOptions{}
+		}
+		return mount.TmpfsOptions
+	}
+
+	for _, field := range fields {
+		parts := strings.SplitN(field, "=", 2)
+		key := strings.ToLower(parts[0])
+
+		if len(parts) == 1 {
+			switch key {
+			case "readonly", "ro":
+				mount.ReadOnly = true
+				continue
+			case "volume-nocopy":
+				volumeOptions().NoCopy = true
+				continue
+			}
+			return nil, fmt.Errorf("invalid field %s in mount %s, must be a key=value pair", field, value)
+		}
+
+		val := parts[1]
+		switch key {
+		case "type":
+			mount.Type = strings.ToLower(val)
+		case "source", "src":
+			mount.Source = val
+		case "target", "dst", "destination":
+			mount.Target = val
+		case "readonly", "ro":
+			if mount.ReadOnly, err = strconv.ParseBool(val); err != nil {
+				return nil, fmt.Errorf("invalid value %s for %s in mount %s", val, key, value)
+			}
+		case "bind-propagation":
+			mount.BindOptions = &types.MountBindOptions{Propagation: strings.ToLower(val)}
+		case "volume-driver":
+			driverConfig().Name = val
+		case "volume-label":
+			labelParts := strings.SplitN(val, "=", 2)
+			if volumeOptions().Labels == nil {
+				mount.VolumeOptions.Labels = map[string]string{}
+			}
+			mount.VolumeOptions.Labels[labelParts[0]] = strings.Join(labelParts[1:], "")
+		case "volume-nocopy":
+			if volumeOptions().NoCopy, err = strconv.ParseBool(val); err != nil {
+				return nil, fmt.Errorf("invalid value %s for %s in mount %s", val, key, value)
+			}
+		case "volume-subpath":
+			if err := ValidateSubpath(val); err != nil {
+				return nil, fmt.Errorf("invalid value %s for %s in mount %s: %v", val, key, value, err)
+			}
+			volumeOptions().Subpath = val
+		case "volume-opt":
+			optParts := strings.SplitN(val, "=", 2)
+			if len(optParts) != 2 {
+				return nil, fmt.Errorf("invalid value %s for %s in mount %s, must be a key=value pair", val, key, value)
+			}
+			if driverConfig().Options == nil {
+				mount.VolumeOptions.DriverConfig.Options = map[string]string{}
+			}
+			mount.VolumeOptions.DriverConfig.Options[optParts[0]] = optParts[1]
+		case "tmpfs-size":
+			size, err := units.RAMInBytes(val)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %s for %s in mount %s: %v", val, key, value, err)
+			}
+			tmpfsOptions().SizeBytes = size
+		case "tmpfs-mode":
+			mode, err := strconv.ParseUint(val, 8, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %s for %s in mount %s: %v", val, key, value, err)
+			}
+			tmpfsOptions().Mode = int64(mode)
+		default:
+			return nil, fmt.Errorf("unknown option %s in mount %s", key, value)
+		}
+	}
+
+	if mount.Target == "" {
+		return nil, fmt.Errorf("target is required in mount %s", value)
+	}
+
+	return mount, nil
+}
@@ -0,0 +1,99 @@
+package opts
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMounts(t *testing.T) {
+	for _, tc := range []struct {
+		mount    string
+		expected *types.Mount
+		err      bool
+	}{
+		{
+			mount: "type=bind,source=/home/admin,target=/data,readonly,bind-propagation=rshared",
+			expected: &types.Mount{
+				Type:        types.MountTypeBind,
+				Source:      "/home/admin",
+				Target:      "/data",
+				ReadOnly:    true,
+				BindOptions: &types.MountBindOptions{Propagation: "rshared"},
+			},
+		},
+		{
+			mount: "src=v1,dst=/data,volume-driver=local,volume-opt=size=10g,volume-label=app=web",
+			expected: &types.Mount{
+				Type:   types.MountTypeVolume,
+				Source: "v1",
+				Target: "/data",
+				VolumeOptions: &types.MountVolumeOptions{
+					Labels: map[string]string{"app": "web"},
+					DriverConfig: &types.MountVolumeOptionsDriverConfig{
+						Name:    "local",
+						Options: map[string]string{"size": "10g"},
+					},
+				},
+			},
+		},
+		{
+			mount: "source=v1,target=/data,volume-nocopy,volume-subpath=logs/app",
+			expected: &types.Mount{
+				Type:   types.MountTypeVolume,
+				Source: "v1",
+				Target: "/data",
+				VolumeOptions: &types.MountVolumeOptions{
+					NoCopy:  true,
+					Subpath: "logs/app",
+				},
+			},
+		},
+		{
+			mount: "source=v1,target=/data,volume-subpath=../app",
+			err:   true,
+		},
+		{
+			mount: "type=tmpfs,target=/tmp,tmpfs-size=64m,tmpfs-mode=1777,ro=false",
+			expected: &types.Mount{
+				Type:   types.MountTypeTmpfs,
+				Target: "/tmp",
+				TmpfsOptions: &types.MountTmpfsOptions{
+					SizeBytes: 64 * 1024 * 1024,
+					Mode:      01777,
+				},
+			},
+		},
+		{
+			mount: "type=bind,source=/home/admin",
+			err:   true,
+		},
+		{
+			mount: "type=volume,target=/data,volume-opt=size",
+			err:   true,
+		},
+		{
+			mount: "type=tmpfs,target=/tmp,tmpfs-mode=999",
+			err:   true,
+		},
+		{
+			mount: "type=bind,target=/data,unknown=value",
+			err:   true,
+		},
+		{
+			mount: "target=/data,exec",
+			err:   true,
+		},
+	} {
+		mounts, err := ParseMounts([]string{tc.mount})
+		if tc.err {
+			assert.Error(t, err, tc.mount)
+			continue
+		}
+		if assert.NoError(t, err, tc.mount) && assert.Len(t, mounts, 1) {
+			assert.Equal(t, tc.expected, mounts[0])
+		}
+	}
+}
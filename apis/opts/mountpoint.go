@@ -53,6 +53,9 @@ func ParseVolumesFrom(volume string) (string, string, error) {
 	return containerID, mode, nil
 }
 
+// subpathModePrefix is the prefix of bind mode to mount the subdirectory of volume.
+const subpathModePrefix = "subpath="
+
 // ParseBindMode is used to parse the bind's mode.
 func ParseBindMode(mp *types.MountPoint, mode string) error {
 	mp.RW = true
@@ -64,6 +67,7 @@ func ParseBindMode(mp *types.MountPoint, mode string) error {
 	replaceMode := 0
 	copyMode := 0
 	propagationMode := 0
+	subpathMode := 0
 
 	for _, m := range strings.Split(mode, ",") {
 		switch m {
@@ -88,14 +92,28 @@ func ParseBindMode(mp *types.MountPoint, mode string) error {
 			mp.Propagation = m
 			propagationMode++
 		default:
-			return fmt.Errorf("unknown bind mode: %s", mode)
+			if !strings.HasPrefix(m, subpathModePrefix) {
+				return fmt.Errorf("unknown bind mode: %s", mode)
+			}
+
+			// subpath mode, mount the subdirectory of volume.
+			mp.Subpath = strings.TrimPrefix(m, subpathModePrefix)
+			if err := ValidateSubpath(mp.Subpath); err != nil {
+				return err
+			}
+			subpathMode++
 		}
 	}
 
-	if defaultMode > 1 || rwMode > 1 || replaceMode > 1 || copyMode > 1 || propagationMode > 1 {
+	if defaultMode > 1 || rwMode > 1 || replaceMode > 1 || copyMode > 1 || propagationMode > 1 || subpathMode > 1 {
 		return fmt.Errorf("invalid bind mode: %s", mode)
 	}
 
+	// the replace mode changes the source of volume, so subpath can't be used.
+	if replaceMode > 0 && subpathMode > 0 {
+		return fmt.Errorf("invalid bind mode: %s, subpath can't be used with dr or rr", mode)
+	}
+
 	if mode != "" {
 		mp.Mode = mode
 	}
@@ -112,3 +130,21 @@ func CheckDuplicateMountPoint(mounts []*types.MountPoint, destination string) bo
 	}
 	return false
 }
+
+// ValidateSubpath validates the subpath of volume, it must be a relative path
+// which doesn't escape from the volume.
+func ValidateSubpath(subpath string) error {
+	if subpath == "" {
+		return fmt.Errorf("subpath can't be empty")
+	}
+	if filepath.IsAbs(subpath) {
+		return fmt.Errorf("subpath(%s) must be a relative path", subpath)
+	}
+
+	cleaned := filepath.Clean(subpath)
+	if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("subpath(%s) must be a subdirectory of volume", subpath)
+	}
+
+	return nil
+}
@@ -169,6 +169,27 @@ func TestParseBindMode(t *testing.T) {
 			err:       false,
 			expectErr: nil,
 		},
+		{
+			mode: "ro,subpath=data/logs",
+			expectMountPoint: &types.MountPoint{
+				Mode:     "ro,subpath=data/logs",
+				RW:       false,
+				CopyData: true,
+				Subpath:  "data/logs",
+			},
+			err:       false,
+			expectErr: nil,
+		},
+		{
+			mode:      "subpath=../data",
+			err:       true,
+			expectErr: fmt.Errorf("subpath(../data) must be a subdirectory of volume"),
+		},
+		{
+			mode:      "dr,subpath=data",
+			err:       true,
+			expectErr: fmt.Errorf("invalid bind mode: dr,subpath=data, subpath can't be used with dr or rr"),
+		},
 		{
 			mode: "z,Z",
 			expectMountPoint: &types.MountPoint{
@@ -191,6 +212,7 @@ func TestParseBindMode(t *testing.T) {
 			assert.Equal(p.expectMountPoint.Mode, mp.Mode)
 			assert.Equal(p.expectMountPoint.RW, mp.RW)
 			assert.Equal(p.expectMountPoint.CopyData, mp.CopyData)
+			assert.Equal(p.expectMountPoint.Subpath, mp.Subpath)
 		}
 	}
 }
@@ -288,3 +310,23 @@ func TestCheckDuplicateMountPoint(t *testing.T) {
 		assert.Equal(realBool, p.expectBool)
 	}
 }
+
+func TestValidateSubpath(t *testing.T) {
+	for _, tc := range []struct {
+		subpath string
+		valid   bool
+	}{
+		{subpath: "data", valid: true},
+		{subpath: "data/logs/", valid: true},
+		{subpath: "data/../logs", valid: true},
+		{subpath: "..data", valid: true},
+		{subpath: "", valid: false},
+		{subpath: "/data", valid: false},
+		{subpath: ".", valid: false},
+		{subpath: "data/../..", valid: false},
+		{subpath: "../data", valid: false},
+	} {
+		err := ValidateSubpath(tc.subpath)
+		assert.Equal(t, tc.valid, err == nil, tc.subpath)
+	}
+}
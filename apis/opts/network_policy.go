@@ -0,0 +1,152 @@
+package opts
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// PolicyDenyAll is the ingress rule which denies all the inbound traffic.
+const PolicyDenyAll = "none"
+
+// ParseNetworkPolicy parses the ingress rules into network policy, rule
+// format as below:
+// from=[label_key]=[label_value],port=[port][-port][/protocol], such as:
+// from=role=frontend,port=8080/tcp(allow containers labeled role=frontend to access tcp port 8080)
+// port=53/udp(allow any source to access udp port 53)
+// none(deny all the inbound traffic)
+func ParseNetworkPolicy(ingress []string) (*types.NetworkPolicy, error) {
+	if len(ingress) == 0 {
+		return nil, nil
+	}
+
+	policy := &types.NetworkPolicy{
+		Ingress: []*types.NetworkPolicyRule{},
+	}
+	for _, r := range ingress {
+		if r == PolicyDenyAll {
+			if len(ingress) > 1 {
+				return nil, fmt.Errorf("invalid ingress rules: %s cannot be used with other rules", PolicyDenyAll)
+			}
+			return policy, nil
+		}
+
+		rule, err := parseNetworkPolicyRule(r)
+		if err != nil {
+			return nil, err
+		}
+		policy.Ingress = append(policy.Ingress, rule)
+	}
+
+	return policy, nil
+}
+
+func parseNetworkPolicyRule(r string) (*types.NetworkPolicyRule, error) {
+	rule := &types.NetworkPolicyRule{
+		Ports: []string{},
+	}
+
+	for _, field := range strings.Split(r, ",") {
+		kv := strings.SplitN(field, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("invalid ingress rule %s: %s must be in format of key=value", r, field)
+		}
+
+		switch kv[0] {
+		case "from":
+			label := parseLabel(kv[1])
+			if label[0] == "" {
+				return nil, fmt.Errorf("invalid ingress rule %s: label key cannot be empty", r)
+			}
+			if rule.FromLabels == nil {
+				rule.FromLabels = make(map[string]string)
+			}
+			rule.FromLabels[label[0]] = label[1]
+		case "port":
+			if _, _, _, err := ParsePolicyPort(kv[1]); err != nil {
+				return nil, fmt.Errorf("invalid ingress rule %s: %v", r, err)
+			}
+			rule.Ports = append(rule.Ports, kv[1])
+		default:
+			return nil, fmt.Errorf("invalid ingress rule %s: unknown key %s", r, kv[0])
+		}
+	}
+
+	return rule, nil
+}
+
+// ParsePolicyPort parses the port of ingress rule in format of
+// [port][-port][/protocol], and returns protocol, start and end port.
+func ParsePolicyPort(port string) (string, int, int, error) {
+	proto := "tcp"
+	parts := strings.SplitN(port, "/", 2)
+	if len(parts) == 2 {
+		proto = strings.ToLower(parts[1])
+	}
+	if proto != "tcp" && proto != "udp" && proto != "sctp" {
+		return "", 0, 0, fmt.Errorf("invalid protocol of port %s", port)
+	}
+
+	ports := strings.SplitN(parts[0], "-", 2)
+	start, err := strconv.ParseUint(ports[0], 10, 16)
+	if err != nil || start == 0 {
+		return "", 0, 0, fmt.Errorf("invalid port %s", port)
+	}
+	end := start
+	if len(ports) == 2 {
+		end, err = strconv.ParseUint(ports[1], 10, 16)
+		if err != nil || end < start {
+			return "", 0, 0, fmt.Errorf("invalid port range %s", port)
+		}
+	}
+
+	return proto, int(start), int(end), nil
+}
+
+// ValidateNetworkPolicy verifies the ingress rules of network policy.
+func ValidateNetworkPolicy(policy *types.NetworkPolicy) error {
+	if policy == nil {
+		return nil
+	}
+
+	for _, rule := range policy.Ingress {
+		if rule == nil {
+			return fmt.Errorf("invalid ingress rule: cannot be empty")
+		}
+		for k := range rule.FromLabels {
+			if k == "" {
+				return fmt.Errorf("invalid ingress rule: label key cannot be empty")
+			}
+		}
+		for _, port := range rule.Ports {
+			if _, _, _, err := ParsePolicyPort(port); err != nil {
+				return fmt.Errorf("invalid ingress rule: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetEndpointPolicy sets the ingress policy of the endpoint on network.
+func SetEndpointPolicy(nwConfig *types.NetworkingConfig, mode string, policy *types.NetworkPolicy) error {
+	if nwConfig == nil || mode == "" || policy == nil {
+		return nil
+	}
+
+	if nwConfig.EndpointsConfig == nil {
+		nwConfig.EndpointsConfig = make(map[string]*types.EndpointSettings)
+	}
+
+	epConfig := nwConfig.EndpointsConfig[mode]
+	if epConfig == nil {
+		epConfig = &types.EndpointSettings{}
+	}
+	epConfig.Policy = policy
+
+	nwConfig.EndpointsConfig[mode] = epConfig
+
+	return nil
+}
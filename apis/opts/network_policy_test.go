@@ -0,0 +1,130 @@
+package opts
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNetworkPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		ingress []string
+		want    *types.NetworkPolicy
+		wantErr bool
+	}{
+		{
+			name:    "noRule",
+			ingress: nil,
+			want:    nil,
+		},
+		{
+			name:    "denyAll",
+			ingress: []string{"none"},
+			want:    &types.NetworkPolicy{Ingress: []*types.NetworkPolicyRule{}},
+		},
+		{
+			name:    "denyAllWithOtherRules",
+			ingress: []string{"none", "port=80"},
+			wantErr: true,
+		},
+		{
+			name:    "fromLabelsAndPorts",
+			ingress: []string{"from=role=frontend,port=8080/tcp", "port=53/udp,port=1000-2000"},
+			want: &types.NetworkPolicy{
+				Ingress: []*types.NetworkPolicyRule{
+					{
+						FromLabels: map[string]string{"role": "frontend"},
+						Ports:      []string{"8080/tcp"},
+					},
+					{
+						Ports: []string{"53/udp", "1000-2000"},
+					},
+				},
+			},
+		},
+		{
+			name:    "multipleLabels",
+			ingress: []string{"from=role=frontend,from=env"},
+			want: &types.NetworkPolicy{
+				Ingress: []*types.NetworkPolicyRule{
+					{
+						FromLabels: map[string]string{"role": "frontend", "env": ""},
+						Ports:      []string{},
+					},
+				},
+			},
+		},
+		{
+			name:    "unknownKey",
+			ingress: []string{"to=role=backend"},
+			wantErr: true,
+		},
+		{
+			name:    "emptyLabelKey",
+			ingress: []string{"from==frontend"},
+			wantErr: true,
+		},
+		{
+			name:    "invalidPort",
+			ingress: []string{"port=http"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseNetworkPolicy(tt.ingress)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseNetworkPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParsePolicyPort(t *testing.T) {
+	tests := []struct {
+		port    string
+		proto   string
+		start   int
+		end     int
+		wantErr bool
+	}{
+		{port: "8080", proto: "tcp", start: 8080, end: 8080},
+		{port: "53/UDP", proto: "udp", start: 53, end: 53},
+		{port: "1000-2000/tcp", proto: "tcp", start: 1000, end: 2000},
+		{port: "2000-1000", wantErr: true},
+		{port: "0", wantErr: true},
+		{port: "65536", wantErr: true},
+		{port: "80/icmp", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		proto, start, end, err := ParsePolicyPort(tt.port)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("ParsePolicyPort(%s) error = %v, wantErr %v", tt.port, err, tt.wantErr)
+		}
+		if tt.wantErr {
+			continue
+		}
+		assert.Equal(t, tt.proto, proto)
+		assert.Equal(t, tt.start, start)
+		assert.Equal(t, tt.end, end)
+	}
+}
+
+func TestValidateNetworkPolicy(t *testing.T) {
+	assert.NoError(t, ValidateNetworkPolicy(nil))
+	assert.NoError(t, ValidateNetworkPolicy(&types.NetworkPolicy{
+		Ingress: []*types.NetworkPolicyRule{{Ports: []string{"80"}}},
+	}))
+	assert.Error(t, ValidateNetworkPolicy(&types.NetworkPolicy{
+		Ingress: []*types.NetworkPolicyRule{nil},
+	}))
+	assert.Error(t, ValidateNetworkPolicy(&types.NetworkPolicy{
+		Ingress: []*types.NetworkPolicyRule{{Ports: []string{"80/icmp"}}},
+	}))
+}
@@ -30,6 +30,8 @@ func ParseNetworks(networks []string) (*types.NetworkingConfig, string, error) {
 
 		if name == "container" {
 			networkMode = fmt.Sprintf("%s:%s", name, parameter)
+		} else if name == "cni" && parameter != "" {
+			networkMode = fmt.Sprintf("%s:%s", name, parameter)
 		} else if ipaddr := net.ParseIP(parameter); ipaddr != nil {
 			networkingConfig.EndpointsConfig[name] = &types.EndpointSettings{
 				IPAddress: parameter,
@@ -46,6 +48,7 @@ func ParseNetworks(networks []string) (*types.NetworkingConfig, string, error) {
 // network format as below:
 // [network]:[ip_address], such as: mynetwork:172.17.0.2 or mynetwork(ip alloc by ipam) or 172.17.0.2(default network is bridge)
 // [network_mode]:[parameter], such as: host(use host network) or container:containerID(use exist container network)
+// cni:[cni_network], such as: cni(use default cni network) or cni:mynet(use the cni network named mynet)
 // [network_mode]:[parameter]:mode, such as: mynetwork:172.17.0.2:mode(if the container has multi-networks, the network is the default network mode)
 func parseNetwork(network string) (string, string, string, error) {
 	var (
@@ -66,7 +69,7 @@ func parseNetwork(network string) (string, string, string, error) {
 		}
 	case 2:
 		name = arr[0]
-		if name == "container" {
+		if name == "container" || (name == "cni" && arr[1] != "mode") {
 			parameter = arr[1]
 		} else if ipaddr := net.ParseIP(arr[1]); ipaddr != nil {
 			parameter = arr[1]
@@ -62,6 +62,28 @@ func TestParseNetworks(t *testing.T) {
 			want1:   "container:e8e153651a0d",
 			wantErr: false,
 		},
+		{
+			name: "if name is 'cni' then return name and cni network as mode",
+			args: args{
+				networks: []string{"cni:mynet"},
+			},
+			want: &types.NetworkingConfig{
+				EndpointsConfig: map[string]*types.EndpointSettings{},
+			},
+			want1:   "cni:mynet",
+			wantErr: false,
+		},
+		{
+			name: "if name is 'cni' without cni network then return default cni mode",
+			args: args{
+				networks: []string{"cni"},
+			},
+			want: &types.NetworkingConfig{
+				EndpointsConfig: map[string]*types.EndpointSettings{},
+			},
+			want1:   "cni",
+			wantErr: false,
+		},
 		{
 			name: "name is not 'container'",
 			args: args{
@@ -262,6 +284,20 @@ func Test_parseNetwork(t *testing.T) {
 				network: net{name: "container", parameter: "9ca6ac", mode: ""},
 			},
 		},
+		{
+			input: "cni:mynet",
+			expect: result{
+				err:     nil,
+				network: net{name: "cni", parameter: "mynet", mode: ""},
+			},
+		},
+		{
+			input: "cni:mode",
+			expect: result{
+				err:     nil,
+				network: net{name: "cni", parameter: "", mode: "mode"},
+			},
+		},
 		{
 			input: "bridge:121.0.0.1:mode",
 			expect: result{
@@ -94,6 +94,11 @@ func (s *Server) getContainer(ctx context.Context, rw http.ResponseWriter, req *
 		hostRootPath = mergedDir
 	}
 
+	diskQuotaUsage, err := s.ContainerMgr.DiskQuotaUsage(ctx, c.ID)
+	if err != nil {
+		log.With(ctx).Warnf("failed to get disk quota usage of container %s: %v", c.ID, err)
+	}
+
 	container := types.ContainerJSON{
 		ID:           c.ID,
 		Name:         c.Name,
@@ -121,6 +126,7 @@ func (s *Server) getContainer(ctx context.Context, rw http.ResponseWriter, req *
 		MountLabel:      c.MountLabel,
 		ProcessLabel:    c.ProcessLabel,
 		ExecIds:         c.ExecIds,
+		DiskQuotaUsage:  diskQuotaUsage,
 	}
 
 	return EncodeResponse(rw, http.StatusOK, container)
@@ -420,6 +426,26 @@ func (s *Server) upgradeContainer(ctx context.Context, rw http.ResponseWriter, r
 	return nil
 }
 
+func (s *Server) migrateContainerSnapshot(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	label := util_metrics.ActionMigrateSnapshotLabel
+	defer func(start time.Time) {
+		metrics.ContainerActionsCounter.WithLabelValues(label).Inc()
+		metrics.ContainerActionsTimer.WithLabelValues(label).Observe(time.Since(start).Seconds())
+	}(time.Now())
+
+	name := mux.Vars(req)["name"]
+	snapshotter := req.FormValue("snapshotter")
+
+	if err := s.ContainerMgr.MigrateSnapshot(ctx, name, snapshotter); err != nil {
+		return err
+	}
+
+	metrics.ContainerSuccessActionsCounter.WithLabelValues(label).Inc()
+
+	rw.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
 func (s *Server) topContainer(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	name := mux.Vars(req)["name"]
 
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/gorilla/mux"
+)
+
+// inspectDistribution returns the image in registry without pulling it.
+func (s *Server) inspectDistribution(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	authConfig, err := registryAuthFromRequest(req)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.ImageMgr.InspectDistribution(ctx, name, authConfig)
+	if err != nil {
+		log.With(ctx).Errorf("failed to inspect image %s in registry: %v", name, err)
+		return err
+	}
+	return EncodeResponse(rw, http.StatusOK, result)
+}
+
+// listDistributionTags lists the tags of repository in registry.
+func (s *Server) listDistributionTags(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	authConfig, err := registryAuthFromRequest(req)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.ImageMgr.ListDistributionTags(ctx, name, authConfig)
+	if err != nil {
+		log.With(ctx).Errorf("failed to list tags of %s in registry: %v", name, err)
+		return err
+	}
+	return EncodeResponse(rw, http.StatusOK, result)
+}
+
+// registryAuthFromRequest decodes the registry auth in request header.
+func registryAuthFromRequest(req *http.Request) (*types.AuthConfig, error) {
+	authConfig := &types.AuthConfig{}
+
+	authStr := req.Header.Get("X-Registry-Auth")
+	if authStr != "" {
+		data := base64.NewDecoder(base64.URLEncoding, strings.NewReader(authStr))
+		if err := json.NewDecoder(data).Decode(authConfig); err != nil {
+			return nil, err
+		}
+	}
+	return authConfig, nil
+}
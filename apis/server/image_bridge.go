@@ -28,6 +28,7 @@ import (
 func (s *Server) pullImage(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	image := req.FormValue("fromImage")
 	tag := req.FormValue("tag")
+	platform := req.FormValue("platform")
 
 	if image == "" {
 		err := fmt.Errorf("fromImage cannot be empty")
@@ -57,7 +58,7 @@ func (s *Server) pullImage(ctx context.Context, rw http.ResponseWriter, req *htt
 		}
 	}
 	// Error information has be sent to client, so no need call resp.Write
-	if err := s.ImageMgr.PullImage(ctx, image, &authConfig, newWriteFlusher(rw)); err != nil {
+	if err := s.ImageMgr.PullImage(ctx, image, platform, &authConfig, newWriteFlusher(rw)); err != nil {
 		log.With(ctx).Errorf("failed to pull image %s: %v", image, err)
 		if err == errtypes.ErrNotfound {
 			return httputils.NewHTTPError(err, http.StatusNotFound)
@@ -191,6 +192,19 @@ func (s *Server) postImageTag(ctx context.Context, rw http.ResponseWriter, req *
 	return nil
 }
 
+// unpackImage unpacks an image into the snapshotter.
+func (s *Server) unpackImage(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+	snapshotter := req.FormValue("snapshotter")
+
+	if err := s.ImageMgr.UnpackImage(ctx, name, snapshotter); err != nil {
+		return err
+	}
+
+	rw.WriteHeader(http.StatusOK)
+	return nil
+}
+
 // loadImage loads an image by http tar stream.
 func (s *Server) loadImage(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	imageName := req.FormValue("name")
@@ -247,6 +261,14 @@ func (s *Server) pushImage(ctx context.Context, rw http.ResponseWriter, req *htt
 		}
 	}
 
+	if httputils.BoolValue(req, "all-tags") {
+		if err := s.ImageMgr.PushRepository(ctx, name, &authConfig, newWriteFlusher(rw)); err != nil {
+			log.With(ctx).Errorf("failed to push all tags of repository %s: %v", name, err)
+			return err
+		}
+		return nil
+	}
+
 	if err := s.ImageMgr.PushImage(ctx, name, tag, &authConfig, newWriteFlusher(rw)); err != nil {
 		log.With(ctx).Errorf("failed to push image %s with tag %s: %v", name, tag, err)
 		return err
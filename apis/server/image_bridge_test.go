@@ -22,7 +22,7 @@ type mockImgePull struct {
 	handler func(ctx context.Context, imageRef string, authConfig *types.AuthConfig, out io.Writer) error
 }
 
-func (m *mockImgePull) PullImage(ctx context.Context, imageRef string, authConfig *types.AuthConfig, out io.Writer) error {
+func (m *mockImgePull) PullImage(ctx context.Context, imageRef, platform string, authConfig *types.AuthConfig, out io.Writer) error {
 	return m.handler(ctx, imageRef, authConfig, out)
 }
 
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/gorilla/mux"
+)
+
+// createManifestList creates the manifest list of images in registry.
+func (s *Server) createManifestList(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	config := &types.ManifestCreateConfig{}
+	if err := json.NewDecoder(req.Body).Decode(config); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	authConfig, err := registryAuthFromRequest(req)
+	if err != nil {
+		return err
+	}
+
+	if err := s.ImageMgr.CreateManifestList(ctx, config.Name, config.Images, config.Amend, authConfig); err != nil {
+		log.With(ctx).Errorf("failed to create manifest list %s: %v", config.Name, err)
+		return err
+	}
+
+	rw.WriteHeader(http.StatusCreated)
+	return nil
+}
+
+// annotateManifestList sets the platform of image in the manifest list.
+func (s *Server) annotateManifestList(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	config := &types.ManifestAnnotateConfig{}
+	if err := json.NewDecoder(req.Body).Decode(config); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	if err := s.ImageMgr.AnnotateManifestList(ctx, name, config); err != nil {
+		log.With(ctx).Errorf("failed to annotate image %s in manifest list %s: %v", config.Image, name, err)
+		return err
+	}
+
+	rw.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// pushManifestList pushes the manifest list to registry.
+func (s *Server) pushManifestList(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	authConfig, err := registryAuthFromRequest(req)
+	if err != nil {
+		return err
+	}
+
+	if err := s.ImageMgr.PushManifestList(ctx, name, authConfig, newWriteFlusher(rw)); err != nil {
+		log.With(ctx).Errorf("failed to push manifest list %s: %v", name, err)
+		return err
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/mgr"
 	networktypes "github.com/alibaba/pouch/network/types"
 	"github.com/alibaba/pouch/pkg/httputils"
 
@@ -50,9 +51,48 @@ func (s *Server) getNetwork(ctx context.Context, rw http.ResponseWriter, req *ht
 
 	networkResp := buildNetworkInspectResp(network)
 
+	reservations, err := s.NetworkMgr.Reservations(ctx, network.ID)
+	if err != nil {
+		return err
+	}
+	for _, r := range reservations {
+		reservation := r.NetworkReservation
+		networkResp.Reservations = append(networkResp.Reservations, &reservation)
+	}
+
+	endpoints, err := s.NetworkMgr.NetworkEndpoints(ctx, network.ID)
+	if err != nil {
+		return err
+	}
+	networkResp.Containers = endpoints
+
+	policy, rules, err := s.NetworkMgr.NetworkPolicy(ctx, network.ID)
+	if err != nil {
+		return err
+	}
+	networkResp.Policy = policy
+	networkResp.PolicyRules = rules
+
 	return EncodeResponse(rw, http.StatusOK, networkResp)
 }
 
+func (s *Server) statsNetwork(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	id := mux.Vars(req)["id"]
+
+	stream := httputils.BoolValue(req, "stream")
+
+	if !stream {
+		rw.Header().Set("Content-Type", "application/json")
+	}
+
+	config := &mgr.NetworkStatsConfig{
+		Stream:    stream,
+		OutStream: rw,
+	}
+
+	return s.NetworkMgr.StreamNetworkStats(ctx, id, config)
+}
+
 func (s *Server) listNetwork(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 	networks, err := s.NetworkMgr.List(ctx, map[string]string{})
 	if err != nil {
@@ -112,6 +152,37 @@ func (s *Server) disconnectNetwork(ctx context.Context, rw http.ResponseWriter,
 	return s.ContainerMgr.Disconnect(ctx, network.Container, id, network.Force)
 }
 
+func (s *Server) reserveNetworkAddress(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	reservation := &types.NetworkReservation{}
+	// decode request body
+	if err := json.NewDecoder(req.Body).Decode(reservation); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+	// validate request body
+	if err := reservation.Validate(strfmt.NewFormats()); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	id := mux.Vars(req)["id"]
+
+	if err := s.NetworkMgr.Reserve(ctx, id, *reservation); err != nil {
+		return err
+	}
+	rw.WriteHeader(http.StatusCreated)
+	return nil
+}
+
+func (s *Server) unreserveNetworkAddress(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	id := mux.Vars(req)["id"]
+	name := mux.Vars(req)["name"]
+
+	if err := s.NetworkMgr.Unreserve(ctx, id, name); err != nil {
+		return err
+	}
+	rw.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
 func buildNetworkInspectResp(n *networktypes.Network) *types.NetworkInspectResp {
 	info := n.Network.Info()
 	network := &types.NetworkInspectResp{
@@ -34,6 +34,7 @@ func initRoute(s *Server) *mux.Router {
 		{Method: http.MethodGet, Path: "/version", HandlerFunc: s.version},
 		{Method: http.MethodPost, Path: "/auth", HandlerFunc: s.auth},
 		{Method: http.MethodGet, Path: "/events", HandlerFunc: withCancelHandler(s.events)},
+		{Method: http.MethodPost, Path: "/system/quota-check", HandlerFunc: s.quotaCheck},
 
 		// daemon, we still list this API into system manager.
 		{Method: http.MethodPost, Path: "/daemon/update", HandlerFunc: s.updateDaemon},
@@ -59,6 +60,7 @@ func initRoute(s *Server) *mux.Router {
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/unpause", HandlerFunc: s.unpauseContainer},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/update", HandlerFunc: s.updateContainer},
 		{Method: http.MethodPost, Path: "/containers/{name:.*}/upgrade", HandlerFunc: s.upgradeContainer},
+		{Method: http.MethodPost, Path: "/containers/{name:.*}/migrate-snapshot", HandlerFunc: s.migrateContainerSnapshot},
 		{Method: http.MethodGet, Path: "/containers/{name:.*}/top", HandlerFunc: s.topContainer},
 		{Method: http.MethodGet, Path: "/containers/{name:.*}/logs", HandlerFunc: withCancelHandler(s.logsContainer)},
 		{Method: http.MethodGet, Path: "/containers/{name:.*}/stats", HandlerFunc: withCancelHandler(s.statsContainer)},
@@ -74,20 +76,37 @@ func initRoute(s *Server) *mux.Router {
 		{Method: http.MethodDelete, Path: "/images/{name:.*}", HandlerFunc: s.removeImage},
 		{Method: http.MethodGet, Path: "/images/{name:.*}/json", HandlerFunc: s.getImage},
 		{Method: http.MethodPost, Path: "/images/{name:.*}/tag", HandlerFunc: s.postImageTag},
+		{Method: http.MethodPost, Path: "/images/{name:.*}/unpack", HandlerFunc: s.unpackImage},
 		{Method: http.MethodPost, Path: "/images/load", HandlerFunc: withCancelHandler(s.loadImage)},
 		{Method: http.MethodGet, Path: "/images/save", HandlerFunc: withCancelHandler(s.saveImage)},
 		{Method: http.MethodGet, Path: "/images/{name:.*}/history", HandlerFunc: s.getImageHistory},
 		{Method: http.MethodPost, Path: "/images/{name:.*}/push", HandlerFunc: s.pushImage},
 
+		// distribution
+		{Method: http.MethodGet, Path: "/distribution/{name:.*}/json", HandlerFunc: s.inspectDistribution},
+		{Method: http.MethodGet, Path: "/distribution/{name:.*}/tags", HandlerFunc: s.listDistributionTags},
+
+		// manifest list
+		{Method: http.MethodPost, Path: "/manifests/create", HandlerFunc: s.createManifestList},
+		{Method: http.MethodPost, Path: "/manifests/{name:.*}/annotate", HandlerFunc: s.annotateManifestList},
+		{Method: http.MethodPost, Path: "/manifests/{name:.*}/push", HandlerFunc: s.pushManifestList},
+
 		// volume
 		{Method: http.MethodGet, Path: "/volumes", HandlerFunc: s.listVolume},
 		{Method: http.MethodPost, Path: "/volumes/create", HandlerFunc: s.createVolume},
+		{Method: http.MethodPost, Path: "/volumes/{name:.*}/update", HandlerFunc: s.updateVolume},
+		{Method: http.MethodGet, Path: "/volumes/{name:.*}/archive", HandlerFunc: s.getVolumeArchive},
+		{Method: http.MethodPut, Path: "/volumes/{name:.*}/archive", HandlerFunc: s.putVolumeArchive},
+		{Method: http.MethodPost, Path: "/volumes/{name:.*}/clone", HandlerFunc: s.cloneVolume},
 		{Method: http.MethodGet, Path: "/volumes/{name:.*}", HandlerFunc: s.getVolume},
 		{Method: http.MethodDelete, Path: "/volumes/{name:.*}", HandlerFunc: s.removeVolume},
 
 		// network
 		{Method: http.MethodGet, Path: "/networks", HandlerFunc: s.listNetwork},
 		{Method: http.MethodPost, Path: "/networks/create", HandlerFunc: s.createNetwork},
+		{Method: http.MethodPost, Path: "/networks/{id:.*}/reservations", HandlerFunc: s.reserveNetworkAddress},
+		{Method: http.MethodDelete, Path: "/networks/{id:.*}/reservations/{name}", HandlerFunc: s.unreserveNetworkAddress},
+		{Method: http.MethodGet, Path: "/networks/{id:.*}/stats", HandlerFunc: withCancelHandler(s.statsNetwork)},
 		{Method: http.MethodGet, Path: "/networks/{id:.*}", HandlerFunc: s.getNetwork},
 		{Method: http.MethodDelete, Path: "/networks/{id:.*}", HandlerFunc: s.deleteNetwork},
 		{Method: http.MethodPost, Path: "/networks/{id:.*}/connect", HandlerFunc: s.connectToNetwork},
@@ -275,8 +294,10 @@ func HandleErrorResponse(w http.ResponseWriter, err error) {
 		code = http.StatusConflict
 	} else if errtypes.IsNotModified(err) {
 		code = http.StatusNotModified
-	} else if errtypes.IsInvalidAuthorization(err) {
+	} else if errtypes.IsInvalidAuthorization(err) || errtypes.IsPolicyDenied(err) {
 		code = http.StatusForbidden
+	} else if errtypes.IsNotImplemented(err) {
+		code = http.StatusNotImplemented
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -8,11 +8,12 @@ import (
 	"time"
 
 	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/metrics"
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/pkg/httputils"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/utils"
-	"github.com/alibaba/pouch/pkg/utils/metrics"
+	util_metrics "github.com/alibaba/pouch/pkg/utils/metrics"
 
 	"github.com/docker/docker/pkg/ioutils"
 	"github.com/go-openapi/strfmt"
@@ -81,6 +82,15 @@ func (s *Server) auth(ctx context.Context, rw http.ResponseWriter, req *http.Req
 	return EncodeResponse(rw, http.StatusOK, authResp)
 }
 
+func (s *Server) quotaCheck(ctx context.Context, rw http.ResponseWriter, req *http.Request) (err error) {
+	issues, err := s.ContainerMgr.QuotaCheck(ctx, httputils.BoolValue(req, "fix"))
+	if err != nil {
+		return err
+	}
+
+	return EncodeResponse(rw, http.StatusOK, &types.QuotaCheckResp{Issues: issues})
+}
+
 func (s *Server) events(ctx context.Context, rw http.ResponseWriter, req *http.Request) (err error) {
 	rw.Header().Set("Content-Type", "application/json")
 	output := ioutils.NewWriteFlusher(rw)
@@ -156,10 +166,47 @@ func (s *Server) events(ctx context.Context, rw http.ResponseWriter, req *http.R
 }
 
 func (s *Server) metrics(ctx context.Context, rw http.ResponseWriter, req *http.Request) (err error) {
-	metrics.GetPrometheusHandler().ServeHTTP(rw, req)
+	s.updateDiskQuotaMetrics(ctx)
+	util_metrics.GetPrometheusHandler().ServeHTTP(rw, req)
 	return nil
 }
 
+// updateDiskQuotaMetrics refreshes the disk quota usage of running containers
+// before the metrics are gathered.
+func (s *Server) updateDiskQuotaMetrics(ctx context.Context) {
+	metrics.ContainerDiskQuotaBytes.Reset()
+	metrics.ContainerDiskQuotaInodes.Reset()
+
+	if s.ContainerMgr == nil {
+		return
+	}
+
+	usages, err := s.ContainerMgr.ListDiskQuotaUsage(ctx)
+	if err != nil {
+		log.With(ctx).Warnf("failed to get disk quota usage for disk quota metrics: %v", err)
+		return
+	}
+
+	for id, containerUsages := range usages {
+		for _, u := range containerUsages {
+			for typ, value := range map[string]uint64{
+				"used":       u.Size,
+				"soft_limit": u.SoftLimit,
+				"hard_limit": u.HardLimit,
+			} {
+				metrics.ContainerDiskQuotaBytes.WithLabelValues(id, u.QuotaID, u.Device, typ).Set(float64(value))
+			}
+			for typ, value := range map[string]uint64{
+				"used":       u.Inodes,
+				"soft_limit": u.InodesSoftLimit,
+				"hard_limit": u.InodesHardLimit,
+			} {
+				metrics.ContainerDiskQuotaInodes.WithLabelValues(id, u.QuotaID, u.Device, typ).Set(float64(value))
+			}
+		}
+	}
+}
+
 func eventTime(formTime string) (time.Time, error) {
 	t, tNano, err := utils.ParseTimestamp(formTime, -1)
 	if err != nil {
@@ -3,12 +3,18 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/alibaba/pouch/apis/filters"
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/mgr"
 	"github.com/alibaba/pouch/pkg/httputils"
+	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/randomid"
+	"github.com/alibaba/pouch/pkg/system"
+	volumedriver "github.com/alibaba/pouch/storage/volume/driver"
 	volumetypes "github.com/alibaba/pouch/storage/volume/types"
 
 	"github.com/go-openapi/strfmt"
@@ -54,12 +60,14 @@ func (s *Server) createVolume(ctx context.Context, rw http.ResponseWriter, req *
 		}
 	}
 	status["size"] = volume.Size()
+	setVolumeIOLimits(volume, status)
 
 	respVolume := types.VolumeInfo{
 		Name:       name,
 		Driver:     driver,
 		Labels:     config.Labels,
 		Mountpoint: volume.Path(),
+		Scope:      volumeScope(ctx, volume),
 		Status:     status,
 		CreatedAt:  volume.CreationTimestamp.Format("2006-1-2 15:04:05"),
 	}
@@ -81,6 +89,12 @@ func (s *Server) getVolume(ctx context.Context, rw http.ResponseWriter, req *htt
 		}
 	}
 	status["size"] = volume.Size()
+	setVolumeIOLimits(volume, status)
+
+	refCounts, err := s.volumeRefCounts(ctx)
+	if err != nil {
+		return err
+	}
 
 	respVolume := types.VolumeInfo{
 		Name:       volume.Name,
@@ -88,7 +102,9 @@ func (s *Server) getVolume(ctx context.Context, rw http.ResponseWriter, req *htt
 		Mountpoint: volume.Path(),
 		CreatedAt:  volume.CreateTime(),
 		Labels:     volume.Labels,
+		Scope:      volumeScope(ctx, volume),
 		Status:     status,
+		UsageData:  s.volumeUsageData(ctx, volume, refCounts),
 	}
 
 	return EncodeResponse(rw, http.StatusOK, respVolume)
@@ -105,6 +121,14 @@ func (s *Server) listVolume(ctx context.Context, rw http.ResponseWriter, req *ht
 		return err
 	}
 
+	var refCounts map[string]int64
+	withSize := httputils.BoolValue(req, "size")
+	if withSize {
+		if refCounts, err = s.volumeRefCounts(ctx); err != nil {
+			return err
+		}
+	}
+
 	respVolumes := types.VolumeListResp{Volumes: []*types.VolumeInfo{}, Warnings: nil}
 	for _, volume := range volumes {
 		status := map[string]interface{}{}
@@ -114,6 +138,7 @@ func (s *Server) listVolume(ctx context.Context, rw http.ResponseWriter, req *ht
 			}
 		}
 		status["size"] = volume.Size()
+		setVolumeIOLimits(volume, status)
 
 		respVolume := &types.VolumeInfo{
 			Name:       volume.Name,
@@ -123,6 +148,12 @@ func (s *Server) listVolume(ctx context.Context, rw http.ResponseWriter, req *ht
 			Labels:     volume.Labels,
 			Status:     status,
 		}
+		if withSize {
+			respVolume.UsageData = s.volumeUsageData(ctx, volume, refCounts)
+		}
+		if volume.Status != nil && volume.Status.Reason == volumetypes.VolumeReasonMissing {
+			respVolumes.Warnings = append(respVolumes.Warnings, volume.Status.Message)
+		}
 		respVolumes.Volumes = append(respVolumes.Volumes, respVolume)
 	}
 	return EncodeResponse(rw, http.StatusOK, respVolumes)
@@ -137,3 +168,256 @@ func (s *Server) removeVolume(ctx context.Context, rw http.ResponseWriter, req *
 	rw.WriteHeader(http.StatusNoContent)
 	return nil
 }
+
+func (s *Server) updateVolume(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	config := &types.VolumeUpdateConfig{}
+	// decode request body
+	if err := json.NewDecoder(req.Body).Decode(config); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+	// validate request body
+	if err := config.Validate(strfmt.NewFormats()); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	name := mux.Vars(req)["name"]
+	if _, err := s.VolumeMgr.Update(ctx, name, config.Size, config.Labels); err != nil {
+		return err
+	}
+
+	rw.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (s *Server) getVolumeArchive(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	if httputils.BoolValue(req, "pause") {
+		resume, err := s.pauseVolumeContainers(ctx, name)
+		if err != nil {
+			return err
+		}
+		defer resume()
+	} else if err := s.checkVolumeContainers(ctx, name); err != nil {
+		return err
+	}
+
+	tarArchive, err := s.VolumeMgr.Archive(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer tarArchive.Close()
+
+	rw.Header().Set("Content-Type", "application/x-tar")
+	_, err = io.Copy(rw, tarArchive)
+
+	return err
+}
+
+func (s *Server) putVolumeArchive(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	if httputils.BoolValue(req, "pause") {
+		resume, err := s.pauseVolumeContainers(ctx, name)
+		if err != nil {
+			return err
+		}
+		defer resume()
+	}
+
+	if err := s.VolumeMgr.Extract(ctx, name, req.Body); err != nil {
+		return err
+	}
+
+	rw.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (s *Server) cloneVolume(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+	config := &types.VolumeCloneConfig{}
+	// decode request body
+	if err := json.NewDecoder(req.Body).Decode(config); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+	// validate request body
+	if err := config.Validate(strfmt.NewFormats()); err != nil {
+		return httputils.NewHTTPError(err, http.StatusBadRequest)
+	}
+
+	name := mux.Vars(req)["name"]
+	if config.Pause {
+		resume, err := s.pauseVolumeContainers(ctx, name)
+		if err != nil {
+			return err
+		}
+		defer resume()
+	} else if err := s.checkVolumeContainers(ctx, name); err != nil {
+		return err
+	}
+
+	volume, err := s.VolumeMgr.Clone(ctx, name, config.Name)
+	if err != nil {
+		return err
+	}
+
+	status := map[string]interface{}{}
+	for k, v := range volume.Options() {
+		if k != "" && v != "" {
+			status[k] = v
+		}
+	}
+	status["size"] = volume.Size()
+	setVolumeIOLimits(volume, status)
+
+	respVolume := types.VolumeInfo{
+		Name:       volume.Name,
+		Driver:     volume.Driver(),
+		Mountpoint: volume.Path(),
+		CreatedAt:  volume.CreateTime(),
+		Labels:     volume.Labels,
+		Scope:      volumeScope(ctx, volume),
+		Status:     status,
+	}
+
+	return EncodeResponse(rw, http.StatusCreated, respVolume)
+}
+
+// volumeScope returns the scope of volume, which is decided by its driver.
+func volumeScope(ctx context.Context, volume *volumetypes.Volume) string {
+	d, err := volumedriver.Get(volume.Driver())
+	if err != nil {
+		return volumedriver.LocalScope
+	}
+	return volumedriver.Scope(ctx, d)
+}
+
+// volumeRefCounts returns the number of containers referencing each volume,
+// which is counted from the mount points of containers.
+func (s *Server) volumeRefCounts(ctx context.Context) (map[string]int64, error) {
+	containers, err := s.ContainerMgr.List(ctx, &mgr.ContainerListOption{All: true})
+	if err != nil {
+		return nil, err
+	}
+
+	refCounts := map[string]int64{}
+	for _, c := range containers {
+		// the volume mounted multiple times by container is counted once.
+		names := map[string]struct{}{}
+		for _, mp := range c.Mounts {
+			if mp.Name != "" {
+				names[mp.Name] = struct{}{}
+			}
+		}
+		for name := range names {
+			refCounts[name]++
+		}
+	}
+
+	return refCounts, nil
+}
+
+// volumeUsageData returns the usage data of volume, the size is -1 if the
+// usage is not available.
+func (s *Server) volumeUsageData(ctx context.Context, volume *volumetypes.Volume, refCounts map[string]int64) *types.VolumeUsageData {
+	size, err := s.VolumeMgr.Usage(ctx, volume.Name)
+	if err != nil {
+		log.With(ctx).Warnf("failed to get usage of volume(%s): %v", volume.Name, err)
+		size = -1
+	}
+
+	return &types.VolumeUsageData{
+		Size:     size,
+		RefCount: refCounts[volume.Name],
+	}
+}
+
+// checkVolumeContainers returns conflict error if volume is mounted read-write
+// by a running container which is not paused. The data of volume is read on
+// host, the container may replace the directories in volume with symlinks to
+// make the reading escape from the volume.
+func (s *Server) checkVolumeContainers(ctx context.Context, name string) error {
+	volume, err := s.VolumeMgr.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range volume.References() {
+		c, err := s.ContainerMgr.Get(ctx, id)
+		if err != nil || !c.IsRunning() || c.State.Paused {
+			continue
+		}
+		for _, mp := range c.Mounts {
+			if mp.Name == name && mp.RW {
+				return httputils.NewHTTPError(fmt.Errorf("volume(%s) is mounted read-write by running container(%s), pause it to copy the volume", name, id), http.StatusConflict)
+			}
+		}
+	}
+
+	return nil
+}
+
+// pauseVolumeContainers pauses the running containers using volume, so that
+// the data of volume is not changed during the copy. The returned function
+// unpauses them.
+func (s *Server) pauseVolumeContainers(ctx context.Context, name string) (func(), error) {
+	volume, err := s.VolumeMgr.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var paused []string
+	resume := func() {
+		for _, id := range paused {
+			if err := s.ContainerMgr.Unpause(ctx, id); err != nil {
+				log.With(ctx).Errorf("failed to unpause container(%s) after copying volume(%s): %v", id, name, err)
+			}
+		}
+	}
+
+	for _, id := range volume.References() {
+		c, err := s.ContainerMgr.Get(ctx, id)
+		if err != nil || !c.IsRunning() || c.State.Paused {
+			continue
+		}
+		if err := s.ContainerMgr.Pause(ctx, id); err != nil {
+			resume()
+			return nil, err
+		}
+		paused = append(paused, id)
+	}
+
+	return resume, nil
+}
+
+// setVolumeIOLimits sets the io limits configured on volume and the block
+// device they are applied to into status. The limits of volumes on the same
+// device are summed when they are applied to a container, so the status is
+// not the total limit of the device.
+func setVolumeIOLimits(volume *volumetypes.Volume, status map[string]interface{}) {
+	if volume.Spec == nil || volume.Spec.VolumeConfig == nil {
+		return
+	}
+	cfg := volume.Spec.VolumeConfig
+
+	limited := false
+	for key, value := range map[string]int64{
+		volumetypes.OptionWriteBPS:  cfg.WriteBPS,
+		volumetypes.OptionReadBPS:   cfg.ReadBPS,
+		volumetypes.OptionWriteIOPS: cfg.WriteIOPS,
+		volumetypes.OptionReadIOPS:  cfg.ReadIOPS,
+	} {
+		if value > 0 {
+			status[key] = value
+			limited = true
+		}
+	}
+
+	if !limited {
+		return
+	}
+	if major, minor, err := system.GetBlockDevice(volume.Path()); err == nil {
+		status["blkdev"] = fmt.Sprintf("%d:%d", major, minor)
+	} else {
+		status["blkdev"] = ""
+	}
+}
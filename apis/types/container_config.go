@@ -41,6 +41,8 @@ type ContainerConfig struct {
 	// Value is disk quota size for the dir.
 	// / means rootfs dir in container.
 	// .* includes rootfs dir and all volume dir.
+	// The special key `inodes` sets the inode limit, which applies
+	// to all the dirs having disk quota.
 	//
 	DiskQuota map[string]string `json:"DiskQuota,omitempty"`
 
@@ -91,6 +93,12 @@ type ContainerConfig struct {
 	// Open `stdin`
 	OpenStdin bool `json:"OpenStdin,omitempty"`
 
+	// The platform of image used to create container, such as `linux/arm64`.
+	// The container fails to be created if the image is built for other platform,
+	// or the platform can't be executed by host.
+	//
+	Platform string `json:"Platform,omitempty"`
+
 	// Set disk quota by specified quota id.
 	// If QuotaID <= 0, it means pouchd should allocate a unique quota id by sequence automatically.
 	// By default, a quota ID is mapped to only one container. And one quota ID can include several mountpoint.
@@ -31,6 +31,9 @@ type ContainerJSON struct {
 	// The time the container was created
 	Created string `json:"Created,omitempty"`
 
+	// The usage of disk quotas set on container, only reported for running container.
+	DiskQuotaUsage []*DiskQuotaUsage `json:"DiskQuotaUsage"`
+
 	// driver
 	Driver string `json:"Driver,omitempty"`
 
@@ -106,6 +109,10 @@ func (m *ContainerJSON) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDiskQuotaUsage(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateGraphDriver(formats); err != nil {
 		res = append(res, err)
 	}
@@ -154,6 +161,31 @@ func (m *ContainerJSON) validateConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ContainerJSON) validateDiskQuotaUsage(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DiskQuotaUsage) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.DiskQuotaUsage); i++ {
+		if swag.IsZero(m.DiskQuotaUsage[i]) { // not required
+			continue
+		}
+
+		if m.DiskQuotaUsage[i] != nil {
+			if err := m.DiskQuotaUsage[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("DiskQuotaUsage" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ContainerJSON) validateGraphDriver(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.GraphDriver) { // not required
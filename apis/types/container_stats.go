@@ -6,6 +6,8 @@ package types
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	"github.com/go-openapi/errors"
 	strfmt "github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -22,6 +24,9 @@ type ContainerStats struct {
 	// cpu stats
 	CPUStats *CPUStats `json:"cpu_stats,omitempty"`
 
+	// usage of disk quotas set on container
+	DiskQuotaStats []*DiskQuotaUsage `json:"disk_quota_stats"`
+
 	// container id
 	ID string `json:"id,omitempty"`
 
@@ -57,6 +62,10 @@ func (m *ContainerStats) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDiskQuotaStats(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMemoryStats(formats); err != nil {
 		res = append(res, err)
 	}
@@ -119,6 +128,31 @@ func (m *ContainerStats) validateCPUStats(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ContainerStats) validateDiskQuotaStats(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DiskQuotaStats) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.DiskQuotaStats); i++ {
+		if swag.IsZero(m.DiskQuotaStats[i]) { // not required
+			continue
+		}
+
+		if m.DiskQuotaStats[i] != nil {
+			if err := m.DiskQuotaStats[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("disk_quota_stats" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ContainerStats) validateMemoryStats(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.MemoryStats) { // not required
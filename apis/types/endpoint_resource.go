@@ -14,6 +14,9 @@ import (
 // swagger:model EndpointResource
 type EndpointResource struct {
 
+	// Aliases represents the network-scoped aliases of the endpoint
+	Aliases []string `json:"Aliases"`
+
 	// EndpointID represents the endpoint's id
 	EndpointID string `json:"EndpointID,omitempty"`
 
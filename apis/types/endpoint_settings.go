@@ -64,6 +64,11 @@ type EndpointSettings struct {
 	// Unique ID of the network.
 	//
 	NetworkID string `json:"NetworkID,omitempty"`
+
+	// Policy is the ingress firewall policy of the container on this
+	// network, which takes precedence over the policy of network.
+	//
+	Policy *NetworkPolicy `json:"Policy,omitempty"`
 }
 
 // Validate validates this endpoint settings
@@ -74,6 +79,10 @@ func (m *EndpointSettings) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -98,6 +107,24 @@ func (m *EndpointSettings) validateIPAMConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *EndpointSettings) validatePolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Policy) { // not required
+		return nil
+	}
+
+	if m.Policy != nil {
+		if err := m.Policy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *EndpointSettings) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -100,7 +100,10 @@ type HostConfig struct {
 	// Masks over the provided paths inside the container.
 	MaskedPaths []string `json:"MaskedPaths"`
 
-	// Network mode to use for this container. Supported standard values are: `netns:<path>`, `bridge`, `host`, `none`, and `container:<name|id>`. Any other value is taken as a custom network's name to which this container should connect to.
+	// Specification for mounts to be added to the container, it is the structured alternative of `Binds`.
+	Mounts []*Mount `json:"Mounts"`
+
+	// Network mode to use for this container. Supported standard values are: `netns:<path>`, `bridge`, `host`, `none`, `container:<name|id>`, and `cni[:<network>]`. Any other value is taken as a custom network's name to which this container should connect to.
 	NetworkMode string `json:"NetworkMode,omitempty"`
 
 	// An integer value containing the score given to the container in order to tune OOM killer preferences.
@@ -225,6 +228,8 @@ func (m *HostConfig) UnmarshalJSON(raw []byte) error {
 
 		MaskedPaths []string `json:"MaskedPaths"`
 
+		Mounts []*Mount `json:"Mounts"`
+
 		NetworkMode string `json:"NetworkMode,omitempty"`
 
 		OomScoreAdj int64 `json:"OomScoreAdj,omitempty"`
@@ -313,6 +318,8 @@ func (m *HostConfig) UnmarshalJSON(raw []byte) error {
 
 	m.MaskedPaths = dataAO0.MaskedPaths
 
+	m.Mounts = dataAO0.Mounts
+
 	m.NetworkMode = dataAO0.NetworkMode
 
 	m.OomScoreAdj = dataAO0.OomScoreAdj
@@ -412,6 +419,8 @@ func (m HostConfig) MarshalJSON() ([]byte, error) {
 
 		MaskedPaths []string `json:"MaskedPaths"`
 
+		Mounts []*Mount `json:"Mounts"`
+
 		NetworkMode string `json:"NetworkMode,omitempty"`
 
 		OomScoreAdj int64 `json:"OomScoreAdj,omitempty"`
@@ -497,6 +506,8 @@ func (m HostConfig) MarshalJSON() ([]byte, error) {
 
 	dataAO0.MaskedPaths = m.MaskedPaths
 
+	dataAO0.Mounts = m.Mounts
+
 	dataAO0.NetworkMode = m.NetworkMode
 
 	dataAO0.OomScoreAdj = m.OomScoreAdj
@@ -572,6 +583,10 @@ func (m *HostConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMounts(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateOomScoreAdj(formats); err != nil {
 		res = append(res, err)
 	}
@@ -685,6 +700,31 @@ func (m *HostConfig) validateLogConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *HostConfig) validateMounts(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Mounts) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Mounts); i++ {
+		if swag.IsZero(m.Mounts[i]) { // not required
+			continue
+		}
+
+		if m.Mounts[i] != nil {
+			if err := m.Mounts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Mounts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *HostConfig) validateOomScoreAdj(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.OomScoreAdj) { // not required
@@ -22,12 +22,18 @@ type ImageInfo struct {
 	// config
 	Config *ContainerConfig `json:"Config,omitempty"`
 
+	// the number of containers using the image.
+	Containers int64 `json:"Containers,omitempty"`
+
 	// time of image creation.
 	CreatedAt string `json:"CreatedAt,omitempty"`
 
 	// ID of an image.
 	ID string `json:"Id,omitempty"`
 
+	// the last time the image is pulled or used by container, empty if unknown.
+	LastUsed string `json:"LastUsed,omitempty"`
+
 	// the name of the operating system.
 	Os string `json:"Os,omitempty"`
 
@@ -42,6 +48,9 @@ type ImageInfo struct {
 
 	// size of image's taking disk space.
 	Size int64 `json:"Size,omitempty"`
+
+	// the variant of the CPU architecture, such as v7 for arm.
+	Variant string `json:"Variant,omitempty"`
 }
 
 // Validate validates this image info
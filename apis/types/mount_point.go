@@ -47,6 +47,9 @@ type MountPoint struct {
 	// source
 	Source string `json:"Source,omitempty"`
 
+	// The subdirectory of volume which is mounted into container.
+	Subpath string `json:"Subpath,omitempty"`
+
 	// type
 	Type string `json:"Type,omitempty"`
 }
@@ -35,6 +35,9 @@ type NetworkCreate struct {
 
 	// options
 	Options map[string]string `json:"Options,omitempty"`
+
+	// Policy is the default ingress firewall policy of containers on the network.
+	Policy *NetworkPolicy `json:"Policy,omitempty"`
 }
 
 // Validate validates this network create
@@ -45,6 +48,10 @@ func (m *NetworkCreate) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -69,6 +76,24 @@ func (m *NetworkCreate) validateIPAM(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *NetworkCreate) validatePolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Policy) { // not required
+		return nil
+	}
+
+	if m.Policy != nil {
+		if err := m.Policy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *NetworkCreate) MarshalBinary() ([]byte, error) {
 	if m == nil {
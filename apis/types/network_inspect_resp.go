@@ -6,15 +6,21 @@ package types
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	"github.com/go-openapi/errors"
 	strfmt "github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // NetworkInspectResp is the expected body of the 'GET networks/{id}'' http request message
 // swagger:model NetworkInspectResp
 type NetworkInspectResp struct {
 
+	// Containers contains the endpoints of containers attached to the network, keyed by container ID.
+	Containers map[string]EndpointResource `json:"Containers,omitempty"`
+
 	// Driver means the network's driver.
 	Driver string `json:"Driver,omitempty"`
 
@@ -39,6 +45,15 @@ type NetworkInspectResp struct {
 	// Options holds the network specific options to use for when creating the network.
 	Options map[string]string `json:"Options,omitempty"`
 
+	// Policy is the default ingress firewall policy of containers on the network.
+	Policy *NetworkPolicy `json:"Policy,omitempty"`
+
+	// PolicyRules are the effective iptables rules compiled from the policies of network and containers.
+	PolicyRules []string `json:"PolicyRules"`
+
+	// Reservations holds the static addresses reserved on the network.
+	Reservations []*NetworkReservation `json:"Reservations"`
+
 	// Scope describes the level at which the network exists.
 	Scope string `json:"Scope,omitempty"`
 }
@@ -47,16 +62,50 @@ type NetworkInspectResp struct {
 func (m *NetworkInspectResp) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateContainers(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateIPAM(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validatePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReservations(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *NetworkInspectResp) validateContainers(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Containers) { // not required
+		return nil
+	}
+
+	for k := range m.Containers {
+
+		if err := validate.Required("Containers"+"."+k, "body", m.Containers[k]); err != nil {
+			return err
+		}
+		if val, ok := m.Containers[k]; ok {
+			if err := val.Validate(formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *NetworkInspectResp) validateIPAM(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.IPAM) { // not required
@@ -75,6 +124,49 @@ func (m *NetworkInspectResp) validateIPAM(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *NetworkInspectResp) validatePolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Policy) { // not required
+		return nil
+	}
+
+	if m.Policy != nil {
+		if err := m.Policy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("Policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *NetworkInspectResp) validateReservations(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Reservations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Reservations); i++ {
+		if swag.IsZero(m.Reservations[i]) { // not required
+			continue
+		}
+
+		if m.Reservations[i] != nil {
+			if err := m.Reservations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Reservations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *NetworkInspectResp) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -7,6 +7,7 @@ package types
 
 import (
 	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	strfmt "github.com/go-openapi/strfmt"
@@ -211,6 +212,11 @@ type SystemInfo struct {
 	//
 	ServerVersion string `json:"ServerVersion,omitempty"`
 
+	// The snapshotters of containerd and their usage. The usage is cached
+	// and refreshed in background at most once a minute.
+	//
+	Snapshotters []*SnapshotterInfo `json:"Snapshotters"`
+
 	// The list of volume drivers which the pouchd supports
 	//
 	VolumeDrivers []string `json:"VolumeDrivers"`
@@ -240,6 +246,10 @@ func (m *SystemInfo) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSnapshotters(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -365,6 +375,31 @@ func (m *SystemInfo) validateRuntimes(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *SystemInfo) validateSnapshotters(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Snapshotters) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Snapshotters); i++ {
+		if swag.IsZero(m.Snapshotters[i]) { // not required
+			continue
+		}
+
+		if m.Snapshotters[i] != nil {
+			if err := m.Snapshotters[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("Snapshotters" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *SystemInfo) MarshalBinary() ([]byte, error) {
 	if m == nil {
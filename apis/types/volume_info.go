@@ -40,6 +40,9 @@ type VolumeInfo struct {
 
 	// Status provides low-level status information about the volume.
 	Status map[string]interface{} `json:"Status,omitempty"`
+
+	// usage data
+	UsageData *VolumeUsageData `json:"UsageData,omitempty"`
 }
 
 // Validate validates this volume info
@@ -50,6 +53,10 @@ func (m *VolumeInfo) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateUsageData(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -93,6 +100,25 @@ func (m *VolumeInfo) validateStatus(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *VolumeInfo) validateUsageData(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.UsageData) { // not required
+		return nil
+	}
+
+	if m.UsageData != nil {
+
+		if err := m.UsageData.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("UsageData")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *VolumeInfo) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -71,6 +71,7 @@ func addCommonFlags(flagSet *pflag.FlagSet) *container {
 	flagSet.StringVar(&c.macAddress, "mac-address", "", "Set mac address of container endpoint")
 	flagSet.StringVar(&c.ip, "ip", "", "Set IPv4 address of container endpoint")
 	flagSet.StringVar(&c.ipv6, "ip6", "", "Set IPv6 address of container endpoint")
+	flagSet.StringArrayVar(&c.ingress, "ingress", nil, "Set ingress rules of container endpoint, format: from=key=value,port=port[-port][/protocol] or none")
 	flagSet.Int64Var(&c.netPriority, "net-priority", 0, "net priority")
 	// dns
 	flagSet.StringArrayVar(&c.dns, "dns", nil, "Set DNS servers")
@@ -95,9 +96,10 @@ func addCommonFlags(flagSet *pflag.FlagSet) *container {
 
 	flagSet.StringVar(&c.utsMode, "uts", "", "UTS namespace to use")
 
-	flagSet.VarP(config.NewVolumes(&c.volume), "volume", "v", "Bind mount volumes to container, format is: [source:]<destination>[:mode], [source] can be volume or host's path, <destination> is container's path, [mode] can be \"ro/rw/dr/rr/z/Z/nocopy/private/rprivate/slave/rslave/shared/rshared\"")
+	flagSet.VarP(config.NewVolumes(&c.volume), "volume", "v", "Bind mount volumes to container, format is: [source:]<destination>[:mode], [source] can be volume or host's path, <destination> is container's path, [mode] can be \"ro/rw/dr/rr/z/Z/nocopy/private/rprivate/slave/rslave/shared/rshared/subpath=<dir>\"")
 	flagSet.StringSliceVar(&c.volumesFrom, "volumes-from", nil, "set volumes from other containers, format is <container>[:mode]")
 	flagSet.StringVar(&c.volumeDriver, "volume-driver", "", "set volume driver for container's volumes")
+	flagSet.StringArrayVar(&c.mounts, "mount", nil, "Attach a filesystem mount to the container, format is: type=<bind|volume|tmpfs>,[source=<src>],target=<dst>[,readonly][,bind-propagation=<mode>][,volume-driver=<driver>][,volume-opt=<key>=<value>][,volume-nocopy][,volume-subpath=<dir>][,volume-label=<key>=<value>][,tmpfs-size=<size>][,tmpfs-mode=<octal>]")
 
 	flagSet.StringVarP(&c.workdir, "workdir", "w", "", "Set the working directory in a container")
 	flagSet.Var(&c.ulimit, "ulimit", "Set container ulimit")
@@ -112,9 +114,12 @@ func addCommonFlags(flagSet *pflag.FlagSet) *container {
 	flagSet.StringVarP(&c.cgroupParent, "cgroup-parent", "", "", "Optional parent cgroup for the container")
 
 	// disk quota
-	flagSet.StringSliceVar(&c.diskQuota, "disk-quota", nil, "Set disk quota for container")
+	flagSet.StringSliceVar(&c.diskQuota, "disk-quota", nil, "Set disk quota for container(/=10g), inodes=N sets the inode limit")
 	flagSet.StringVar(&c.quotaID, "quota-id", "", "Specified quota id, if id < 0, it means pouchd alloc a unique quota id")
 
+	// platform of image
+	flagSet.StringVar(&c.platform, "platform", "", "Set platform of the image if the image supports multiple platforms, such as linux/arm64")
+
 	// additional runtime spec annotations
 	flagSet.StringArrayVar(&c.specAnnotation, "annotation", nil, "Additional annotation for runtime")
 
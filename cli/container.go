@@ -17,6 +17,7 @@ type container struct {
 	volume              config.Volumes
 	volumesFrom         []string
 	volumeDriver        string
+	mounts              []string
 	runtime             string
 	env                 []string
 	envfile             []string
@@ -70,6 +71,7 @@ type container struct {
 	publishAll  bool
 	ip          string
 	ipv6        string
+	ingress     []string
 	macAddress  string
 	netPriority int64
 	dns         []string
@@ -84,6 +86,7 @@ type container struct {
 	quotaID        string
 	oomScoreAdj    int64
 	specAnnotation []string
+	platform       string
 	cgroupParent   string
 	ulimit         config.Ulimit
 	pidsLimit      int64
@@ -178,6 +181,14 @@ func (c *container) config() (*types.ContainerCreateConfig, error) {
 		return nil, err
 	}
 
+	policy, err := opts.ParseNetworkPolicy(c.ingress)
+	if err != nil {
+		return nil, err
+	}
+	if err := opts.SetEndpointPolicy(networkingConfig, networkMode, policy); err != nil {
+		return nil, err
+	}
+
 	if err := opts.ValidateNetworks(networkingConfig); err != nil {
 		return nil, err
 	}
@@ -206,6 +217,11 @@ func (c *container) config() (*types.ContainerCreateConfig, error) {
 		return nil, err
 	}
 
+	mounts, err := opts.ParseMounts(c.mounts)
+	if err != nil {
+		return nil, err
+	}
+
 	config := &types.ContainerCreateConfig{
 		ContainerConfig: types.ContainerConfig{
 			Tty:                 c.tty,
@@ -226,12 +242,14 @@ func (c *container) config() (*types.ContainerCreateConfig, error) {
 			NetPriority:         c.netPriority,
 			SpecificID:          c.specificID,
 			MacAddress:          c.macAddress,
+			Platform:            c.platform,
 		},
 
 		HostConfig: &types.HostConfig{
 			Binds:        c.volume.Value(),
 			VolumesFrom:  c.volumesFrom,
 			VolumeDriver: c.volumeDriver,
+			Mounts:       mounts,
 			Runtime:      c.runtime,
 			Resources: types.Resources{
 				// cpu
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// containerMgmtDescription is used to describe container command in detail and auto generate command doc.
+var containerMgmtDescription = "Manage Pouch container"
+
+// ContainerMgmtCommand use to implement 'container' command.
+type ContainerMgmtCommand struct {
+	baseCommand
+}
+
+// Init initialize "container" command.
+func (c *ContainerMgmtCommand) Init(cli *Cli) {
+	c.cli = cli
+
+	c.cmd = &cobra.Command{
+		Use:   "container",
+		Short: "Manage container",
+		Long:  containerMgmtDescription,
+		Args:  cobra.NoArgs,
+	}
+
+	c.cli.AddCommand(c, &ContainerMigrateSnapshotCommand{})
+}
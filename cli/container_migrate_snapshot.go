@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// containerMigrateSnapshotDescription is used to describe container migrate-snapshot command in detail and auto generate command doc.
+var containerMigrateSnapshotDescription = "Move the writable layer of a stopped container to another snapshotter, " +
+	"such as from overlayfs to native or devmapper. The image of container is unpacked into the snapshotter " +
+	"if needed, then the changes of writable layer are diffed and reapplied on a new snapshot. " +
+	"The snapshotter other than the one of daemon is allowed only if pouchd runs with --allow-multi-snapshotter."
+
+// ContainerMigrateSnapshotCommand use to implement 'container migrate-snapshot' command.
+type ContainerMigrateSnapshotCommand struct {
+	baseCommand
+	snapshotter string
+}
+
+// Init initialize "container migrate-snapshot" command.
+func (m *ContainerMigrateSnapshotCommand) Init(c *Cli) {
+	m.cli = c
+	m.cmd = &cobra.Command{
+		Use:   "migrate-snapshot [OPTIONS] CONTAINER",
+		Short: "Move the writable layer of a stopped container to another snapshotter",
+		Long:  containerMigrateSnapshotDescription,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return m.runMigrateSnapshot(args)
+		},
+		Example: m.example(),
+	}
+	m.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (m *ContainerMigrateSnapshotCommand) addFlags() {
+	m.cmd.Flags().StringVar(&m.snapshotter, "snapshotter", "", "Snapshotter to migrate the writable layer to")
+}
+
+// runMigrateSnapshot is the entry of container migrate-snapshot command.
+func (m *ContainerMigrateSnapshotCommand) runMigrateSnapshot(args []string) error {
+	if m.snapshotter == "" {
+		return fmt.Errorf("snapshotter must be specified by --snapshotter")
+	}
+
+	ctx := context.Background()
+	apiClient := m.cli.Client()
+
+	if err := apiClient.ContainerMigrateSnapshot(ctx, args[0], m.snapshotter); err != nil {
+		return fmt.Errorf("failed to migrate snapshot of container %s: %v", args[0], err)
+	}
+
+	fmt.Println(args[0])
+	return nil
+}
+
+// example shows examples in migrate-snapshot command, and is used in auto-generated cli docs.
+func (m *ContainerMigrateSnapshotCommand) example() string {
+	return `$ pouch stop foo
+foo
+$ pouch container migrate-snapshot --snapshotter native foo
+foo
+$ pouch inspect -f {{.Snapshotter.Name}} foo
+native`
+}
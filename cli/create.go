@@ -71,7 +71,7 @@ func (cc *CreateCommand) runCreate(args []string) error {
 
 	ctx := context.Background()
 	apiClient := cc.cli.Client()
-	if err := pullMissingImage(ctx, apiClient, config.Image, false); err != nil {
+	if err := pullMissingImage(ctx, apiClient, config.Image, cc.platform, false); err != nil {
 		return err
 	}
 
@@ -16,7 +16,10 @@ import (
 // imagesDescription is used to describe image command in detail and auto generate command doc.
 var imagesDescription = "List all images in Pouchd. " +
 	"This is useful when you wish to have a look at images and Pouchd will show all local images with their NAME and SIZE. " +
-	"All local images will be shown in a table format you can use."
+	"All local images will be shown in a table format you can use. " +
+	"The images can be filtered by reference glob pattern, created before or since an image, platform, " +
+	"dangling (without any tag), label of image config in form of key or key=value, " +
+	"and unused (not used by any container)."
 
 type imageSize int64
 
@@ -65,7 +68,7 @@ func (i *ImagesCommand) addFlags() {
 	flagSet.BoolVarP(&i.flagQuiet, "quiet", "q", false, "Only show image numeric ID")
 	flagSet.BoolVar(&i.flagDigest, "digest", false, "Show images with digest")
 	flagSet.BoolVar(&i.flagNoTrunc, "no-trunc", false, "Do not truncate output")
-	flagSet.StringSliceVarP(&i.flagFilter, "filter", "f", []string{}, "Filter output based on conditions provided, filter support reference, since, before")
+	flagSet.StringSliceVarP(&i.flagFilter, "filter", "f", []string{}, "Filter output based on conditions provided, filter support reference, since, before, platform, dangling, label, unused")
 }
 
 // runImages is the entry of images container command.
@@ -211,5 +214,9 @@ IMAGE ID       IMAGE NAME                                           DIGEST
 $ pouch images --no-trunc
 IMAGE ID                                                                  IMAGE NAME                                           SIZE
 sha256:2cb0d9787c4dd17ef9eb03e512923bc4db10add190d3f84af63b744e353a9b34   registry.hub.docker.com/library/hello-world:latest   6.30 KB
-sha256:4ab4c602aa5eed5528a6620ff18a1dc4faef0e1ab3a5eddeddb410714478c67f   registry.hub.docker.com/library/hello-world:linux    5.25 KB`
+sha256:4ab4c602aa5eed5528a6620ff18a1dc4faef0e1ab3a5eddeddb410714478c67f   registry.hub.docker.com/library/hello-world:linux    5.25 KB
+
+$ pouch images --filter unused=true --filter label=maintainer
+IMAGE ID             IMAGE NAME                                               SIZE
+b81f317384d7         docker.io/library/nginx:latest                           42.39 MB`
 }
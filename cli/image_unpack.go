@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// imageUnpackDescription is used to describe image unpack command in detail and auto generate command doc.
+var imageUnpackDescription = "Unpack the layers of image into a snapshotter, so containers using the snapshotter " +
+	"can be created from it. The snapshotter of daemon is used if it's not specified, and other snapshotters " +
+	"are allowed only if pouchd runs with --allow-multi-snapshotter."
+
+// ImageUnpackCommand use to implement 'image unpack' command.
+type ImageUnpackCommand struct {
+	baseCommand
+	snapshotter string
+}
+
+// Init initialize "image unpack" command.
+func (i *ImageUnpackCommand) Init(c *Cli) {
+	i.cli = c
+	i.cmd = &cobra.Command{
+		Use:   "unpack [OPTIONS] IMAGE [IMAGE...]",
+		Short: "Unpack one or more images into a snapshotter",
+		Long:  imageUnpackDescription,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return i.runUnpack(args)
+		},
+		Example: i.example(),
+	}
+	i.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (i *ImageUnpackCommand) addFlags() {
+	i.cmd.Flags().StringVar(&i.snapshotter, "snapshotter", "", "Snapshotter to unpack the image into")
+}
+
+// runUnpack is used to unpack images.
+func (i *ImageUnpackCommand) runUnpack(args []string) error {
+	ctx := context.Background()
+	apiClient := i.cli.Client()
+
+	var errs []string
+	for _, name := range args {
+		if err := apiClient.ImageUnpack(ctx, name, i.snapshotter); err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		fmt.Println(name)
+	}
+
+	if len(errs) > 0 {
+		return errors.New("failed to unpack images: " + strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// example shows examples in unpack command, and is used in auto-generated cli docs.
+func (i *ImageUnpackCommand) example() string {
+	return `$ pouch image unpack --snapshotter native registry.hub.docker.com/library/busybox:latest
+registry.hub.docker.com/library/busybox:latest`
+}
@@ -66,6 +66,17 @@ func prettyPrintInfo(cli *Cli, info *types.SystemInfo) error {
 	fmt.Fprintf(os.Stdout, "Server Version: %s\n", info.ServerVersion)
 	fmt.Fprintf(os.Stdout, "Storage Driver: %s\n", info.Driver)
 	fmt.Fprintf(os.Stdout, "Driver Status: %v\n", info.DriverStatus)
+	if len(info.Snapshotters) > 0 {
+		fmt.Fprintln(os.Stdout, "Snapshotters:")
+		for _, sn := range info.Snapshotters {
+			fmt.Fprintf(os.Stdout, " %s: status=%s snapshots=%d size=%s inodes=%d", sn.Name, sn.Status,
+				sn.Snapshots, units.HumanSize(float64(sn.Size)), sn.Inodes)
+			if sn.Default {
+				fmt.Fprint(os.Stdout, " (default)")
+			}
+			fmt.Fprint(os.Stdout, "\n")
+		}
+	}
 	fmt.Fprintf(os.Stdout, "Logging Driver: %s\n", info.LoggingDriver)
 	fmt.Fprintf(os.Stdout, "Volume Drivers: %v\n", info.VolumeDrivers)
 	fmt.Fprintf(os.Stdout, "Cgroup Driver: %s\n", info.CgroupDriver)
@@ -141,8 +152,12 @@ Images:  0
 ID:
 Name:
 Server Version: 0.3-dev
-Storage Driver:
+Storage Driver: overlayfs
 Driver Status: []
+Snapshotters:
+ btrfs: status=error snapshots=0 size=0B inodes=0
+ native: status=ok snapshots=4 size=1.39MB inodes=501
+ overlayfs: status=ok snapshots=12 size=14.5MB inodes=3722 (default)
 Logging Driver:
 Cgroup Driver:
 runc: <nil>
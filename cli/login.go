@@ -12,7 +12,9 @@ import (
 )
 
 // loginDescription is used to describe login command and auto generate command doc.
-var loginDescription = "\nlogin to a v1/v2 registry with the provided credentials."
+var loginDescription = "\nlogin to a v1/v2 registry with the provided credentials. " +
+	"The credentials are kept by the credential helper docker-credential-<name> if credsStore or credHelpers " +
+	"is set in ~/.pouch/config.json, otherwise they are saved in the file."
 
 // LoginCommand use to implement 'login' command.
 type LoginCommand struct {
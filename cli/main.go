@@ -27,15 +27,18 @@ func main() {
 	cli.AddCommand(base, &VersionCommand{})
 	cli.AddCommand(base, &InfoCommand{})
 	cli.AddCommand(base, &ImageMgmtCommand{})
+	cli.AddCommand(base, &ContainerMgmtCommand{})
 	cli.AddCommand(base, &ImagesCommand{})
 	cli.AddCommand(base, &RmiCommand{})
 	cli.AddCommand(base, &VolumeCommand{})
+	cli.AddCommand(base, &SystemCommand{})
 	cli.AddCommand(base, &NetworkCommand{})
 	cli.AddCommand(base, &TagCommand{})
 	cli.AddCommand(base, &LoadCommand{})
 	cli.AddCommand(base, &SaveCommand{})
 	cli.AddCommand(base, &HistoryCommand{})
 	cli.AddCommand(base, &SearchCommand{})
+	cli.AddCommand(base, &ManifestCommand{})
 
 	cli.AddCommand(base, &InspectCommand{})
 	cli.AddCommand(base, &RenameCommand{})
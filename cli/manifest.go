@@ -0,0 +1,294 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/reference"
+
+	"github.com/spf13/cobra"
+)
+
+// manifestDescription is used to describe manifest command in detail and auto generate command doc.
+var manifestDescription = "\nManage the manifests and manifest lists of images in registry. " +
+	"A manifest list of images built on different platforms can be created, annotated locally and then pushed to registry."
+
+// ManifestCommand use to implement 'manifest' command.
+type ManifestCommand struct {
+	baseCommand
+}
+
+// Init initialize manifest command.
+func (m *ManifestCommand) Init(c *Cli) {
+	m.cli = c
+	m.cmd = &cobra.Command{
+		Use:   "manifest COMMAND",
+		Short: "Manage image manifests in registry",
+		Long:  manifestDescription,
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	// add subcommands
+	c.AddCommand(m, &ManifestInspectCommand{})
+	c.AddCommand(m, &ManifestCreateCommand{})
+	c.AddCommand(m, &ManifestAnnotateCommand{})
+	c.AddCommand(m, &ManifestPushCommand{})
+}
+
+// manifestInspectDescription is used to describe manifest inspect command in detail and auto generate command doc.
+var manifestInspectDescription = "Display the manifest or manifest list of an image in registry without pulling it."
+
+// ManifestInspectCommand use to implement 'manifest inspect' command.
+type ManifestInspectCommand struct {
+	ManifestCommand
+
+	verbose bool
+}
+
+// Init initialize manifest inspect command.
+func (mi *ManifestInspectCommand) Init(c *Cli) {
+	mi.cli = c
+	mi.cmd = &cobra.Command{
+		Use:   "inspect [OPTIONS] IMAGE[:TAG|@DIGEST]",
+		Short: "Display the manifest of an image in registry",
+		Long:  manifestInspectDescription,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return mi.runManifestInspect(args[0])
+		},
+		Example: manifestInspectExample(),
+	}
+	mi.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (mi *ManifestInspectCommand) addFlags() {
+	flagSet := mi.cmd.Flags()
+	flagSet.BoolVarP(&mi.verbose, "verbose", "v", false, "Show the descriptor and platforms besides the manifest")
+}
+
+// runManifestInspect is the entry of manifest inspect command.
+func (mi *ManifestInspectCommand) runManifestInspect(ref string) error {
+	ctx := context.Background()
+	apiClient := mi.cli.Client()
+
+	namedRef, err := reference.Parse(ref)
+	if err != nil {
+		return err
+	}
+	namedRef = reference.WithDefaultTagIfMissing(namedRef)
+
+	result, err := apiClient.DistributionInspect(ctx, namedRef.String(), fetchRegistryAuth(namedRef.Name()))
+	if err != nil {
+		return err
+	}
+
+	var output interface{} = result.Manifest
+	if mi.verbose {
+		output = result
+	}
+
+	data, err := json.MarshalIndent(output, "", "    ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stdout, string(data))
+	return nil
+}
+
+// manifestInspectExample shows examples in manifest inspect command, and is used in auto-generated cli docs.
+func manifestInspectExample() string {
+	return `$ pouch manifest inspect busybox:1.28
+{
+    "manifests": [
+        {
+            "digest": "sha256:58ac43b2cc92c687a32c8be6278e50a063579655fe3090125dcb2af0ff9e1a64",
+            "mediaType": "application/vnd.docker.distribution.manifest.v2+json",
+            "platform": {
+                "architecture": "amd64",
+                "os": "linux"
+            },
+            "size": 527
+        }
+    ],
+    "mediaType": "application/vnd.docker.distribution.manifest.list.v2+json",
+    "schemaVersion": 2
+}`
+}
+
+// manifestCreateDescription is used to describe manifest create command in detail and auto generate command doc.
+var manifestCreateDescription = "Create a manifest list of images in registry. The images should be in the same registry as " +
+	"the manifest list, and the list is stored locally until it is pushed."
+
+// ManifestCreateCommand use to implement 'manifest create' command.
+type ManifestCreateCommand struct {
+	ManifestCommand
+
+	amend bool
+}
+
+// Init initialize manifest create command.
+func (mc *ManifestCreateCommand) Init(c *Cli) {
+	mc.cli = c
+	mc.cmd = &cobra.Command{
+		Use:   "create [OPTIONS] MANIFEST_LIST IMAGE [IMAGE...]",
+		Short: "Create a local manifest list of images",
+		Long:  manifestCreateDescription,
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return mc.runManifestCreate(args[0], args[1:])
+		},
+		Example: manifestCreateExample(),
+	}
+	mc.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (mc *ManifestCreateCommand) addFlags() {
+	flagSet := mc.cmd.Flags()
+	flagSet.BoolVarP(&mc.amend, "amend", "a", false, "Add the images to an existing manifest list")
+}
+
+// runManifestCreate is the entry of manifest create command.
+func (mc *ManifestCreateCommand) runManifestCreate(name string, images []string) error {
+	ctx := context.Background()
+	apiClient := mc.cli.Client()
+
+	namedRef, err := reference.Parse(name)
+	if err != nil {
+		return err
+	}
+
+	config := &types.ManifestCreateConfig{
+		Name:   name,
+		Images: images,
+		Amend:  mc.amend,
+	}
+	if err := apiClient.ManifestCreate(ctx, config, fetchRegistryAuth(namedRef.Name())); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stdout, "Created manifest list %s\n", name)
+	return nil
+}
+
+// manifestCreateExample shows examples in manifest create command, and is used in auto-generated cli docs.
+func manifestCreateExample() string {
+	return `$ pouch manifest create reg.example.com/app:v1 reg.example.com/app:v1-amd64 reg.example.com/app:v1-arm64
+Created manifest list reg.example.com/app:v1`
+}
+
+// manifestAnnotateDescription is used to describe manifest annotate command in detail and auto generate command doc.
+var manifestAnnotateDescription = "Set the platform of an image in a local manifest list. " +
+	"By default the platform is read from the image config when the manifest list is created."
+
+// ManifestAnnotateCommand use to implement 'manifest annotate' command.
+type ManifestAnnotateCommand struct {
+	ManifestCommand
+
+	os      string
+	arch    string
+	variant string
+}
+
+// Init initialize manifest annotate command.
+func (ma *ManifestAnnotateCommand) Init(c *Cli) {
+	ma.cli = c
+	ma.cmd = &cobra.Command{
+		Use:   "annotate [OPTIONS] MANIFEST_LIST IMAGE",
+		Short: "Set the platform of an image in a local manifest list",
+		Long:  manifestAnnotateDescription,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return ma.runManifestAnnotate(args[0], args[1])
+		},
+		Example: manifestAnnotateExample(),
+	}
+	ma.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (ma *ManifestAnnotateCommand) addFlags() {
+	flagSet := ma.cmd.Flags()
+	flagSet.StringVar(&ma.os, "os", "", "Set the operating system of image")
+	flagSet.StringVar(&ma.arch, "arch", "", "Set the CPU architecture of image")
+	flagSet.StringVar(&ma.variant, "variant", "", "Set the variant of CPU architecture, such as v7 of arm")
+}
+
+// runManifestAnnotate is the entry of manifest annotate command.
+func (ma *ManifestAnnotateCommand) runManifestAnnotate(name, image string) error {
+	ctx := context.Background()
+	apiClient := ma.cli.Client()
+
+	if ma.os == "" && ma.arch == "" && ma.variant == "" {
+		return fmt.Errorf("at least one of --os, --arch and --variant should be set")
+	}
+
+	return apiClient.ManifestAnnotate(ctx, name, &types.ManifestAnnotateConfig{
+		Image:        image,
+		Os:           ma.os,
+		Architecture: ma.arch,
+		Variant:      ma.variant,
+	})
+}
+
+// manifestAnnotateExample shows examples in manifest annotate command, and is used in auto-generated cli docs.
+func manifestAnnotateExample() string {
+	return `$ pouch manifest annotate --arch arm --variant v7 reg.example.com/app:v1 reg.example.com/app:v1-armv7`
+}
+
+// manifestPushDescription is used to describe manifest push command in detail and auto generate command doc.
+var manifestPushDescription = "Push a local manifest list to registry. The manifests of images are pushed with the list, " +
+	"and the layers are mounted from the repositories of images instead of being uploaded."
+
+// ManifestPushCommand use to implement 'manifest push' command.
+type ManifestPushCommand struct {
+	ManifestCommand
+}
+
+// Init initialize manifest push command.
+func (mp *ManifestPushCommand) Init(c *Cli) {
+	mp.cli = c
+	mp.cmd = &cobra.Command{
+		Use:   "push MANIFEST_LIST",
+		Short: "Push a manifest list to registry",
+		Long:  manifestPushDescription,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return mp.runManifestPush(args[0])
+		},
+		Example: manifestPushExample(),
+	}
+}
+
+// runManifestPush is the entry of manifest push command.
+func (mp *ManifestPushCommand) runManifestPush(name string) error {
+	ctx := context.Background()
+	apiClient := mp.cli.Client()
+
+	namedRef, err := reference.Parse(name)
+	if err != nil {
+		return err
+	}
+
+	responseBody, err := apiClient.ManifestPush(ctx, name, fetchRegistryAuth(namedRef.Name()))
+	if err != nil {
+		return fmt.Errorf("failed to push manifest list: %v", err)
+	}
+	defer responseBody.Close()
+
+	return showProgress(responseBody)
+}
+
+// manifestPushExample shows examples in manifest push command, and is used in auto-generated cli docs.
+func manifestPushExample() string {
+	return `$ pouch manifest push reg.example.com/app:v1
+index-sha256:3b2d8f2d8c5bc7d8d5c5b9c0e5dbe5b1c1e5b7e4c1b95d3a8f0e6a4d6d5c2a91:    done
+manifest-sha256:58ac43b2cc92c687a32c8be6278e50a063579655fe3090125dcb2af0ff9e1a64: done
+manifest-sha256:a3a2e1b3e2a7c1d4f5e6b7a8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8: done
+layer-sha256:56bec22e355981d8ba0878c6c2f23b21f422f30ab0aba188b54f1ffeff59c190:    done
+config-sha256:e02e811dd08fd49e7f6032625495118e63f597eb150403d02e3238af1df240ba:   done
+elapsed: 0.0 s                                                                    total:   0.0 B (0.0 B/s)`
+}
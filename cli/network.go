@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/alibaba/pouch/apis/opts"
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/cli/inspect"
 	"github.com/alibaba/pouch/pkg/log"
@@ -43,6 +44,8 @@ func (n *NetworkCommand) Init(c *Cli) {
 	c.AddCommand(n, &NetworkListCommand{})
 	c.AddCommand(n, &NetworkConnectCommand{})
 	c.AddCommand(n, &NetworkDisconnectCommand{})
+	c.AddCommand(n, &NetworkReserveCommand{})
+	c.AddCommand(n, &NetworkUnreserveCommand{})
 }
 
 // networkCreateDescription is used to describe network create command in detail and auto generate command doc.
@@ -63,6 +66,7 @@ type NetworkCreateCommand struct {
 	enableIPv6 bool
 	options    []string
 	labels     []string
+	ingress    []string
 }
 
 // Init initializes NetworkCreateCommand command.
@@ -97,6 +101,7 @@ func (n *NetworkCreateCommand) addFlags() {
 	flagSet.BoolVar(&n.enableIPv6, "enable-ipv6", false, "enable ipv6 network")
 	flagSet.StringSliceVarP(&n.options, "option", "o", nil, "create network with options")
 	flagSet.StringSliceVarP(&n.labels, "label", "l", nil, "create network with labels")
+	flagSet.StringArrayVar(&n.ingress, "ingress", nil, "default ingress rules of containers on network, format: from=key=value,port=port[-port][/protocol] or none")
 }
 
 // runNetworkCreate is the entry of NetworkCreateCommand command.
@@ -165,6 +170,11 @@ func (n *NetworkCreateCommand) buildNetworkCreateRequest(name string) (*types.Ne
 		ipam.Config = append(ipam.Config, ipamConfig)
 	}
 
+	policy, err := opts.ParseNetworkPolicy(n.ingress)
+	if err != nil {
+		return nil, err
+	}
+
 	networkCreate := types.NetworkCreate{
 		Driver:         n.driver,
 		EnableIPV6:     n.enableIPv6,
@@ -173,6 +183,7 @@ func (n *NetworkCreateCommand) buildNetworkCreateRequest(name string) (*types.Ne
 		Options:        options,
 		Labels:         labels,
 		IPAM:           ipam,
+		Policy:         policy,
 	}
 	networkRequest := &types.NetworkCreateConfig{
 		Name:          name,
@@ -392,6 +403,7 @@ type NetworkConnectCommand struct {
 	links        []string
 	aliases      []string
 	linklocalips []string
+	ingress      []string
 }
 
 // Init initializes NetworkConnectCommand command.
@@ -421,6 +433,7 @@ func (n *NetworkConnectCommand) addFlags() {
 	flagSet.StringSliceVar(&n.links, "link", []string{}, "Add link to another container")
 	flagSet.StringSliceVar(&n.aliases, "alias", []string{}, "Add network-scoped alias for the container")
 	flagSet.StringSliceVar(&n.linklocalips, "link-local-ip", []string{}, "Add a link-local address for the container")
+	flagSet.StringArrayVar(&n.ingress, "ingress", nil, "Set ingress rules of the container on network, format: from=key=value,port=port[-port][/protocol] or none")
 }
 
 // runNetworkConnect is the entry of NetworkConnectCommand command.
@@ -434,6 +447,11 @@ func (n *NetworkConnectCommand) runNetworkConnect(args []string) error {
 		return fmt.Errorf("container name cannot be empty")
 	}
 
+	policy, err := opts.ParseNetworkPolicy(n.ingress)
+	if err != nil {
+		return err
+	}
+
 	networkReq := &types.NetworkConnect{
 		Container: container,
 		EndpointConfig: &types.EndpointSettings{
@@ -444,12 +462,13 @@ func (n *NetworkConnectCommand) runNetworkConnect(args []string) error {
 			},
 			Links:   n.links,
 			Aliases: n.aliases,
+			Policy:  policy,
 		},
 	}
 
 	ctx := context.Background()
 	apiClient := n.cli.Client()
-	err := apiClient.NetworkConnect(ctx, network, networkReq)
+	err = apiClient.NetworkConnect(ctx, network, networkReq)
 	if err != nil {
 		return err
 	}
@@ -524,3 +543,121 @@ func (nd *NetworkDisconnectCommand) networkDisconnectExample() string {
 	return `$ pouch network disconnect bridge test
 container test is disconnected from network bridge successfully`
 }
+
+// networkReserveDescription is used to describe network reserve command in detail and auto generate command doc.
+var networkReserveDescription = "Reserve static addresses on a network for the container with the given name. " +
+	"The reserved addresses are excluded from dynamic allocation, and are assigned to the container " +
+	"whenever it is created, connected or upgraded on the network."
+
+// NetworkReserveCommand is used to implement 'network reserve' command.
+type NetworkReserveCommand struct {
+	baseCommand
+
+	name        string
+	ipAddress   string
+	ipv6Address string
+	macAddress  string
+}
+
+// Init initializes NetworkReserveCommand command.
+func (n *NetworkReserveCommand) Init(c *Cli) {
+	n.cli = c
+
+	n.cmd = &cobra.Command{
+		Use:   "reserve [OPTIONS] NETWORK",
+		Short: "Reserve static addresses on a network for a container",
+		Long:  networkReserveDescription,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return n.runNetworkReserve(args)
+		},
+		Example: networkReserveExample(),
+	}
+
+	n.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (n *NetworkReserveCommand) addFlags() {
+	flagSet := n.cmd.Flags()
+
+	flagSet.StringVar(&n.name, "name", "", "Name of the container which owns the reserved addresses")
+	flagSet.StringVar(&n.ipAddress, "ip", "", "IPv4 address to reserve")
+	flagSet.StringVar(&n.ipv6Address, "ip6", "", "IPv6 address to reserve")
+	flagSet.StringVar(&n.macAddress, "mac", "", "MAC address to reserve")
+}
+
+// runNetworkReserve is the entry of NetworkReserveCommand command.
+func (n *NetworkReserveCommand) runNetworkReserve(args []string) error {
+	network := args[0]
+	if n.name == "" {
+		return fmt.Errorf("container name cannot be empty")
+	}
+
+	reservation := &types.NetworkReservation{
+		Name:        n.name,
+		IPV4Address: n.ipAddress,
+		IPV6Address: n.ipv6Address,
+		MacAddress:  n.macAddress,
+	}
+
+	ctx := context.Background()
+	apiClient := n.cli.Client()
+	if err := apiClient.NetworkReserve(ctx, network, reservation); err != nil {
+		return err
+	}
+	fmt.Printf("addresses are reserved for container %s on network %s\n", n.name, network)
+
+	return nil
+}
+
+// networkReserveExample shows examples in network reserve command, and is used in auto-generated cli docs.
+func networkReserveExample() string {
+	return `$ pouch network reserve net1 --name web --ip 192.168.1.10 --mac 02:42:c0:a8:01:0a
+addresses are reserved for container web on network net1`
+}
+
+// networkUnreserveDescription is used to describe network unreserve command in detail and auto generate command doc.
+var networkUnreserveDescription = "Release the addresses reserved on a network for the container with the given name."
+
+// NetworkUnreserveCommand is used to implement 'network unreserve' command.
+type NetworkUnreserveCommand struct {
+	baseCommand
+}
+
+// Init initializes NetworkUnreserveCommand command.
+func (n *NetworkUnreserveCommand) Init(c *Cli) {
+	n.cli = c
+
+	n.cmd = &cobra.Command{
+		Use:   "unreserve NETWORK NAME",
+		Short: "Release the addresses reserved on a network for a container",
+		Long:  networkUnreserveDescription,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return n.runNetworkUnreserve(args)
+		},
+		Example: networkUnreserveExample(),
+	}
+}
+
+// runNetworkUnreserve is the entry of NetworkUnreserveCommand command.
+func (n *NetworkUnreserveCommand) runNetworkUnreserve(args []string) error {
+	network := args[0]
+	name := args[1]
+
+	ctx := context.Background()
+	apiClient := n.cli.Client()
+	if err := apiClient.NetworkUnreserve(ctx, network, name); err != nil {
+		return err
+	}
+	fmt.Printf("addresses reserved for container %s on network %s are released\n", name, network)
+
+	return nil
+}
+
+// networkUnreserveExample shows examples in network unreserve command, and is used in auto-generated cli docs.
+func networkUnreserveExample() string {
+	return `$ pouch network unreserve net1 web
+addresses reserved for container web on network net1 are released`
+}
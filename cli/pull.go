@@ -19,6 +19,8 @@ import (
 	"github.com/alibaba/pouch/pkg/reference"
 
 	"github.com/containerd/containerd/pkg/progress"
+	"github.com/containerd/containerd/platforms"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/spf13/cobra"
 	"golang.org/x/crypto/ssh/terminal"
 )
@@ -31,6 +33,8 @@ var pullDescription = "Pull an image or a repository from a registry. " +
 // PullCommand use to implement 'pull' command, it download image.
 type PullCommand struct {
 	baseCommand
+
+	platform string
 }
 
 // Init initialize pull command.
@@ -52,12 +56,13 @@ func (p *PullCommand) Init(c *Cli) {
 
 // addFlags adds flags for specific command.
 func (p *PullCommand) addFlags() {
-	// TODO: add flags here
+	flagSet := p.cmd.Flags()
+	flagSet.StringVar(&p.platform, "platform", "", "Pull the image of the platform if the image supports multiple platforms, such as linux/arm64")
 }
 
 // runPull is the entry of pull command.
 func (p *PullCommand) runPull(args []string) error {
-	return pullMissingImage(context.Background(), p.cli.Client(), args[0], true)
+	return pullMissingImage(context.Background(), p.cli.Client(), args[0], p.platform, true)
 }
 
 func fetchRegistryAuth(serverAddress string) string {
@@ -185,19 +190,26 @@ $ pouch pull docker.io/library/redis:alpine
 $ pouch images
 IMAGE ID            IMAGE NAME                           SIZE
 bbc3a0323522        docker.io/library/busybox:latest     703.14 KB
-0153c5db97e5        docker.io/library/redis:alpine       9.63 MB`
+0153c5db97e5        docker.io/library/redis:alpine       9.63 MB
+$ pouch pull --platform linux/arm64 docker.io/library/busybox:latest
+$ pouch images --filter platform=linux/arm64
+IMAGE ID            IMAGE NAME                           SIZE
+5d2ad5b5a5d4        docker.io/library/busybox:latest     692.28 KB`
 }
 
-// pullMissingImage pull the image if it doesn't exist.
+// pullMissingImage pull the image if it doesn't exist, or the local one
+// is not built for the platform.
 // When `force` is true, always pull the latest image instead of
 // using the local version
-func pullMissingImage(ctx context.Context, apiClient client.CommonAPIClient, image string, force bool) error {
+func pullMissingImage(ctx context.Context, apiClient client.CommonAPIClient, image, platform string, force bool) error {
 	if !force {
-		_, inspectError := apiClient.ImageInspect(ctx, image)
+		img, inspectError := apiClient.ImageInspect(ctx, image)
 		if inspectError == nil {
-			return nil
-		}
-		if err, ok := inspectError.(client.RespError); !ok {
+			matched, err := matchPlatform(img, platform)
+			if err != nil || matched {
+				return err
+			}
+		} else if err, ok := inspectError.(client.RespError); !ok {
 			return inspectError
 		} else if err.Code() != http.StatusNotFound {
 			return inspectError
@@ -218,7 +230,7 @@ func pullMissingImage(ctx context.Context, apiClient client.CommonAPIClient, ima
 		name = namedRef.String()
 	}
 
-	responseBody, err := apiClient.ImagePull(ctx, name, tag, fetchRegistryAuth(namedRef.Name()))
+	responseBody, err := apiClient.ImagePullWithOptions(ctx, name, tag, fetchRegistryAuth(namedRef.Name()), types.ImagePullOptions{Platform: platform})
 	if err != nil {
 		return fmt.Errorf("failed to pull image: %v", err)
 	}
@@ -226,3 +238,22 @@ func pullMissingImage(ctx context.Context, apiClient client.CommonAPIClient, ima
 
 	return showProgress(responseBody)
 }
+
+// matchPlatform returns true if the image is built for the platform, the
+// empty platform matches any image.
+func matchPlatform(img types.ImageInfo, platform string) (bool, error) {
+	if platform == "" {
+		return true, nil
+	}
+
+	p, err := platforms.Parse(platform)
+	if err != nil {
+		return false, fmt.Errorf("invalid platform %s: %v", platform, err)
+	}
+
+	return platforms.NewMatcher(p).Match(ocispec.Platform{
+		OS:           img.Os,
+		Architecture: img.Architecture,
+		Variant:      img.Variant,
+	}), nil
+}
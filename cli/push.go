@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/alibaba/pouch/pkg/reference"
 
@@ -10,11 +11,14 @@ import (
 )
 
 // pushDescription is used to describe push command in detail and auto generate command doc.
-var pushDescription = "Push a local image to remote registry."
+var pushDescription = "Push a local image to remote registry. The progress of each layer is shown, " +
+	"and the layers which exist in another repository of the same registry are mounted instead of uploaded."
 
 // PushCommand is used to implement 'push' command, it pushes image to some registries.
 type PushCommand struct {
 	baseCommand
+
+	allTags bool
 }
 
 // Init initializes push command.
@@ -22,7 +26,7 @@ func (p *PushCommand) Init(c *Cli) {
 	p.cli = c
 
 	p.cmd = &cobra.Command{
-		Use:   "push IMAGE[:TAG]",
+		Use:   "push [OPTIONS] IMAGE[:TAG]",
 		Short: "Push an image to registry",
 		Args:  cobra.ExactArgs(1),
 		Long:  pushDescription,
@@ -31,9 +35,16 @@ func (p *PushCommand) Init(c *Cli) {
 		},
 		Example: p.pushExample(),
 	}
+	p.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (p *PushCommand) addFlags() {
+	flagSet := p.cmd.Flags()
+	flagSet.BoolVarP(&p.allTags, "all-tags", "a", false, "Push all the local tags of the repository")
 }
 
-// runPush pushes a image.
+// runPush pushes a image, or all the tags of repository.
 func (p *PushCommand) runPush(refImage string) error {
 	apiClient := p.cli.Client()
 
@@ -41,9 +52,17 @@ func (p *PushCommand) runPush(refImage string) error {
 	if err != nil {
 		return err
 	}
-	namedRef = reference.TrimTagForDigest(reference.WithDefaultTagIfMissing(namedRef))
 
-	responseBody, err := apiClient.ImagePush(context.TODO(), namedRef.String(), fetchRegistryAuth(namedRef.Name()))
+	var responseBody io.ReadCloser
+	if p.allTags {
+		if !reference.IsNamedOnly(namedRef) {
+			return fmt.Errorf("tag or digest can't be used with --all-tags")
+		}
+		responseBody, err = apiClient.ImagePushAllTags(context.TODO(), namedRef.Name(), fetchRegistryAuth(namedRef.Name()))
+	} else {
+		namedRef = reference.TrimTagForDigest(reference.WithDefaultTagIfMissing(namedRef))
+		responseBody, err = apiClient.ImagePush(context.TODO(), namedRef.String(), fetchRegistryAuth(namedRef.Name()))
+	}
 	if err != nil {
 		return fmt.Errorf("failed to push image: %v", err)
 	}
@@ -60,5 +79,16 @@ manifest-sha256:29f5d56d12684887bdfa50dcd29fc31eea4aaf4ad3bec43daf19026a7ce69912
 layer-sha256:56bec22e355981d8ba0878c6c2f23b21f422f30ab0aba188b54f1ffeff59c190:    done
 config-sha256:e02e811dd08fd49e7f6032625495118e63f597eb150403d02e3238af1df240ba:   done
 elapsed: 0.0 s                                                                    total:   0.0 B (0.0 B/s)
+
+$ pouch push --all-tags docker.io/testing/busybox
+docker.io/testing/busybox:1.25:                                                   resolved |++++++++++++++++++++++++++++++++++++++|
+manifest-sha256:29f5d56d12684887bdfa50dcd29fc31eea4aaf4ad3bec43daf19026a7ce69912: exists   |++++++++++++++++++++++++++++++++++++++|
+layer-sha256:56bec22e355981d8ba0878c6c2f23b21f422f30ab0aba188b54f1ffeff59c190:    exists   |++++++++++++++++++++++++++++++++++++++|
+config-sha256:e02e811dd08fd49e7f6032625495118e63f597eb150403d02e3238af1df240ba:   exists   |++++++++++++++++++++++++++++++++++++++|
+docker.io/testing/busybox:1.28:                                                   resolved |++++++++++++++++++++++++++++++++++++++|
+manifest-sha256:58ac43b2cc92c687a32c8be6278e50a063579655fe3090125dcb2af0ff9e1a64: done     |++++++++++++++++++++++++++++++++++++++|
+layer-sha256:07a152489297fc2bca20be96fab3527ceac5668328a30fd543a160cd689ee548:    mounted  |++++++++++++++++++++++++++++++++++++++|
+config-sha256:8c811b4aec35f259572d0f79207bc0678df4c736eeec50bc9fec37ed936a472a:   done     |++++++++++++++++++++++++++++++++++++++|
+elapsed: 0.5 s                                                                    total:   1.5 KiB (3.0 KiB/s)
 `
 }
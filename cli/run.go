@@ -81,7 +81,7 @@ func (rc *RunCommand) runRun(args []string) error {
 	ctx := context.Background()
 	apiClient := rc.cli.Client()
 
-	if err := pullMissingImage(ctx, apiClient, config.Image, false); err != nil {
+	if err := pullMissingImage(ctx, apiClient, config.Image, rc.platform, false); err != nil {
 		return err
 	}
 
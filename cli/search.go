@@ -4,15 +4,20 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/alibaba/pouch/pkg/reference"
+
 	"github.com/spf13/cobra"
 )
 
-var searchDescription = "\nSearch the images from specific registry."
+var searchDescription = "\nSearch the images from specific registry. The repositories in catalog " +
+	"are searched if the registry does not serve the v1 search API. With --tags, the TERM is the " +
+	"repository name and its tags are listed."
 
 // SearchCommand implements search images.
 type SearchCommand struct {
 	baseCommand
 	registry string
+	tags     bool
 }
 
 // Init initialize search command.
@@ -37,6 +42,7 @@ func (s *SearchCommand) addFlags() {
 	flagSet := s.cmd.Flags()
 
 	flagSet.StringVarP(&s.registry, "registry", "r", "", "set registry name")
+	flagSet.BoolVar(&s.tags, "tags", false, "list the tags of repository TERM")
 }
 
 func (s *SearchCommand) runSearch(args []string) error {
@@ -44,6 +50,9 @@ func (s *SearchCommand) runSearch(args []string) error {
 	apiClient := s.cli.Client()
 
 	term := args[0]
+	if s.tags {
+		return s.runListTags(ctx, term)
+	}
 
 	// TODO: add flags --filter、--format、--limit、--no-trunc
 	searchResults, err := apiClient.ImageSearch(ctx, term, s.registry, fetchRegistryAuth(s.registry))
@@ -63,6 +72,31 @@ func (s *SearchCommand) runSearch(args []string) error {
 	return nil
 }
 
+// runListTags lists the tags of repository.
+func (s *SearchCommand) runListTags(ctx context.Context, name string) error {
+	apiClient := s.cli.Client()
+
+	namedRef, err := reference.Parse(name)
+	if err != nil {
+		return err
+	}
+
+	result, err := apiClient.DistributionTags(ctx, namedRef.String(), fetchRegistryAuth(namedRef.Name()))
+	if err != nil {
+		return err
+	}
+
+	display := s.cli.NewTableDisplay()
+	display.AddRow([]string{"NAME", "TAG"})
+
+	for _, tag := range result.Tags {
+		display.AddRow([]string{result.Name, tag})
+	}
+
+	display.Flush()
+	return nil
+}
+
 func boolToOKOrNot(isTrue bool) string {
 	if isTrue {
 		return "[OK]"
@@ -98,5 +132,10 @@ mailu/nginx                                            Mailu nginx frontend
 toccoag/openshift-nginx                                Nginx reverse proxy for Nice running on same…   1                                       [OK]
 ansibleplaybookbundle/nginx-apb                        An APB to deploy NGINX                          0                                       [OK]
 wodby/nginx                                            Generic nginx                                   0                                       [OK]
+
+$ pouch search --tags busybox
+NAME                                      TAG
+registry.hub.docker.com/library/busybox   1.28
+registry.hub.docker.com/library/busybox   latest
 `
 }
@@ -21,6 +21,7 @@ const (
 	memPercHeader       = "MEM %"
 	memUseHeader        = "MEM USAGE / LIMIT"
 	pidsHeader          = "PIDS"
+	diskQuotaHeader     = "DISK QUOTA USAGE / LIMIT"
 )
 
 // statsDescription is used to describe stats command in detail and auto generate command doc.
@@ -95,7 +96,7 @@ func (stats *StatsCommand) runStats(args []string) error {
 
 	display := stats.cli.NewTableDisplay()
 	displayHead := []string{containerHeader, containerNameHeader, cpuPercHeader, memPercHeader,
-		memUseHeader, netIOHeader, blockIOHeader, pidsHeader}
+		memUseHeader, netIOHeader, blockIOHeader, pidsHeader, diskQuotaHeader}
 
 	for range time.Tick(500 * time.Millisecond) {
 		cleanScreen()
@@ -114,7 +115,7 @@ func (stats *StatsCommand) runStats(args []string) error {
 		// display the stats of each container
 		for _, c := range ccstats {
 			displayLine := []string{c.ID(), c.Name(), c.CPUPerc(), c.MemPerc(),
-				c.MemUsage(), c.NetIO(), c.BlockIO(), c.PIDs()}
+				c.MemUsage(), c.NetIO(), c.BlockIO(), c.PIDs(), c.DiskQuota()}
 			display.AddRow(displayLine)
 		}
 
@@ -131,8 +132,8 @@ func (stats *StatsCommand) runStats(args []string) error {
 // statsExample shows examples in stats command, and is used in auto-generated cli docs.
 func statsExample() string {
 	return `$ pouch stats b25ae a0067
-CONTAINER ID        NAME                       CPU %               MEM USAGE / LIMIT     MEM %               NET I/O             BLOCK I/O           PIDS
-b25ae88e5b70        naughty_goldwasser         0.11%               2.559MiB / 15.23GiB   0.02%               7.32kB / 0B         0B / 0B             4
-a00670c2bdff        xenodochial_varahamihira   0.11%               2.887MiB / 15.23GiB   0.02%               13.3kB / 0B         14.7MB / 0B         4
+CONTAINER ID        NAME                       CPU %               MEM USAGE / LIMIT     MEM %               NET I/O             BLOCK I/O           PIDS                DISK QUOTA USAGE / LIMIT
+b25ae88e5b70        naughty_goldwasser         0.11%               2.559MiB / 15.23GiB   0.02%               7.32kB / 0B         0B / 0B             4                   16KiB / 10GiB
+a00670c2bdff        xenodochial_varahamihira   0.11%               2.887MiB / 15.23GiB   0.02%               13.3kB / 0B         14.7MB / 0B         4                   --
 `
 }
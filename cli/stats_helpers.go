@@ -31,6 +31,7 @@ type StatsEntry struct {
 	blockRead        float64
 	blockWrite       float64
 	pidsCurrent      uint64
+	diskQuotaStats   []*types.DiskQuotaUsage
 	err              error
 }
 
@@ -103,6 +104,21 @@ func (s StatsEntry) PIDs() string {
 	return fmt.Sprintf("%d", s.pidsCurrent)
 }
 
+// DiskQuota return disk quota usage of container, the usage and limits of
+// all the quota ids are summed up.
+func (s StatsEntry) DiskQuota() string {
+	if s.err != nil || len(s.diskQuotaStats) == 0 {
+		return fmt.Sprintf("--")
+	}
+
+	var used, limit uint64
+	for _, q := range s.diskQuotaStats {
+		used += q.Size
+		limit += q.HardLimit
+	}
+	return fmt.Sprintf("%s / %s", units.BytesSize(float64(used)), units.BytesSize(float64(limit)))
+}
+
 // GetStatsEntry return the StatsEntry of StatsEntryWithLock
 func (s *StatsEntryWithLock) GetStatsEntry() StatsEntry {
 	s.mutex.Lock()
@@ -194,6 +210,7 @@ func collect(ctx context.Context, s *StatsEntryWithLock, cli client.CommonAPICli
 			s.blockRead = float64(blkRead)
 			s.blockWrite = float64(blkWrite)
 			s.pidsCurrent = pidsStatsCurrent
+			s.diskQuotaStats = v.DiskQuotaStats
 			s.mutex.Unlock()
 
 			dataCh <- struct{}{}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// systemDescription is used to describe system command in detail and auto generate command doc.
+var systemDescription = "Manage the system resources of pouchd. " +
+	"It contains the function of quota-check, which checks the consistency of disk quota ids."
+
+// SystemCommand is used to implement 'system' command.
+type SystemCommand struct {
+	baseCommand
+}
+
+// Init initializes SystemCommand command.
+func (s *SystemCommand) Init(c *Cli) {
+	s.cli = c
+
+	s.cmd = &cobra.Command{
+		Use:   "system [command]",
+		Short: "Manage pouch system",
+		Long:  systemDescription,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return fmt.Errorf("command 'pouch system %s' does not exist.\nPlease execute `pouch system --help` for more help", args[0])
+		},
+	}
+
+	c.AddCommand(s, &SystemQuotaCheckCommand{})
+}
+
+// RunE is the entry of SystemCommand command.
+func (s *SystemCommand) RunE(args []string) error {
+	return nil
+}
+
+// systemQuotaCheckDescription is used to describe system quota-check command in detail and auto generate command doc.
+var systemQuotaCheckDescription = "Check the disk quota ids of containers and volumes in pouchd. " +
+	"It scans the quota ids set on the rootfs and volume directories, cross-references them with " +
+	"the QuotaID of containers and the quota report of filesystems, then reports the orphaned, " +
+	"leaked, duplicated and mismatched quota ids. Only the filesystems used by pouchd and the quota ids " +
+	"allocated by pouchd are checked. The issues are repaired with option --fix."
+
+// SystemQuotaCheckCommand is used to implement 'system quota-check' command.
+type SystemQuotaCheckCommand struct {
+	baseCommand
+
+	fix bool
+}
+
+// Init initializes SystemQuotaCheckCommand command.
+func (s *SystemQuotaCheckCommand) Init(c *Cli) {
+	s.cli = c
+	s.cmd = &cobra.Command{
+		Use:   "quota-check [OPTIONS]",
+		Short: "Check and repair the disk quota ids",
+		Long:  systemQuotaCheckDescription,
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return s.runSystemQuotaCheck(args)
+		},
+		Example: systemQuotaCheckExample(),
+	}
+	s.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (s *SystemQuotaCheckCommand) addFlags() {
+	flagSet := s.cmd.Flags()
+	flagSet.BoolVar(&s.fix, "fix", false, "Repair the issues found")
+}
+
+// runSystemQuotaCheck is the entry of SystemQuotaCheckCommand command.
+func (s *SystemQuotaCheckCommand) runSystemQuotaCheck(args []string) error {
+	ctx := context.Background()
+	apiClient := s.cli.Client()
+
+	resp, err := apiClient.SystemQuotaCheck(ctx, s.fix)
+	if err != nil {
+		return err
+	}
+
+	display := s.cli.NewTableDisplay()
+	display.AddRow([]string{"QUOTA ID", "TYPE", "OWNERS", "FIXED", "DESCRIPTION"})
+
+	for _, issue := range resp.Issues {
+		owners := strings.Join(issue.Owners, ",")
+		if owners == "" {
+			owners = "<none>"
+		}
+		display.AddRow([]string{issue.QuotaID, issue.Type, owners, strconv.FormatBool(issue.Fixed), issue.Description})
+	}
+
+	display.Flush()
+
+	return nil
+}
+
+// systemQuotaCheckExample shows examples in system quota-check command, and is used in auto-generated cli docs.
+func systemQuotaCheckExample() string {
+	return `$ pouch system quota-check
+QUOTA ID   TYPE         OWNERS                                          FIXED   DESCRIPTION
+16777218   duplicated   container/3b5a9c2e1d7f,container/8e4f1a6b2c9d   false   quota id is kept by container/3b5a9c2e1d7f
+16777225   orphaned     <none>                                          false   the limits are not used
+$ pouch system quota-check --fix
+QUOTA ID   TYPE         OWNERS                                          FIXED   DESCRIPTION
+16777218   duplicated   container/3b5a9c2e1d7f,container/8e4f1a6b2c9d   true    quota id is kept by container/3b5a9c2e1d7f, container/8e4f1a6b2c9d is moved to quota id 16777226
+16777225   orphaned     <none>                                          true    the limits are not used, the limits are cleared`
+}
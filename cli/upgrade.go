@@ -73,7 +73,7 @@ func (ug *UpgradeCommand) runUpgrade(args []string) error {
 	ctx := context.Background()
 	apiClient := ug.cli.Client()
 
-	if err := pullMissingImage(ctx, apiClient, image, false); err != nil {
+	if err := pullMissingImage(ctx, apiClient, image, "", false); err != nil {
 		return err
 	}
 
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -10,13 +11,14 @@ import (
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/cli/inspect"
 	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/utils"
 
 	"github.com/spf13/cobra"
 )
 
 // volumeDescription is used to describe volume command in detail and auto generate command doc.
 var volumeDescription = "Manager the volumes in pouchd. " +
-	"It contains the functions of create/remove/list/inspect volume, 'driver' is used to list drivers that pouch support. " +
+	"It contains the functions of create/remove/list/inspect/update/backup/restore/clone volume, 'driver' is used to list drivers that pouch support. " +
 	"The default volume driver is local, it will make a directory to bind into container."
 
 // VolumeCommand is used to implement 'volume' command.
@@ -42,6 +44,10 @@ func (v *VolumeCommand) Init(c *Cli) {
 	c.AddCommand(v, &VolumeRemoveCommand{})
 	c.AddCommand(v, &VolumeInspectCommand{})
 	c.AddCommand(v, &VolumeListCommand{})
+	c.AddCommand(v, &VolumeUpdateCommand{})
+	c.AddCommand(v, &VolumeBackupCommand{})
+	c.AddCommand(v, &VolumeRestoreCommand{})
+	c.AddCommand(v, &VolumeCloneCommand{})
 }
 
 // RunE is the entry of VolumeCommand command.
@@ -51,7 +57,9 @@ func (v *VolumeCommand) RunE(args []string) error {
 
 // volumeCreateDescription is used to describe volume create command in detail and auto generate command doc.
 var volumeCreateDescription = "Create a volume in pouchd. " +
-	"It must specify volume's name, size and driver. You can use 'volume driver' to get drivers that pouch support."
+	"It must specify volume's name, size and driver. You can use 'volume driver' to get drivers that pouch support. " +
+	"The io limits of volume can be set by options wbps, rbps, wiops and riops, " +
+	"which are applied to the backing block device when the volume is used by containers."
 
 // VolumeCreateCommand is used to implement 'volume create' command.
 type VolumeCreateCommand struct {
@@ -277,7 +285,8 @@ func volumeInspectExample() string {
 
 // volumeListDescription is used to describe volume list command in detail and auto generate command doc.
 var volumeListDescription = "List volumes in pouchd. " +
-	"It lists the volume's name"
+	"It lists the volume's name, use '--size' to display the configured size and the used size of volumes, " +
+	"or '--format' to print volumes using a Go template."
 
 // VolumeListCommand is used to implement 'volume rm' command.
 type VolumeListCommand struct {
@@ -287,6 +296,7 @@ type VolumeListCommand struct {
 	mountPoint bool
 	quiet      bool
 	filter     []string
+	format     string
 }
 
 // Init initializes VolumeListCommand command.
@@ -309,10 +319,11 @@ func (v *VolumeListCommand) Init(c *Cli) {
 // addFlags adds flags for specific command.
 func (v *VolumeListCommand) addFlags() {
 	flagSet := v.cmd.Flags()
-	flagSet.BoolVar(&v.size, "size", false, "Display volume size")
+	flagSet.BoolVarP(&v.size, "size", "s", false, "Display volume size and usage")
 	flagSet.BoolVar(&v.mountPoint, "mountpoint", false, "Display volume mountpoint")
 	flagSet.BoolVarP(&v.quiet, "quiet", "q", false, "Only display volume names")
 	flagSet.StringSliceVarP(&v.filter, "filter", "f", []string{}, "Filter output based on conditions provided, filter support driver, name, label")
+	flagSet.StringVar(&v.format, "format", "", "Pretty-print volumes using a Go template")
 }
 
 // runVolumeList is the entry of VolumeListCommand command.
@@ -327,13 +338,31 @@ func (v *VolumeListCommand) runVolumeList(args []string) error {
 		return err
 	}
 
-	volumeList, err := apiClient.VolumeList(ctx, volumeFilterArgs)
+	if (v.size || v.mountPoint) && v.quiet {
+		return fmt.Errorf("Conflicting options: --size (or --mountpoint) and -q")
+	}
+	if v.format != "" && v.quiet {
+		return fmt.Errorf("Conflicting options: --format and -q")
+	}
+
+	// the usage data is only calculated when required, since it may walk the volumes.
+	withSize := v.size || strings.Contains(v.format, "UsageData")
+	volumeList, err := apiClient.VolumeListWithOptions(ctx, volumeFilterArgs, types.VolumeListOptions{Size: withSize})
 	if err != nil {
 		return err
 	}
 
-	if (v.size || v.mountPoint) && v.quiet {
-		return fmt.Errorf("Conflicting options: --size (or --mountpoint) and -q")
+	if v.format != "" {
+		tmpl, err := inspect.NewTemplateInspectorFromString(os.Stdout, v.format)
+		if err != nil {
+			return err
+		}
+		for _, volume := range volumeList.Volumes {
+			if err := tmpl.Inspect(volume); err != nil {
+				return err
+			}
+		}
+		return tmpl.Flush()
 	}
 
 	display := v.cli.NewTableDisplay()
@@ -342,7 +371,7 @@ func (v *VolumeListCommand) runVolumeList(args []string) error {
 	if !v.quiet {
 		displayHead = append([]string{"DRIVER"}, displayHead...)
 		if v.size {
-			displayHead = append(displayHead, "SIZE")
+			displayHead = append(displayHead, "SIZE", "USAGE")
 		}
 		if v.mountPoint {
 			displayHead = append(displayHead, "MOUNT POINT")
@@ -361,6 +390,7 @@ func (v *VolumeListCommand) runVolumeList(args []string) error {
 				} else {
 					displayLine = append(displayLine, "ulimit")
 				}
+				displayLine = append(displayLine, volumeUsage(volume.UsageData))
 			}
 			if v.mountPoint {
 				displayLine = append(displayLine, volume.Mountpoint)
@@ -374,6 +404,14 @@ func (v *VolumeListCommand) runVolumeList(args []string) error {
 	return nil
 }
 
+// volumeUsage returns the human readable used size of volume.
+func volumeUsage(usage *types.VolumeUsageData) string {
+	if usage == nil || usage.Size < 0 {
+		return "N/A"
+	}
+	return utils.FormatSize(usage.Size)
+}
+
 // volumeListExample shows examples in volume list command, and is used in auto-generated cli docs.
 func volumeListExample() string {
 	return `$ pouch volume list
@@ -385,5 +423,270 @@ $ pouch volume list --quiet
 VOLUME NAME
 pouch-volume-1
 pouch-volume-2
-pouch-volume-3`
+pouch-volume-3
+$ pouch volume list -s
+DRIVER   VOLUME NAME      SIZE     USAGE
+local    pouch-volume-1   10g      1.95 MB
+local    pouch-volume-2   ulimit   0.00 B
+local    pouch-volume-3   ulimit   12.00 KB
+$ pouch volume list --format "{{.Name}}: {{.UsageData.Size}} {{.UsageData.RefCount}}"
+pouch-volume-1: 2048000 1
+pouch-volume-2: 0 0
+pouch-volume-3: 12288 2`
+}
+
+// volumeUpdateDescription is used to describe volume update command in detail and auto generate command doc.
+var volumeUpdateDescription = "Update the size and labels of a volume in pouchd. " +
+	"The size is changed online if the volume driver supports resize, such as local and tmpfs. " +
+	"The label with empty value, such as 'key=', is removed from the volume."
+
+// VolumeUpdateCommand is used to implement 'volume update' command.
+type VolumeUpdateCommand struct {
+	baseCommand
+	size   string
+	labels []string
+}
+
+// Init initializes VolumeUpdateCommand command.
+func (v *VolumeUpdateCommand) Init(c *Cli) {
+	v.cli = c
+	v.cmd = &cobra.Command{
+		Use:   "update [OPTIONS] NAME",
+		Short: "Update a volume",
+		Long:  volumeUpdateDescription,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return v.runVolumeUpdate(args)
+		},
+		Example: volumeUpdateExample(),
+	}
+	v.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (v *VolumeUpdateCommand) addFlags() {
+	flagSet := v.cmd.Flags()
+	flagSet.StringVar(&v.size, "size", "", "Set the new size of volume, such as 20g")
+	flagSet.StringSliceVarP(&v.labels, "label", "l", nil, "Set labels for volume, remove the label with empty value")
+}
+
+// runVolumeUpdate is the entry of VolumeUpdateCommand command.
+func (v *VolumeUpdateCommand) runVolumeUpdate(args []string) error {
+	name := args[0]
+
+	config := &types.VolumeUpdateConfig{
+		Size:   v.size,
+		Labels: map[string]string{},
+	}
+	for _, label := range v.labels {
+		l := strings.SplitN(label, "=", 2)
+		if len(l) != 2 || l[0] == "" {
+			return fmt.Errorf("unknown label %s: label format must be key=value", label)
+		}
+		config.Labels[l[0]] = l[1]
+	}
+
+	if config.Size == "" && len(config.Labels) == 0 {
+		return fmt.Errorf("nothing to update, please specify --size or --label")
+	}
+
+	log.With(nil).Debugf("update a volume: %s, size: %s, labels: %v", name, v.size, v.labels)
+
+	ctx := context.Background()
+	apiClient := v.cli.Client()
+	if err := apiClient.VolumeUpdate(ctx, name, config); err != nil {
+		return err
+	}
+
+	fmt.Printf("Updated: %s\n", name)
+	return nil
+}
+
+// volumeUpdateExample shows examples in volume update command, and is used in auto-generated cli docs.
+func volumeUpdateExample() string {
+	return `$ pouch volume update --size 20g --label env=prod pouch-volume
+Updated: pouch-volume`
+}
+
+var volumeBackupDescription = "Backup the data of a volume to a tar archive, the owner and permission of files are kept. " +
+	"The volume which is not used by any container is attached during the backup. " +
+	"Use '--pause' to pause the running containers using the volume, so that the data is consistent. " +
+	"The volume mounted read-write by running containers can't be backed up without '--pause'."
+
+// VolumeBackupCommand is used to implement 'volume backup' command.
+type VolumeBackupCommand struct {
+	baseCommand
+	output string
+	pause  bool
+}
+
+// Init initializes VolumeBackupCommand command.
+func (v *VolumeBackupCommand) Init(c *Cli) {
+	v.cli = c
+	v.cmd = &cobra.Command{
+		Use:   "backup [OPTIONS] NAME",
+		Short: "Backup a volume to a tar archive or STDOUT",
+		Long:  volumeBackupDescription,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return v.runVolumeBackup(args)
+		},
+		Example: volumeBackupExample(),
+	}
+	v.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (v *VolumeBackupCommand) addFlags() {
+	flagSet := v.cmd.Flags()
+	flagSet.StringVarP(&v.output, "output", "o", "", "Write to a tar archive file, instead of STDOUT")
+	flagSet.BoolVar(&v.pause, "pause", false, "Pause the running containers using the volume during the backup")
+}
+
+// runVolumeBackup is the entry of VolumeBackupCommand command.
+func (v *VolumeBackupCommand) runVolumeBackup(args []string) error {
+	ctx := context.Background()
+	apiClient := v.cli.Client()
+
+	r, err := apiClient.VolumeArchive(ctx, args[0], v.pause)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	out := os.Stdout
+	if v.output != "" {
+		out, err = os.Create(v.output)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+	}
+
+	_, err = io.Copy(out, r)
+	return err
+}
+
+// volumeBackupExample shows examples in volume backup command, and is used in auto-generated cli docs.
+func volumeBackupExample() string {
+	return `$ pouch volume backup --pause -o pouch-volume.tar pouch-volume`
+}
+
+var volumeRestoreDescription = "Restore the data of a volume from a tar archive, the existing files with same name are overwritten. " +
+	"Use '--pause' to pause the running containers using the volume during the restore."
+
+// VolumeRestoreCommand is used to implement 'volume restore' command.
+type VolumeRestoreCommand struct {
+	baseCommand
+	input string
+	pause bool
+}
+
+// Init initializes VolumeRestoreCommand command.
+func (v *VolumeRestoreCommand) Init(c *Cli) {
+	v.cli = c
+	v.cmd = &cobra.Command{
+		Use:   "restore [OPTIONS] NAME",
+		Short: "Restore a volume from a tar archive or STDIN",
+		Long:  volumeRestoreDescription,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return v.runVolumeRestore(args)
+		},
+		Example: volumeRestoreExample(),
+	}
+	v.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (v *VolumeRestoreCommand) addFlags() {
+	flagSet := v.cmd.Flags()
+	flagSet.StringVarP(&v.input, "input", "i", "", "Read from tar archive file, instead of STDIN")
+	flagSet.BoolVar(&v.pause, "pause", false, "Pause the running containers using the volume during the restore")
+}
+
+// runVolumeRestore is the entry of VolumeRestoreCommand command.
+func (v *VolumeRestoreCommand) runVolumeRestore(args []string) error {
+	ctx := context.Background()
+	apiClient := v.cli.Client()
+
+	var in io.Reader = os.Stdin
+	if v.input != "" {
+		file, err := os.Open(v.input)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		in = file
+	}
+
+	if err := apiClient.VolumeRestore(ctx, args[0], in, v.pause); err != nil {
+		return err
+	}
+
+	fmt.Printf("Restored: %s\n", args[0])
+	return nil
+}
+
+// volumeRestoreExample shows examples in volume restore command, and is used in auto-generated cli docs.
+func volumeRestoreExample() string {
+	return `$ pouch volume restore -i pouch-volume.tar pouch-volume
+Restored: pouch-volume`
+}
+
+var volumeCloneDescription = "Clone a volume to a new volume, the new volume is created with the driver, options, labels and size of the source volume, " +
+	"then the data is copied with the owner and permission of files kept. " +
+	"Use '--pause' to pause the running containers using the source volume during the copy. " +
+	"The volume mounted read-write by running containers can't be cloned without '--pause'."
+
+// VolumeCloneCommand is used to implement 'volume clone' command.
+type VolumeCloneCommand struct {
+	baseCommand
+	pause bool
+}
+
+// Init initializes VolumeCloneCommand command.
+func (v *VolumeCloneCommand) Init(c *Cli) {
+	v.cli = c
+	v.cmd = &cobra.Command{
+		Use:   "clone [OPTIONS] SOURCE TARGET",
+		Short: "Clone a volume to a new volume",
+		Long:  volumeCloneDescription,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return v.runVolumeClone(args)
+		},
+		Example: volumeCloneExample(),
+	}
+	v.addFlags()
+}
+
+// addFlags adds flags for specific command.
+func (v *VolumeCloneCommand) addFlags() {
+	flagSet := v.cmd.Flags()
+	flagSet.BoolVar(&v.pause, "pause", false, "Pause the running containers using the source volume during the copy")
+}
+
+// runVolumeClone is the entry of VolumeCloneCommand command.
+func (v *VolumeCloneCommand) runVolumeClone(args []string) error {
+	ctx := context.Background()
+	apiClient := v.cli.Client()
+
+	config := &types.VolumeCloneConfig{
+		Name:  args[1],
+		Pause: v.pause,
+	}
+	volume, err := apiClient.VolumeClone(ctx, args[0], config)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Cloned: %s\n", volume.Name)
+	return nil
+}
+
+// volumeCloneExample shows examples in volume clone command, and is used in auto-generated cli docs.
+func volumeCloneExample() string {
+	return `$ pouch volume clone --pause pouch-volume pouch-volume-clone
+Cloned: pouch-volume-clone`
 }
@@ -0,0 +1,17 @@
+package client
+
+import (
+	"context"
+	"net/url"
+)
+
+// ContainerMigrateSnapshot moves the writable layer of a stopped container to the snapshotter.
+func (client *APIClient) ContainerMigrateSnapshot(ctx context.Context, name, snapshotter string) error {
+	q := url.Values{}
+	q.Set("snapshotter", snapshotter)
+
+	resp, err := client.post(ctx, "/containers/"+name+"/migrate-snapshot", q, nil, nil)
+	ensureCloseReader(resp)
+
+	return err
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestContainerMigrateSnapshotError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	err := client.ContainerMigrateSnapshot(context.Background(), "nothing", "native")
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestContainerMigrateSnapshot(t *testing.T) {
+	expectedURL := "/containers/container_id/migrate-snapshot"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != http.MethodPost {
+			return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+		}
+		if got := req.FormValue("snapshotter"); got != "native" {
+			return nil, fmt.Errorf("expected snapshotter is native, got %s", got)
+		}
+		return &http.Response{
+			StatusCode: http.StatusNoContent,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	if err := client.ContainerMigrateSnapshot(context.Background(), "container_id", "native"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// DistributionInspect requests daemon to inspect an image in registry
+// without pulling it.
+func (client *APIClient) DistributionInspect(ctx context.Context, ref, encodedAuth string) (*types.DistributionInspect, error) {
+	headers := map[string][]string{}
+	if encodedAuth != "" {
+		headers["X-Registry-Auth"] = []string{encodedAuth}
+	}
+
+	resp, err := client.get(ctx, "/distribution/"+ref+"/json", nil, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &types.DistributionInspect{}
+	defer ensureCloseReader(resp)
+	err = decodeBody(result, resp.Body)
+	return result, err
+}
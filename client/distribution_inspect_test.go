@@ -0,0 +1,74 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistributionInspectError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusUnauthorized, "authorization failed")),
+	}
+	_, err := client.DistributionInspect(context.Background(), "busybox:latest", "")
+	if err == nil || !strings.Contains(err.Error(), "authorization failed") {
+		t.Fatalf("expected an authorization error, got %v", err)
+	}
+}
+
+func TestDistributionInspect(t *testing.T) {
+	expectedURL := "/distribution/busybox:latest/json"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "GET" {
+			return nil, fmt.Errorf("expected GET method, got %s", req.Method)
+		}
+		if auth := req.Header.Get("X-Registry-Auth"); auth != "auth" {
+			return nil, fmt.Errorf("expected registry auth 'auth', got '%s'", auth)
+		}
+
+		b, err := json.Marshal(types.DistributionInspect{
+			Descriptor: &types.OCIDescriptor{
+				MediaType: "application/vnd.oci.image.index.v1+json",
+				Digest:    "sha256:abc",
+				Size:      100,
+			},
+			Platforms: []*types.OCIPlatform{
+				{Os: "linux", Architecture: "amd64"},
+				{Os: "linux", Architecture: "arm", Variant: "v7"},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	result, err := client.DistributionInspect(context.Background(), "busybox:latest", "auth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "sha256:abc", result.Descriptor.Digest)
+	assert.Len(t, result.Platforms, 2)
+	assert.Equal(t, "v7", result.Platforms[1].Variant)
+}
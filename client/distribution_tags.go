@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// DistributionTags requests daemon to list the tags of repository in
+// registry.
+func (client *APIClient) DistributionTags(ctx context.Context, name, encodedAuth string) (*types.DistributionTagsResp, error) {
+	headers := map[string][]string{}
+	if encodedAuth != "" {
+		headers["X-Registry-Auth"] = []string{encodedAuth}
+	}
+
+	resp, err := client.get(ctx, "/distribution/"+name+"/tags", nil, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &types.DistributionTagsResp{}
+	defer ensureCloseReader(resp)
+	err = decodeBody(result, resp.Body)
+	return result, err
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistributionTagsNotFoundError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusNotFound, "Not Found")),
+	}
+	_, err := client.DistributionTags(context.Background(), "busybox", "")
+	if err == nil || !strings.Contains(err.Error(), "Not Found") {
+		t.Fatalf("expected a Not Found Error, got %v", err)
+	}
+}
+
+func TestDistributionTags(t *testing.T) {
+	expectedURL := "/distribution/busybox/tags"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "GET" {
+			return nil, fmt.Errorf("expected GET method, got %s", req.Method)
+		}
+
+		b, err := json.Marshal(types.DistributionTagsResp{
+			Name: "registry.hub.docker.com/library/busybox",
+			Tags: []string{"1.28", "latest"},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	result, err := client.DistributionTags(context.Background(), "busybox", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"1.28", "latest"}, result.Tags)
+}
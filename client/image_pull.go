@@ -4,13 +4,23 @@ import (
 	"context"
 	"io"
 	"net/url"
+
+	"github.com/alibaba/pouch/apis/types"
 )
 
 // ImagePull requests daemon to pull an image from registry.
 func (client *APIClient) ImagePull(ctx context.Context, name, tag, encodedAuth string) (io.ReadCloser, error) {
+	return client.ImagePullWithOptions(ctx, name, tag, encodedAuth, types.ImagePullOptions{})
+}
+
+// ImagePullWithOptions requests daemon to pull an image from registry with options.
+func (client *APIClient) ImagePullWithOptions(ctx context.Context, name, tag, encodedAuth string, options types.ImagePullOptions) (io.ReadCloser, error) {
 	q := url.Values{}
 	q.Set("fromImage", name)
 	q.Set("tag", tag)
+	if options.Platform != "" {
+		q.Set("platform", options.Platform)
+	}
 
 	headers := map[string][]string{}
 	if encodedAuth != "" {
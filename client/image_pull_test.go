@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
 )
 
 func TestImagePullServerError(t *testing.T) {
@@ -58,3 +60,25 @@ func TestImagePull(t *testing.T) {
 	}
 
 }
+
+func TestImagePullWithOptions(t *testing.T) {
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if platform := req.URL.Query().Get("platform"); platform != "linux/arm64" {
+			return nil, fmt.Errorf("expected platform linux/arm64, got %s", platform)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	_, err := client.ImagePullWithOptions(context.Background(), "image_name", "image_tag", "auth", types.ImagePullOptions{Platform: "linux/arm64"})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -40,3 +40,29 @@ func (client *APIClient) ImagePush(ctx context.Context, ref, encodedAuth string)
 	}
 	return resp.Body, nil
 }
+
+// ImagePushAllTags requests daemon to push all the local tags of repository
+// to registry.
+func (client *APIClient) ImagePushAllTags(ctx context.Context, name, encodedAuth string) (io.ReadCloser, error) {
+	namedRef, err := reference.Parse(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !reference.IsNamedOnly(namedRef) {
+		return nil, errors.New("cannot push all tags with a tag or digest reference")
+	}
+
+	q := url.Values{}
+	q.Set("all-tags", "1")
+
+	headers := map[string][]string{}
+	if encodedAuth != "" {
+		headers["X-Registry-Auth"] = []string{encodedAuth}
+	}
+	resp, err := client.post(ctx, "/images/"+namedRef.Name()+"/push", q, nil, headers)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
@@ -49,3 +49,39 @@ func TestImagePush(t *testing.T) {
 	}
 
 }
+
+func TestImagePushAllTags(t *testing.T) {
+	name := "reg.example.com/app"
+	expectedURL := "/images/" + name + "/push"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+
+		if got := req.FormValue("all-tags"); got != "1" {
+			return nil, fmt.Errorf("expected all-tags is 1, got %s", got)
+		}
+
+		if got := req.FormValue("tag"); got != "" {
+			return nil, fmt.Errorf("expected no tag, got %s", got)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	if _, err := client.ImagePushAllTags(context.Background(), name, "auth"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.ImagePushAllTags(context.Background(), name+":v1", "auth"); err == nil {
+		t.Fatal("expected an error of tagged reference")
+	}
+}
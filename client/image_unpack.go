@@ -0,0 +1,19 @@
+package client
+
+import (
+	"context"
+	"net/url"
+)
+
+// ImageUnpack unpacks the image into the snapshotter.
+func (client *APIClient) ImageUnpack(ctx context.Context, name, snapshotter string) error {
+	q := url.Values{}
+	if snapshotter != "" {
+		q.Set("snapshotter", snapshotter)
+	}
+
+	resp, err := client.post(ctx, "/images/"+name+"/unpack", q, nil, nil)
+	ensureCloseReader(resp)
+
+	return err
+}
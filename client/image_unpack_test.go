@@ -0,0 +1,53 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestImageUnpackNotFoundError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusNotFound, "Not Found")),
+	}
+
+	err := client.ImageUnpack(context.Background(), "oops", "native")
+	if err == nil || !strings.Contains(err.Error(), "Not Found") {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func TestImageUnpackOK(t *testing.T) {
+	expectedURL := "/images/busybox:latest/unpack"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+
+		if req.Method != http.MethodPost {
+			return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+		}
+
+		if got := req.FormValue("snapshotter"); got != "native" {
+			return nil, fmt.Errorf("expected snapshotter is native, got %s", got)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	if err := client.ImageUnpack(context.Background(), "busybox:latest", "native"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
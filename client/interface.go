@@ -14,6 +14,7 @@ import (
 type CommonAPIClient interface {
 	ContainerAPIClient
 	ImageAPIClient
+	DistributionAPIClient
 	VolumeAPIClient
 	SystemAPIClient
 	NetworkAPIClient
@@ -50,6 +51,7 @@ type ContainerAPIClient interface {
 	ContainerStatPath(ctx context.Context, name string, path string) (types.ContainerPathStat, error)
 	CopyFromContainer(ctx context.Context, container, srcPath string) (io.ReadCloser, types.ContainerPathStat, error)
 	CopyToContainer(ctx context.Context, container, path string, content io.Reader) error
+	ContainerMigrateSnapshot(ctx context.Context, name, snapshotter string) error
 }
 
 // ImageAPIClient defines methods of Image client.
@@ -57,13 +59,25 @@ type ImageAPIClient interface {
 	ImageList(ctx context.Context, filters filters.Args) ([]types.ImageInfo, error)
 	ImageInspect(ctx context.Context, name string) (types.ImageInfo, error)
 	ImagePull(ctx context.Context, name, tag, encodedAuth string) (io.ReadCloser, error)
+	ImagePullWithOptions(ctx context.Context, name, tag, encodedAuth string, options types.ImagePullOptions) (io.ReadCloser, error)
 	ImageRemove(ctx context.Context, name string, force bool) error
 	ImageTag(ctx context.Context, image string, tag string) error
 	ImageLoad(ctx context.Context, name string, r io.Reader) error
 	ImageSave(ctx context.Context, imageName string) (io.ReadCloser, error)
 	ImageHistory(ctx context.Context, name string) ([]types.HistoryResultItem, error)
 	ImagePush(ctx context.Context, ref, encodedAuth string) (io.ReadCloser, error)
+	ImagePushAllTags(ctx context.Context, name, encodedAuth string) (io.ReadCloser, error)
 	ImageSearch(ctx context.Context, term, registry, encodedAuth string) ([]types.SearchResultItem, error)
+	ImageUnpack(ctx context.Context, name, snapshotter string) error
+}
+
+// DistributionAPIClient defines methods of Distribution client.
+type DistributionAPIClient interface {
+	DistributionInspect(ctx context.Context, ref, encodedAuth string) (*types.DistributionInspect, error)
+	DistributionTags(ctx context.Context, name, encodedAuth string) (*types.DistributionTagsResp, error)
+	ManifestCreate(ctx context.Context, config *types.ManifestCreateConfig, encodedAuth string) error
+	ManifestAnnotate(ctx context.Context, name string, config *types.ManifestAnnotateConfig) error
+	ManifestPush(ctx context.Context, name, encodedAuth string) (io.ReadCloser, error)
 }
 
 // VolumeAPIClient defines methods of Volume client.
@@ -72,6 +86,11 @@ type VolumeAPIClient interface {
 	VolumeRemove(ctx context.Context, name string) error
 	VolumeInspect(ctx context.Context, name string) (*types.VolumeInfo, error)
 	VolumeList(ctx context.Context, filter filters.Args) (*types.VolumeListResp, error)
+	VolumeListWithOptions(ctx context.Context, filter filters.Args, options types.VolumeListOptions) (*types.VolumeListResp, error)
+	VolumeUpdate(ctx context.Context, name string, config *types.VolumeUpdateConfig) error
+	VolumeArchive(ctx context.Context, name string, pause bool) (io.ReadCloser, error)
+	VolumeRestore(ctx context.Context, name string, content io.Reader, pause bool) error
+	VolumeClone(ctx context.Context, name string, config *types.VolumeCloneConfig) (*types.VolumeInfo, error)
 }
 
 // SystemAPIClient defines methods of System client.
@@ -82,6 +101,7 @@ type SystemAPIClient interface {
 	RegistryLogin(ctx context.Context, auth *types.AuthConfig) (*types.AuthResponse, error)
 	DaemonUpdate(ctx context.Context, daemonConfig *types.DaemonUpdateConfig) error
 	Events(ctx context.Context, since string, until string, filters filters.Args) (io.ReadCloser, error)
+	SystemQuotaCheck(ctx context.Context, fix bool) (*types.QuotaCheckResp, error)
 }
 
 // NetworkAPIClient defines methods of Network client.
@@ -92,4 +112,7 @@ type NetworkAPIClient interface {
 	NetworkList(ctx context.Context) ([]types.NetworkResource, error)
 	NetworkConnect(ctx context.Context, network string, req *types.NetworkConnect) error
 	NetworkDisconnect(ctx context.Context, networkID, containerID string, force bool) error
+	NetworkReserve(ctx context.Context, network string, req *types.NetworkReservation) error
+	NetworkUnreserve(ctx context.Context, network, name string) error
+	NetworkStats(ctx context.Context, networkID string, stream bool) (io.ReadCloser, error)
 }
@@ -0,0 +1,15 @@
+package client
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// ManifestAnnotate requests daemon to set the platform of image in the
+// manifest list.
+func (client *APIClient) ManifestAnnotate(ctx context.Context, name string, config *types.ManifestAnnotateConfig) error {
+	resp, err := client.post(ctx, "/manifests/"+name+"/annotate", nil, config, nil)
+	ensureCloseReader(resp)
+	return err
+}
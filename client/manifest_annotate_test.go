@@ -0,0 +1,63 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManifestAnnotateNotFoundError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusNotFound, "Not Found")),
+	}
+	err := client.ManifestAnnotate(context.Background(), "app:v1", &types.ManifestAnnotateConfig{Image: "app:v1-arm"})
+	if err == nil || !strings.Contains(err.Error(), "Not Found") {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func TestManifestAnnotate(t *testing.T) {
+	expectedURL := "/manifests/app:v1/annotate"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != http.MethodPost {
+			return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+		}
+
+		config := types.ManifestAnnotateConfig{}
+		if err := json.NewDecoder(req.Body).Decode(&config); err != nil {
+			return nil, err
+		}
+		if config.Image != "app:v1-arm" || config.Architecture != "arm" || config.Variant != "v7" {
+			return nil, fmt.Errorf("unexpected config %+v", config)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	err := client.ManifestAnnotate(context.Background(), "app:v1", &types.ManifestAnnotateConfig{
+		Image:        "app:v1-arm",
+		Architecture: "arm",
+		Variant:      "v7",
+	})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,20 @@
+package client
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// ManifestCreate requests daemon to create a manifest list of images in
+// registry, which is stored locally until it is pushed.
+func (client *APIClient) ManifestCreate(ctx context.Context, config *types.ManifestCreateConfig, encodedAuth string) error {
+	headers := map[string][]string{}
+	if encodedAuth != "" {
+		headers["X-Registry-Auth"] = []string{encodedAuth}
+	}
+
+	resp, err := client.post(ctx, "/manifests/create", nil, config, headers)
+	ensureCloseReader(resp)
+	return err
+}
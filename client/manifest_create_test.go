@@ -0,0 +1,66 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManifestCreateError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusConflict, "already exists")),
+	}
+	err := client.ManifestCreate(context.Background(), &types.ManifestCreateConfig{Name: "app:v1"}, "")
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("expected a conflict error, got %v", err)
+	}
+}
+
+func TestManifestCreate(t *testing.T) {
+	expectedURL := "/manifests/create"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != http.MethodPost {
+			return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+		}
+		if auth := req.Header.Get("X-Registry-Auth"); auth != "auth" {
+			return nil, fmt.Errorf("expected registry auth 'auth', got '%s'", auth)
+		}
+
+		config := types.ManifestCreateConfig{}
+		if err := json.NewDecoder(req.Body).Decode(&config); err != nil {
+			return nil, err
+		}
+		if config.Name != "app:v1" || len(config.Images) != 2 || !config.Amend {
+			return nil, fmt.Errorf("unexpected config %+v", config)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	err := client.ManifestCreate(context.Background(), &types.ManifestCreateConfig{
+		Name:   "app:v1",
+		Images: []string{"app:v1-amd64", "app:v1-arm64"},
+		Amend:  true,
+	}, "auth")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,20 @@
+package client
+
+import (
+	"context"
+	"io"
+)
+
+// ManifestPush requests daemon to push the manifest list to registry.
+func (client *APIClient) ManifestPush(ctx context.Context, name, encodedAuth string) (io.ReadCloser, error) {
+	headers := map[string][]string{}
+	if encodedAuth != "" {
+		headers["X-Registry-Auth"] = []string{encodedAuth}
+	}
+
+	resp, err := client.post(ctx, "/manifests/"+name+"/push", nil, nil, headers)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// NetworkReserve reserves static addresses on a network for a container name.
+func (client *APIClient) NetworkReserve(ctx context.Context, network string, req *types.NetworkReservation) error {
+	resp, err := client.post(ctx, "/networks/"+network+"/reservations", nil, req, nil)
+	if err != nil {
+		return err
+	}
+
+	ensureCloseReader(resp)
+	return nil
+}
+
+// NetworkUnreserve releases the addresses reserved on a network for a container name.
+func (client *APIClient) NetworkUnreserve(ctx context.Context, network, name string) error {
+	resp, err := client.delete(ctx, "/networks/"+network+"/reservations/"+name, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	ensureCloseReader(resp)
+	return nil
+}
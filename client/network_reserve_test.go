@@ -0,0 +1,96 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+func TestNetworkReserveConflictError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusConflict, "already existed")),
+	}
+	err := client.NetworkReserve(context.Background(), "network_id", &types.NetworkReservation{Name: "web"})
+	if err == nil || !strings.Contains(err.Error(), "already existed") {
+		t.Fatalf("expected an already existed Error, got %v", err)
+	}
+}
+
+func TestNetworkReserve(t *testing.T) {
+	expectedURL := "/networks/network_id/reservations"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "POST" {
+			return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+		}
+
+		reservation := &types.NetworkReservation{}
+		if err := json.NewDecoder(req.Body).Decode(reservation); err != nil {
+			return nil, err
+		}
+
+		if reservation.Name != "web" || reservation.IPV4Address != "192.168.1.10" {
+			return nil, fmt.Errorf("expected reservation of web with 192.168.1.10, got %v", reservation)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	err := client.NetworkReserve(context.Background(), "network_id", &types.NetworkReservation{Name: "web", IPV4Address: "192.168.1.10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNetworkUnreserveNotFoundError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusNotFound, "Not Found")),
+	}
+	err := client.NetworkUnreserve(context.Background(), "network_id", "no_reservation")
+	if err == nil || !strings.Contains(err.Error(), "Not Found") {
+		t.Fatalf("expected a Not Found Error, got %v", err)
+	}
+}
+
+func TestNetworkUnreserve(t *testing.T) {
+	expectedURL := "/networks/network_id/reservations/web"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != expectedURL {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "DELETE" {
+			return nil, fmt.Errorf("expected DELETE method, got %s", req.Method)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusNoContent,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	if err := client.NetworkUnreserve(context.Background(), "network_id", "web"); err != nil {
+		t.Fatal(err)
+	}
+}
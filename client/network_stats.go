@@ -0,0 +1,21 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+// NetworkStats return the traffic stats of endpoints attached to network in an io.ReadCloser.
+func (client *APIClient) NetworkStats(ctx context.Context, networkID string, stream bool) (io.ReadCloser, error) {
+	query := url.Values{}
+	if stream {
+		query.Set("stream", "1")
+	}
+	resp, err := client.get(ctx, fmt.Sprintf("/networks/%s/stats", networkID), query, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkStatsServerError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	_, err := client.NetworkStats(context.Background(), "network_id", false)
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestNetworkStats(t *testing.T) {
+	expectedURL := "/networks/network_id/stats"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "GET" {
+			return nil, fmt.Errorf("expected GET method, got %s", req.Method)
+		}
+		if stream := req.URL.Query().Get("stream"); stream != "" {
+			return nil, fmt.Errorf("expected no stream query, got %s", stream)
+		}
+
+		statsResp, err := json.Marshal(types.NetworkStatsResp{
+			ID:    "network_id",
+			Name:  "net-1",
+			Total: &types.NetworkStats{RxBytes: 10, TxBytes: 20},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(statsResp)),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	body, err := client.NetworkStats(context.Background(), "network_id", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close()
+
+	stats := &types.NetworkStatsResp{}
+	if err := json.NewDecoder(body).Decode(stats); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "net-1", stats.Name)
+	assert.Equal(t, uint64(10), stats.Total.RxBytes)
+	assert.Equal(t, uint64(20), stats.Total.TxBytes)
+}
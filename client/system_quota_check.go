@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// SystemQuotaCheck requests daemon to check the quota ids of containers and
+// volumes, the issues found are repaired if fix is true.
+func (client *APIClient) SystemQuotaCheck(ctx context.Context, fix bool) (*types.QuotaCheckResp, error) {
+	q := url.Values{}
+	if fix {
+		q.Set("fix", "true")
+	}
+
+	resp, err := client.post(ctx, "/system/quota-check", q, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &types.QuotaCheckResp{}
+	err = decodeBody(result, resp.Body)
+	ensureCloseReader(resp)
+
+	return result, err
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSystemQuotaCheckError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	_, err := client.SystemQuotaCheck(context.Background(), false)
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestSystemQuotaCheck(t *testing.T) {
+	expectedURL := "/system/quota-check"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "POST" {
+			return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+		}
+		if fix := req.URL.Query().Get("fix"); fix != "true" {
+			return nil, fmt.Errorf("expected fix true, got %s", fix)
+		}
+
+		resp := types.QuotaCheckResp{
+			Issues: []*types.QuotaCheckIssue{
+				{
+					QuotaID: "16777217",
+					Type:    "orphaned",
+					Fixed:   true,
+				},
+			},
+		}
+		b, err := json.Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(b))),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	resp, err := client.SystemQuotaCheck(context.Background(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, len(resp.Issues), 1)
+	assert.Equal(t, resp.Issues[0].QuotaID, "16777217")
+	assert.Equal(t, resp.Issues[0].Type, "orphaned")
+	assert.Equal(t, resp.Issues[0].Fixed, true)
+}
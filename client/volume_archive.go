@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// VolumeArchive gets a tar archive of the data in volume and returns it as
+// a Reader. It's up to the caller to close the reader.
+func (client *APIClient) VolumeArchive(ctx context.Context, name string, pause bool) (io.ReadCloser, error) {
+	query := url.Values{}
+	if pause {
+		query.Set("pause", "true")
+	}
+
+	response, err := client.get(ctx, "/volumes/"+name+"/archive", query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		ensureCloseReader(response)
+		return nil, fmt.Errorf("unexpected status code from daemon: %d", response.StatusCode)
+	}
+	return response.Body, nil
+}
+
+// VolumeRestore extracts a tar archive into volume.
+func (client *APIClient) VolumeRestore(ctx context.Context, name string, content io.Reader, pause bool) error {
+	query := url.Values{}
+	if pause {
+		query.Set("pause", "true")
+	}
+
+	response, err := client.putRawData(ctx, "/volumes/"+name+"/archive", query, content, nil)
+	if err != nil {
+		return err
+	}
+	ensureCloseReader(response)
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code from daemon: %d", response.StatusCode)
+	}
+
+	return nil
+}
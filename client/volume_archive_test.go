@@ -0,0 +1,105 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestVolumeArchiveError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	_, err := client.VolumeArchive(context.Background(), "nothing", false)
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestVolumeArchive(t *testing.T) {
+	expectedURL := "/volumes/volume_id/archive"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "GET" {
+			return nil, fmt.Errorf("expected GET method, got %s", req.Method)
+		}
+		if pause := req.URL.Query().Get("pause"); pause != "true" {
+			return nil, fmt.Errorf("expected pause true, got %s", pause)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("content"))),
+		}, nil
+	})
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	content, err := client.VolumeArchive(context.Background(), "volume_id", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer content.Close()
+
+	data, err := ioutil.ReadAll(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "content" {
+		t.Fatalf("expected content, got %s", string(data))
+	}
+}
+
+func TestVolumeRestoreError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	err := client.VolumeRestore(context.Background(), "nothing", bytes.NewReader(nil), false)
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestVolumeRestore(t *testing.T) {
+	expectedURL := "/volumes/volume_id/archive"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "PUT" {
+			return nil, fmt.Errorf("expected PUT method, got %s", req.Method)
+		}
+		if pause := req.URL.Query().Get("pause"); pause != "" {
+			return nil, fmt.Errorf("expected no pause, got %s", pause)
+		}
+
+		data, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		if string(data) != "content" {
+			return nil, fmt.Errorf("expected content, got %s", string(data))
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+		}, nil
+	})
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	if err := client.VolumeRestore(context.Background(), "volume_id", strings.NewReader("content"), false); err != nil {
+		t.Fatal(err)
+	}
+}
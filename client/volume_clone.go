@@ -0,0 +1,22 @@
+package client
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// VolumeClone creates a new volume with the options, labels and data of volume.
+func (client *APIClient) VolumeClone(ctx context.Context, name string, config *types.VolumeCloneConfig) (*types.VolumeInfo, error) {
+	resp, err := client.post(ctx, "/volumes/"+name+"/clone", nil, config, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	volume := &types.VolumeInfo{}
+
+	err = decodeBody(volume, resp.Body)
+	ensureCloseReader(resp)
+
+	return volume, err
+}
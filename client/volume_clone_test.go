@@ -0,0 +1,70 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+func TestVolumeCloneError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	_, err := client.VolumeClone(context.Background(), "nothing", &types.VolumeCloneConfig{Name: "target"})
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestVolumeClone(t *testing.T) {
+	expectedURL := "/volumes/volume_id/clone"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "POST" {
+			return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+		}
+
+		config := &types.VolumeCloneConfig{}
+		if err := json.NewDecoder(req.Body).Decode(config); err != nil {
+			return nil, fmt.Errorf("failed to parse json: %v", err)
+		}
+		if config.Name != "target" || !config.Pause {
+			return nil, fmt.Errorf("unexpected clone config: %+v", config)
+		}
+
+		volumeInfo := types.VolumeInfo{
+			Name:   config.Name,
+			Driver: "local",
+		}
+		b, err := json.Marshal(volumeInfo)
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		}, nil
+	})
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	volume, err := client.VolumeClone(context.Background(), "volume_id", &types.VolumeCloneConfig{Name: "target", Pause: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if volume.Name != "target" || volume.Driver != "local" {
+		t.Fatalf("unexpected volume: %+v", volume)
+	}
+}
@@ -10,7 +10,16 @@ import (
 
 // VolumeList returns the list of volumes.
 func (client *APIClient) VolumeList(ctx context.Context, filter filters.Args) (*types.VolumeListResp, error) {
+	return client.VolumeListWithOptions(ctx, filter, types.VolumeListOptions{})
+}
+
+// VolumeListWithOptions returns the list of volumes with options, the usage
+// data of volumes are returned if options.Size is true.
+func (client *APIClient) VolumeListWithOptions(ctx context.Context, filter filters.Args, options types.VolumeListOptions) (*types.VolumeListResp, error) {
 	query := url.Values{}
+	if options.Size {
+		query.Set("size", "true")
+	}
 	if filter.Len() > 0 {
 		filtersJSON, err := filters.ToParam(filter)
 		if err != nil {
@@ -74,3 +74,40 @@ func TestVolumeList(t *testing.T) {
 	}
 	assert.Equal(t, len(volume.Volumes), 3)
 }
+
+func TestVolumeListWithOptions(t *testing.T) {
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if size := req.URL.Query().Get("size"); size != "true" {
+			return nil, fmt.Errorf("expected size true, got %s", size)
+		}
+
+		volListResp, err := json.Marshal(types.VolumeListResp{
+			Volumes: []*types.VolumeInfo{
+				{
+					Driver:    "local",
+					Name:      "volume-1",
+					UsageData: &types.VolumeUsageData{Size: 1024, RefCount: 2},
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(volListResp)),
+		}, nil
+	})
+
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	volume, err := client.VolumeListWithOptions(context.Background(), filters.NewArgs(), types.VolumeListOptions{Size: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, len(volume.Volumes), 1)
+	assert.Equal(t, volume.Volumes[0].UsageData, &types.VolumeUsageData{Size: 1024, RefCount: 2})
+}
@@ -0,0 +1,15 @@
+package client
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// VolumeUpdate updates the size and labels of a volume.
+func (client *APIClient) VolumeUpdate(ctx context.Context, name string, config *types.VolumeUpdateConfig) error {
+	resp, err := client.post(ctx, "/volumes/"+name+"/update", nil, config, nil)
+	ensureCloseReader(resp)
+
+	return err
+}
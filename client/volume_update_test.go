@@ -0,0 +1,58 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+func TestVolumeUpdateError(t *testing.T) {
+	client := &APIClient{
+		HTTPCli: newMockClient(errorMockResponse(http.StatusInternalServerError, "Server error")),
+	}
+	err := client.VolumeUpdate(context.Background(), "nothing", &types.VolumeUpdateConfig{})
+	if err == nil || !strings.Contains(err.Error(), "Server error") {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestVolumeUpdate(t *testing.T) {
+	expectedURL := "/volumes/volume_id/update"
+
+	httpClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasPrefix(req.URL.Path, expectedURL) {
+			return nil, fmt.Errorf("expected URL '%s', got '%s'", expectedURL, req.URL)
+		}
+		if req.Method != "POST" {
+			return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+		}
+
+		config := &types.VolumeUpdateConfig{}
+		if err := json.NewDecoder(req.Body).Decode(config); err != nil {
+			return nil, fmt.Errorf("failed to parse json: %v", err)
+		}
+		if config.Size != "20g" || config.Labels["a"] != "b" {
+			return nil, fmt.Errorf("unexpected update config: %+v", config)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+		}, nil
+	})
+	client := &APIClient{
+		HTTPCli: httpClient,
+	}
+
+	config := &types.VolumeUpdateConfig{Size: "20g", Labels: map[string]string{"a": "b"}}
+	if err := client.VolumeUpdate(context.Background(), "volume_id", config); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -10,4 +10,12 @@ var (
 // ConfigFile defines configs that file needs keep.
 type ConfigFile struct {
 	AuthConfigs map[string]types.AuthConfig `json:"auths"`
+
+	// CredentialsStore is the default credential helper, the credentials
+	// are kept by it instead of the config file if set.
+	CredentialsStore string `json:"credsStore,omitempty"`
+
+	// CredentialHelpers are the credential helpers of registries, which
+	// take precedence over the default one.
+	CredentialHelpers map[string]string `json:"credHelpers,omitempty"`
 }
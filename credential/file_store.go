@@ -13,7 +13,7 @@ type fileStore struct {
 	fileName   string
 }
 
-func newFileStore() Store {
+func newFileStore() *fileStore {
 	fs := &fileStore{
 		fileName: filepath.Join(homedir(), configFileName),
 	}
@@ -36,9 +36,10 @@ func newFileStore() Store {
 // Save implements Store interface.
 func (fs *fileStore) Save(authConfig *types.AuthConfig) error {
 	if fs.configFile == nil {
-		fs.configFile = &ConfigFile{
-			AuthConfigs: make(map[string]types.AuthConfig),
-		}
+		fs.configFile = &ConfigFile{}
+	}
+	if fs.configFile.AuthConfigs == nil {
+		fs.configFile.AuthConfigs = make(map[string]types.AuthConfig)
 	}
 
 	encodedAuth := encodeAuth(authConfig.Username, authConfig.Password)
@@ -114,6 +115,19 @@ func (fs *fileStore) Exist(serverAddress string) bool {
 	return exist
 }
 
+// credentialHelper returns the name of credential helper of registry, or
+// empty if the credential is kept in the config file.
+func (fs *fileStore) credentialHelper(serverAddress string) string {
+	if fs.configFile == nil {
+		return ""
+	}
+
+	if helper, ok := fs.configFile.CredentialHelpers[normalizeHost(serverAddress)]; ok {
+		return helper
+	}
+	return fs.configFile.CredentialsStore
+}
+
 // update updates file store with new contents.
 func (fs *fileStore) update() error {
 	if fs.configFile == nil {
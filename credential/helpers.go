@@ -65,3 +65,12 @@ func convertHost(addr string) string {
 	splits := strings.SplitN(addr, "/", 2)
 	return splits[0]
 }
+
+// normalizeHost returns the host of server address, or the default registry
+// if the address is empty.
+func normalizeHost(addr string) string {
+	if addr == "" {
+		return defaultRegistry
+	}
+	return convertHost(addr)
+}
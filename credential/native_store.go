@@ -0,0 +1,178 @@
+package credential
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+)
+
+const (
+	// credentialHelperPrefix is the prefix of the credential helper program,
+	// the suffix is the name configured in credsStore or credHelpers.
+	credentialHelperPrefix = "docker-credential-"
+
+	// tokenUsername is the username stored by the credential helper when the
+	// secret is an identity token.
+	tokenUsername = "<token>"
+
+	// errCredentialsNotFoundMessage is the message printed by the credential
+	// helper when no credential of the server is stored.
+	errCredentialsNotFoundMessage = "credentials not found in native keychain"
+)
+
+// helperCredentials is the credential exchanged with the credential helper.
+type helperCredentials struct {
+	ServerURL string
+	Username  string
+	Secret    string
+}
+
+// nativeStore keeps the credentials in the native store of system, such as
+// the keychain of macOS or pass of linux, by executing the credential helper
+// of the docker-credential-helpers protocol. Nothing but the name of helper
+// is kept in the config file.
+type nativeStore struct {
+	program   string
+	fileStore *fileStore
+}
+
+func newNativeStore(helper string, fs *fileStore) Store {
+	return &nativeStore{
+		program:   credentialHelperPrefix + helper,
+		fileStore: fs,
+	}
+}
+
+// Save implements Store interface.
+func (ns *nativeStore) Save(authConfig *types.AuthConfig) error {
+	serverAddress := normalizeHost(authConfig.ServerAddress)
+
+	creds := helperCredentials{
+		ServerURL: serverAddress,
+		Username:  authConfig.Username,
+		Secret:    authConfig.Password,
+	}
+	if authConfig.IdentityToken != "" {
+		creds.Username = tokenUsername
+		creds.Secret = authConfig.IdentityToken
+	}
+	if creds.Username == "" || creds.Secret == "" {
+		return nil
+	}
+
+	input, err := json.Marshal(creds)
+	if err != nil {
+		return err
+	}
+	if _, err := ns.execute("store", input); err != nil {
+		return err
+	}
+
+	// the credential saved before the helper is configured should not be
+	// left in plaintext.
+	if ns.fileStore.Exist(serverAddress) {
+		return ns.fileStore.Delete(serverAddress)
+	}
+	return nil
+}
+
+// Get implements Store interface.
+func (ns *nativeStore) Get(serverAddress string) (types.AuthConfig, error) {
+	serverAddress = normalizeHost(serverAddress)
+
+	output, err := ns.execute("get", []byte(serverAddress))
+	if err != nil {
+		return types.AuthConfig{}, err
+	}
+	if output == nil {
+		return ns.fileStore.Get(serverAddress)
+	}
+
+	var creds helperCredentials
+	if err := json.Unmarshal(output, &creds); err != nil {
+		return types.AuthConfig{}, fmt.Errorf("failed to decode the output of %s: %v", ns.program, err)
+	}
+
+	authConfig := types.AuthConfig{
+		ServerAddress: serverAddress,
+	}
+	if creds.Username == tokenUsername {
+		authConfig.IdentityToken = creds.Secret
+	} else {
+		authConfig.Username = creds.Username
+		authConfig.Password = creds.Secret
+	}
+	return authConfig, nil
+}
+
+// Delete implements Store interface.
+func (ns *nativeStore) Delete(serverAddress string) error {
+	serverAddress = normalizeHost(serverAddress)
+
+	if _, err := ns.execute("erase", []byte(serverAddress)); err != nil {
+		return err
+	}
+
+	if ns.fileStore.Exist(serverAddress) {
+		return ns.fileStore.Delete(serverAddress)
+	}
+	return nil
+}
+
+// Exist implements Store interface.
+func (ns *nativeStore) Exist(serverAddress string) bool {
+	serverAddress = normalizeHost(serverAddress)
+
+	servers, err := ns.list()
+	if err != nil {
+		return false
+	}
+	for server := range servers {
+		if convertHost(server) == serverAddress {
+			return true
+		}
+	}
+	return ns.fileStore.Exist(serverAddress)
+}
+
+// list returns the servers and usernames of the credentials stored by the
+// credential helper.
+func (ns *nativeStore) list() (map[string]string, error) {
+	output, err := ns.execute("list", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	servers := make(map[string]string)
+	if output == nil {
+		return servers, nil
+	}
+	if err := json.Unmarshal(output, &servers); err != nil {
+		return nil, fmt.Errorf("failed to decode the output of %s: %v", ns.program, err)
+	}
+	return servers, nil
+}
+
+// execute runs the action of credential helper with input, and returns its
+// output. The output is nil if the credential is not found.
+func (ns *nativeStore) execute(action string, input []byte) ([]byte, error) {
+	cmd := exec.Command(ns.program, action)
+	cmd.Stdin = bytes.NewReader(input)
+
+	output, err := cmd.Output()
+	if err != nil {
+		msg := strings.TrimSpace(string(output))
+		if strings.Contains(msg, errCredentialsNotFoundMessage) {
+			return nil, nil
+		}
+		if msg == "" {
+			msg = err.Error()
+		}
+		return nil, fmt.Errorf("failed to execute %s %s: %s", ns.program, action, msg)
+	}
+	return output, nil
+}
@@ -0,0 +1,203 @@
+package credential
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeHelperDirEnv is the env of the directory where the fake credential
+// helper keeps the credentials.
+const fakeHelperDirEnv = "POUCH_FAKE_CREDENTIAL_DIR"
+
+func TestMain(m *testing.M) {
+	// the test binary acts as the credential helper when it is executed
+	// by the link named docker-credential-fake.
+	if filepath.Base(os.Args[0]) == credentialHelperPrefix+"fake" {
+		os.Exit(fakeHelper(os.Args[1]))
+	}
+	os.Exit(m.Run())
+}
+
+// fakeHelper implements the docker-credential-helpers protocol, the
+// credentials are kept in a json file.
+func fakeHelper(action string) int {
+	file := filepath.Join(os.Getenv(fakeHelperDirEnv), "creds.json")
+
+	store := make(map[string]helperCredentials)
+	if data, err := ioutil.ReadFile(file); err == nil {
+		json.Unmarshal(data, &store)
+	}
+
+	input, _ := ioutil.ReadAll(os.Stdin)
+	switch action {
+	case "store":
+		var creds helperCredentials
+		if err := json.Unmarshal(input, &creds); err != nil {
+			fmt.Println(err)
+			return 1
+		}
+		store[creds.ServerURL] = creds
+	case "get":
+		creds, ok := store[strings.TrimSpace(string(input))]
+		if !ok {
+			fmt.Println(errCredentialsNotFoundMessage)
+			return 1
+		}
+		data, _ := json.Marshal(creds)
+		fmt.Println(string(data))
+		return 0
+	case "erase":
+		delete(store, strings.TrimSpace(string(input)))
+	case "list":
+		servers := make(map[string]string)
+		for server, creds := range store {
+			servers[server] = creds.Username
+		}
+		data, _ := json.Marshal(servers)
+		fmt.Println(string(data))
+		return 0
+	default:
+		fmt.Printf("unknown action %s\n", action)
+		return 1
+	}
+
+	data, _ := json.Marshal(store)
+	if err := ioutil.WriteFile(file, data, 0600); err != nil {
+		fmt.Println(err)
+		return 1
+	}
+	return 0
+}
+
+// setupFakeHelper installs the fake credential helper, and points the home
+// to a temporary directory with the config file.
+func setupFakeHelper(t *testing.T, configFile *ConfigFile) func() {
+	dir, err := ioutil.TempDir("", "credential")
+	assert.NoError(t, err)
+
+	self, err := os.Executable()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Symlink(self, filepath.Join(dir, credentialHelperPrefix+"fake")))
+
+	data, err := json.Marshal(configFile)
+	assert.NoError(t, err)
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, ".pouch"), 0700))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, configFileName), data, 0600))
+
+	envs := map[string]string{
+		"HOME":           dir,
+		"PATH":           dir + string(os.PathListSeparator) + os.Getenv("PATH"),
+		fakeHelperDirEnv: dir,
+	}
+	origin := make(map[string]string)
+	for k, v := range envs {
+		origin[k] = os.Getenv(k)
+		os.Setenv(k, v)
+	}
+
+	return func() {
+		for k, v := range origin {
+			os.Setenv(k, v)
+		}
+		os.RemoveAll(dir)
+	}
+}
+
+func TestNativeStore(t *testing.T) {
+	cleanup := setupFakeHelper(t, &ConfigFile{
+		AuthConfigs: map[string]types.AuthConfig{
+			"reg.example.com": {Auth: encodeAuth("old", "plaintext")},
+		},
+		CredentialsStore: "fake",
+	})
+	defer cleanup()
+
+	assert.False(t, Exist("docker.io"))
+
+	// the credential is kept by helper, and the plaintext one is removed.
+	assert.NoError(t, Save(&types.AuthConfig{
+		ServerAddress: "https://reg.example.com/v2/",
+		Username:      "user",
+		Password:      "secret",
+	}))
+	assert.True(t, Exist("reg.example.com"))
+
+	data, err := ioutil.ReadFile(filepath.Join(os.Getenv("HOME"), configFileName))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), encodeAuth("old", "plaintext"))
+	assert.NotContains(t, string(data), encodeAuth("user", "secret"))
+
+	authConfig, err := Get("reg.example.com/library/busybox")
+	assert.NoError(t, err)
+	assert.Equal(t, types.AuthConfig{
+		ServerAddress: "reg.example.com",
+		Username:      "user",
+		Password:      "secret",
+	}, authConfig)
+
+	// the identity token is stored with the token username.
+	assert.NoError(t, Save(&types.AuthConfig{IdentityToken: "token"}))
+	authConfig, err = Get("")
+	assert.NoError(t, err)
+	assert.Equal(t, types.AuthConfig{
+		ServerAddress: defaultRegistry,
+		IdentityToken: "token",
+	}, authConfig)
+
+	assert.NoError(t, Delete("reg.example.com"))
+	assert.False(t, Exist("reg.example.com"))
+	authConfig, err = Get("reg.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, types.AuthConfig{}, authConfig)
+}
+
+func TestCredentialHelpers(t *testing.T) {
+	cleanup := setupFakeHelper(t, &ConfigFile{
+		CredentialHelpers: map[string]string{
+			"reg.example.com": "fake",
+			"gcr.io":          "missing",
+		},
+	})
+	defer cleanup()
+
+	// the registry without helper is kept in the config file.
+	assert.NoError(t, Save(&types.AuthConfig{
+		ServerAddress: "docker.io",
+		Username:      "user",
+		Password:      "secret",
+	}))
+	data, err := ioutil.ReadFile(filepath.Join(os.Getenv("HOME"), configFileName))
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), encodeAuth("user", "secret"))
+
+	assert.NoError(t, Save(&types.AuthConfig{
+		ServerAddress: "reg.example.com",
+		Username:      "admin",
+		Password:      "password",
+	}))
+	data, err = ioutil.ReadFile(filepath.Join(os.Getenv("HOME"), configFileName))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), encodeAuth("admin", "password"))
+
+	authConfig, err := Get("reg.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", authConfig.Username)
+
+	// the error of helper is surfaced instead of falling back to file.
+	err = Save(&types.AuthConfig{
+		ServerAddress: "gcr.io",
+		Username:      "user",
+		Password:      "secret",
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), credentialHelperPrefix+"missing")
+}
@@ -466,6 +466,11 @@ type Mount struct {
 	Propagation MountPropagation `protobuf:"varint,5,opt,name=propagation,proto3,enum=runtime.v1alpha2.MountPropagation" json:"propagation,omitempty"`
 	// Name of volume
 	Name string `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`
+	// If set, the data in image is not copied into the volume.
+	Nocopy bool `protobuf:"varint,101,opt,name=nocopy,proto3" json:"nocopy,omitempty"`
+	// The subdirectory of volume mounted into container, it is created if
+	// it doesn't exist.
+	Subpath string `protobuf:"bytes,102,opt,name=subpath,proto3" json:"subpath,omitempty"`
 }
 
 func (m *Mount) Reset()                    { *m = Mount{} }
@@ -514,6 +519,20 @@ func (m *Mount) GetName() string {
 	return ""
 }
 
+func (m *Mount) GetNocopy() bool {
+	if m != nil {
+		return m.Nocopy
+	}
+	return false
+}
+
+func (m *Mount) GetSubpath() string {
+	if m != nil {
+		return m.Subpath
+	}
+	return ""
+}
+
 // NamespaceOption provides options for Linux namespaces.
 type NamespaceOption struct {
 	// Network namespace for this container/sandbox.
@@ -5639,6 +5658,26 @@ func (m *Mount) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintApi(dAtA, i, uint64(len(m.Name)))
 		i += copy(dAtA[i:], m.Name)
 	}
+	if m.Nocopy {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.Nocopy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Subpath) > 0 {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Subpath)))
+		i += copy(dAtA[i:], m.Subpath)
+	}
 	return i, nil
 }
 
@@ -10270,6 +10309,13 @@ func (m *Mount) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovApi(uint64(l))
 	}
+	if m.Nocopy {
+		n += 3
+	}
+	l = len(m.Subpath)
+	if l > 0 {
+		n += 2 + l + sovApi(uint64(l))
+	}
 	return n
 }
 
@@ -12225,6 +12271,8 @@ func (this *Mount) String() string {
 		`SelinuxRelabel:` + fmt.Sprintf("%v", this.SelinuxRelabel) + `,`,
 		`Propagation:` + fmt.Sprintf("%v", this.Propagation) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Nocopy:` + fmt.Sprintf("%v", this.Nocopy) + `,`,
+		`Subpath:` + fmt.Sprintf("%v", this.Subpath) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14449,6 +14497,55 @@ func (m *Mount) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 101:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nocopy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Nocopy = bool(v != 0)
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subpath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subpath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -30192,339 +30289,339 @@ var (
 func init() { proto.RegisterFile("api.proto", fileDescriptorApi) }
 
 var fileDescriptorApi = []byte{
-	// 5333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7c, 0x4b, 0x73, 0x1b, 0x57,
-	0x76, 0x30, 0xf1, 0x20, 0x09, 0x1c, 0x10, 0x24, 0x78, 0x49, 0x89, 0x10, 0x64, 0xbd, 0x5a, 0x6f,
-	0xd9, 0xa2, 0x2c, 0x7a, 0x46, 0xb6, 0x1e, 0x23, 0x9b, 0x22, 0x29, 0x09, 0xdf, 0x48, 0x20, 0xbe,
-	0x06, 0x69, 0xd9, 0x63, 0x57, 0xf5, 0x34, 0xd1, 0x97, 0x60, 0x5b, 0x40, 0x77, 0xbb, 0x6f, 0x43,
-	0x14, 0x93, 0xaa, 0x94, 0xab, 0x52, 0x35, 0x8b, 0xac, 0xb2, 0xce, 0x72, 0x66, 0x91, 0x45, 0x36,
-	0xa9, 0x54, 0x65, 0x95, 0x6c, 0x92, 0x9a, 0x4a, 0xcd, 0x66, 0xaa, 0xb2, 0x4a, 0xe5, 0xb1, 0x89,
-	0x9d, 0xac, 0x66, 0x91, 0xca, 0x3f, 0x48, 0xea, 0xbe, 0x1a, 0xfd, 0xc4, 0x83, 0x96, 0xc7, 0xce,
-	0x8a, 0xb8, 0xa7, 0xcf, 0x39, 0xf7, 0xdc, 0x73, 0x4f, 0x9f, 0x7b, 0x1e, 0xb7, 0x09, 0x45, 0xdd,
-	0x31, 0x57, 0x1d, 0xd7, 0xf6, 0x6c, 0x54, 0x71, 0xfb, 0x96, 0x67, 0xf6, 0xf0, 0xea, 0xab, 0xdb,
-	0x7a, 0xd7, 0x39, 0xd0, 0xd7, 0x6a, 0x37, 0x3b, 0xa6, 0x77, 0xd0, 0xdf, 0x5b, 0x6d, 0xdb, 0xbd,
-	0x5b, 0x1d, 0xbb, 0x63, 0xdf, 0x62, 0x88, 0x7b, 0xfd, 0x7d, 0x36, 0x62, 0x03, 0xf6, 0x8b, 0x33,
-	0x50, 0x6e, 0xc0, 0xfc, 0xc7, 0xd8, 0x25, 0xa6, 0x6d, 0xa9, 0xf8, 0xcb, 0x3e, 0x26, 0x1e, 0xaa,
-	0xc2, 0xec, 0x2b, 0x0e, 0xa9, 0x66, 0xce, 0x67, 0xae, 0x15, 0x55, 0x39, 0x54, 0xfe, 0x3c, 0x03,
-	0x0b, 0x3e, 0x32, 0x71, 0x6c, 0x8b, 0xe0, 0x74, 0x6c, 0x74, 0x01, 0xe6, 0x84, 0x70, 0x9a, 0xa5,
-	0xf7, 0x70, 0x35, 0xcb, 0x1e, 0x97, 0x04, 0xac, 0xa1, 0xf7, 0x30, 0xba, 0x0a, 0x0b, 0x12, 0x45,
-	0x32, 0xc9, 0x31, 0xac, 0x79, 0x01, 0x16, 0xb3, 0xa1, 0x55, 0x58, 0x92, 0x88, 0xba, 0x63, 0xfa,
-	0xc8, 0x79, 0x86, 0xbc, 0x28, 0x1e, 0xad, 0x3b, 0xa6, 0xc0, 0x57, 0x3e, 0x83, 0xe2, 0x66, 0xa3,
-	0xb5, 0x61, 0x5b, 0xfb, 0x66, 0x87, 0x8a, 0x48, 0xb0, 0x4b, 0x69, 0xaa, 0x99, 0xf3, 0x39, 0x2a,
-	0xa2, 0x18, 0xa2, 0x1a, 0x14, 0x08, 0xd6, 0xdd, 0xf6, 0x01, 0x26, 0xd5, 0x2c, 0x7b, 0xe4, 0x8f,
-	0x29, 0x95, 0xed, 0x78, 0xa6, 0x6d, 0x91, 0x6a, 0x8e, 0x53, 0x89, 0xa1, 0xf2, 0xcb, 0x0c, 0x94,
-	0x9a, 0xb6, 0xeb, 0x3d, 0xd7, 0x1d, 0xc7, 0xb4, 0x3a, 0xe8, 0x0e, 0x14, 0x98, 0x2e, 0xdb, 0x76,
-	0x97, 0xe9, 0x60, 0x7e, 0xad, 0xb6, 0x1a, 0xdd, 0x96, 0xd5, 0xa6, 0xc0, 0x50, 0x7d, 0x5c, 0x74,
-	0x19, 0xe6, 0xdb, 0xb6, 0xe5, 0xe9, 0xa6, 0x85, 0x5d, 0xcd, 0xb1, 0x5d, 0x8f, 0xa9, 0x68, 0x5a,
-	0x2d, 0xfb, 0x50, 0x3a, 0x0b, 0x3a, 0x0d, 0xc5, 0x03, 0x9b, 0x78, 0x1c, 0x23, 0xc7, 0x30, 0x0a,
-	0x14, 0xc0, 0x1e, 0xae, 0xc0, 0x2c, 0x7b, 0x68, 0x3a, 0x42, 0x19, 0x33, 0x74, 0x58, 0x77, 0x94,
-	0xdf, 0x65, 0x60, 0xfa, 0xb9, 0xdd, 0xb7, 0xbc, 0xc8, 0x34, 0xba, 0x77, 0x20, 0x36, 0x2a, 0x30,
-	0x8d, 0xee, 0x1d, 0x0c, 0xa6, 0xa1, 0x18, 0x7c, 0xaf, 0xf8, 0x34, 0xf4, 0x61, 0x0d, 0x0a, 0x2e,
-	0xd6, 0x0d, 0xdb, 0xea, 0x1e, 0x31, 0x11, 0x0a, 0xaa, 0x3f, 0xa6, 0x9b, 0x48, 0x70, 0xd7, 0xb4,
-	0xfa, 0xaf, 0x35, 0x17, 0x77, 0xf5, 0x3d, 0xdc, 0x65, 0xa2, 0x14, 0xd4, 0x79, 0x01, 0x56, 0x39,
-	0x14, 0x6d, 0x42, 0xc9, 0x71, 0x6d, 0x47, 0xef, 0xe8, 0x54, 0x8f, 0xd5, 0x69, 0xa6, 0x2a, 0x25,
-	0xae, 0x2a, 0x26, 0x76, 0x73, 0x80, 0xa9, 0x06, 0xc9, 0x10, 0x82, 0x3c, 0x33, 0x27, 0x83, 0x89,
-	0xc8, 0x7e, 0x2b, 0x7f, 0x95, 0x81, 0x05, 0x6a, 0x50, 0xc4, 0xd1, 0xdb, 0x78, 0x9b, 0x6d, 0x13,
-	0xba, 0x0b, 0xb3, 0x16, 0xf6, 0x0e, 0x6d, 0xf7, 0xa5, 0xd8, 0x94, 0x73, 0xf1, 0x99, 0x7c, 0x9a,
-	0xe7, 0xb6, 0x81, 0x55, 0x89, 0x8f, 0x6e, 0x43, 0xce, 0x31, 0x0d, 0xa6, 0x84, 0x31, 0xc8, 0x28,
-	0x2e, 0x25, 0x31, 0x9d, 0x36, 0xd3, 0xcd, 0x38, 0x24, 0xa6, 0xd3, 0x56, 0x14, 0x80, 0xba, 0xe5,
-	0xdd, 0xf9, 0xd1, 0xc7, 0x7a, 0xb7, 0x8f, 0xd1, 0x32, 0x4c, 0xbf, 0xa2, 0x3f, 0x98, 0xb0, 0x39,
-	0x95, 0x0f, 0x94, 0xaf, 0x73, 0x70, 0xfa, 0x19, 0xd5, 0x61, 0x4b, 0xb7, 0x8c, 0x3d, 0xfb, 0x75,
-	0x0b, 0xb7, 0xfb, 0xae, 0xe9, 0x1d, 0x6d, 0xd8, 0x96, 0x87, 0x5f, 0x7b, 0xa8, 0x01, 0x8b, 0x96,
-	0xe4, 0xac, 0x49, 0x73, 0xa5, 0x1c, 0x4a, 0x6b, 0x17, 0x86, 0x08, 0xc1, 0x55, 0xa4, 0x56, 0xac,
-	0x30, 0x80, 0xa0, 0xa7, 0x83, 0xbd, 0x94, 0xdc, 0xb2, 0x8c, 0x5b, 0xc2, 0x92, 0x5a, 0x5b, 0x4c,
-	0x32, 0xc1, 0x4b, 0x6e, 0xb6, 0xe4, 0xf4, 0x00, 0xe8, 0x9b, 0xae, 0xe9, 0x44, 0xeb, 0x13, 0xec,
-	0x32, 0xc5, 0x94, 0xd6, 0xde, 0x8a, 0x73, 0x19, 0xa8, 0x40, 0x2d, 0xba, 0x7d, 0x6b, 0x9d, 0xec,
-	0x12, 0xec, 0x32, 0xc7, 0x20, 0xec, 0x4b, 0x73, 0x6d, 0xdb, 0xdb, 0x27, 0xd2, 0xa6, 0x24, 0x58,
-	0x65, 0x50, 0x74, 0x0b, 0x96, 0x48, 0xdf, 0x71, 0xba, 0xb8, 0x87, 0x2d, 0x4f, 0xef, 0x6a, 0x1d,
-	0xd7, 0xee, 0x3b, 0xa4, 0x3a, 0x7d, 0x3e, 0x77, 0x2d, 0xa7, 0xa2, 0xe0, 0xa3, 0x27, 0xec, 0x09,
-	0x3a, 0x0b, 0xe0, 0xb8, 0xe6, 0x2b, 0xb3, 0x8b, 0x3b, 0xd8, 0xa8, 0xce, 0x30, 0xa6, 0x01, 0x08,
-	0x7a, 0x17, 0x96, 0x09, 0x6e, 0xb7, 0xed, 0x9e, 0xa3, 0x39, 0xae, 0xbd, 0x6f, 0x76, 0x31, 0x7f,
-	0x23, 0x66, 0x99, 0xb9, 0x21, 0xf1, 0xac, 0xc9, 0x1f, 0xb1, 0x77, 0xe3, 0x21, 0xf3, 0x73, 0x74,
-	0xa5, 0x6c, 0xf2, 0x6a, 0x61, 0x8c, 0xa5, 0x02, 0x5b, 0x2a, 0x13, 0x49, 0xf9, 0x65, 0x16, 0x4e,
-	0x30, 0x4d, 0x36, 0x6d, 0x43, 0x6c, 0xb3, 0x70, 0x5c, 0x17, 0xa1, 0xdc, 0x66, 0x3c, 0x35, 0x47,
-	0x77, 0xb1, 0xe5, 0x89, 0x17, 0x77, 0x8e, 0x03, 0x9b, 0x0c, 0x86, 0x3e, 0x81, 0x0a, 0x11, 0x56,
-	0xa1, 0xb5, 0xb9, 0x59, 0x88, 0x3d, 0xbb, 0x19, 0x17, 0x61, 0x88, 0x2d, 0xa9, 0x0b, 0x24, 0x66,
-	0x5c, 0xb3, 0xe4, 0x88, 0xb4, 0xbd, 0x2e, 0xf7, 0x80, 0xa5, 0xb5, 0x1f, 0xa5, 0x30, 0x8c, 0x0a,
-	0xbe, 0xda, 0xe2, 0x64, 0x5b, 0x96, 0xe7, 0x1e, 0xa9, 0x92, 0x49, 0xed, 0x1e, 0xcc, 0x05, 0x1f,
-	0xa0, 0x0a, 0xe4, 0x5e, 0xe2, 0x23, 0xb1, 0x28, 0xfa, 0x73, 0xf0, 0x12, 0x70, 0xff, 0xc3, 0x07,
-	0xf7, 0xb2, 0x1f, 0x64, 0x14, 0x17, 0xd0, 0x60, 0x96, 0xe7, 0xd8, 0xd3, 0x0d, 0xdd, 0xd3, 0x7d,
-	0x5f, 0x90, 0x19, 0xf8, 0x02, 0xca, 0xb5, 0x2f, 0x5e, 0xde, 0xa2, 0x4a, 0x7f, 0xa2, 0xb7, 0xa0,
-	0xe8, 0x1b, 0xba, 0x38, 0x5f, 0x06, 0x00, 0xea, 0xe7, 0x75, 0xcf, 0xc3, 0x3d, 0xc7, 0x63, 0x26,
-	0x56, 0x56, 0xe5, 0x50, 0xf9, 0xaf, 0x3c, 0x54, 0x62, 0x7b, 0xf2, 0x11, 0x14, 0x7a, 0x62, 0x7a,
-	0xf1, 0xa2, 0x5d, 0x4a, 0x70, 0xf6, 0x31, 0x51, 0x55, 0x9f, 0x8a, 0xfa, 0x52, 0xea, 0x57, 0x03,
-	0x67, 0xa2, 0x3f, 0xa6, 0x3b, 0xde, 0xb5, 0x3b, 0x9a, 0x61, 0xba, 0xb8, 0xed, 0xd9, 0xee, 0x91,
-	0x10, 0x77, 0xae, 0x6b, 0x77, 0x36, 0x25, 0x0c, 0xdd, 0x03, 0x30, 0x2c, 0x42, 0x37, 0x7b, 0xdf,
-	0xec, 0x30, 0xa1, 0x4b, 0x6b, 0xa7, 0xe3, 0x42, 0xf8, 0x07, 0xa0, 0x5a, 0x34, 0x2c, 0x22, 0xc4,
-	0x7f, 0x04, 0x65, 0x7a, 0x8e, 0x68, 0x3d, 0x7e, 0x76, 0xf1, 0x37, 0xa5, 0xb4, 0x76, 0x26, 0x69,
-	0x0d, 0xfe, 0x09, 0xa7, 0xce, 0x39, 0x83, 0x01, 0x41, 0x8f, 0x61, 0x86, 0x39, 0x74, 0x52, 0x9d,
-	0x61, 0xc4, 0xab, 0xc3, 0x14, 0x20, 0x2c, 0xe2, 0x19, 0x23, 0xe0, 0x06, 0x21, 0xa8, 0xd1, 0x2e,
-	0x94, 0x74, 0xcb, 0xb2, 0x3d, 0x9d, 0x3b, 0x9a, 0x59, 0xc6, 0xec, 0xbd, 0x31, 0x98, 0xad, 0x0f,
-	0xa8, 0x38, 0xc7, 0x20, 0x1f, 0xf4, 0x13, 0x98, 0x66, 0x9e, 0x48, 0xbc, 0x88, 0x57, 0xc7, 0x34,
-	0x5a, 0x95, 0x53, 0xd5, 0xee, 0x42, 0x29, 0x20, 0xec, 0x24, 0x46, 0x5a, 0x7b, 0x08, 0x95, 0xa8,
-	0x68, 0x13, 0x19, 0xf9, 0x1f, 0xc2, 0xb2, 0xda, 0xb7, 0x06, 0x82, 0xc9, 0x88, 0xec, 0x1e, 0xcc,
-	0x88, 0xcd, 0xe6, 0x16, 0xa7, 0x8c, 0xd6, 0x91, 0x2a, 0x28, 0x82, 0x21, 0xd6, 0x81, 0x6e, 0x19,
-	0x5d, 0xec, 0x8a, 0x79, 0x65, 0x88, 0xf5, 0x94, 0x43, 0x95, 0x9f, 0xc0, 0x89, 0xc8, 0xe4, 0x22,
-	0xc2, 0xbb, 0x04, 0xf3, 0x8e, 0x6d, 0x68, 0x84, 0x83, 0x35, 0xd3, 0x90, 0x6e, 0xc8, 0xf1, 0x71,
-	0xeb, 0x06, 0x25, 0x6f, 0x79, 0xb6, 0x13, 0x17, 0x7e, 0x3c, 0xf2, 0x2a, 0x9c, 0x8c, 0x92, 0xf3,
-	0xe9, 0x95, 0x0f, 0x61, 0x45, 0xc5, 0x3d, 0xfb, 0x15, 0x3e, 0x2e, 0xeb, 0x1a, 0x54, 0xe3, 0x0c,
-	0x04, 0xf3, 0x4f, 0x61, 0x65, 0x00, 0x6d, 0x79, 0xba, 0xd7, 0x27, 0x13, 0x31, 0x17, 0xe1, 0xef,
-	0x9e, 0x4d, 0xf8, 0x76, 0x16, 0x54, 0x39, 0x54, 0xae, 0x07, 0x59, 0x37, 0x78, 0x64, 0xc1, 0x67,
-	0x40, 0xf3, 0x90, 0x35, 0x1d, 0xc1, 0x2e, 0x6b, 0x3a, 0xca, 0x53, 0x28, 0xfa, 0x47, 0x33, 0xba,
-	0x3f, 0x88, 0x3b, 0xb3, 0xe3, 0x1e, 0xe4, 0x7e, 0x68, 0xba, 0x13, 0x3b, 0x4a, 0xc4, 0x94, 0xf7,
-	0x01, 0x7c, 0x97, 0x27, 0x23, 0x84, 0xd3, 0x43, 0x18, 0xab, 0x01, 0x74, 0xe5, 0x5f, 0x43, 0x8e,
-	0x30, 0xb0, 0x08, 0xc3, 0x5f, 0x84, 0x11, 0x72, 0x8c, 0xd9, 0x63, 0x39, 0xc6, 0xf7, 0x61, 0x9a,
-	0x78, 0xba, 0x87, 0x45, 0x14, 0x75, 0x61, 0x18, 0x39, 0x15, 0x02, 0xab, 0x1c, 0x1f, 0x9d, 0x01,
-	0x68, 0xbb, 0x58, 0xf7, 0xb0, 0xa1, 0xe9, 0xdc, 0x8b, 0xe7, 0xd4, 0xa2, 0x80, 0xac, 0x7b, 0x68,
-	0x63, 0x10, 0x09, 0x4e, 0x33, 0xc1, 0xae, 0x0f, 0xe3, 0x1c, 0xda, 0xaa, 0x41, 0x4c, 0xe8, 0x7b,
-	0x95, 0x99, 0x31, 0xbd, 0x8a, 0x60, 0xc0, 0xa9, 0x02, 0x3e, 0x73, 0x76, 0xb4, 0xcf, 0xe4, 0xa4,
-	0xe3, 0xf8, 0xcc, 0xc2, 0x68, 0x9f, 0x29, 0x98, 0x0d, 0xf5, 0x99, 0xdf, 0xa7, 0xd3, 0xfb, 0x97,
-	0x0c, 0x54, 0xe3, 0xef, 0xa0, 0xf0, 0x3d, 0xf7, 0x60, 0x86, 0x30, 0xc8, 0x38, 0x9e, 0x4f, 0xd0,
-	0x0a, 0x0a, 0xf4, 0x14, 0xf2, 0xa6, 0xb5, 0x6f, 0xb3, 0xc4, 0x2e, 0x31, 0x76, 0x49, 0x9b, 0x75,
-	0xb5, 0x6e, 0xed, 0xdb, 0x5c, 0x49, 0x8c, 0x43, 0xed, 0x7d, 0x28, 0xfa, 0xa0, 0x89, 0xd6, 0xb6,
-	0x0d, 0xcb, 0x11, 0x93, 0xe5, 0xc1, 0xbe, 0x6f, 0xe9, 0x99, 0xc9, 0x2c, 0x5d, 0xf9, 0x2a, 0x1b,
-	0x7c, 0x13, 0x1f, 0x9b, 0x5d, 0x0f, 0xbb, 0xb1, 0x37, 0xf1, 0x81, 0xe4, 0xce, 0x5f, 0xc3, 0x2b,
-	0x23, 0xb9, 0xf3, 0x98, 0x54, 0xbc, 0x4c, 0x9f, 0xc3, 0x3c, 0xb3, 0x35, 0x8d, 0xe0, 0x2e, 0x0b,
-	0x38, 0x44, 0xf0, 0xf7, 0xe3, 0x61, 0x6c, 0xb8, 0x24, 0xdc, 0x62, 0x5b, 0x82, 0x8e, 0x6b, 0xb0,
-	0xdc, 0x0d, 0xc2, 0x6a, 0x1f, 0x01, 0x8a, 0x23, 0x4d, 0xa4, 0xd3, 0x16, 0x75, 0x71, 0x34, 0xfb,
-	0x4d, 0x38, 0x25, 0xf7, 0x99, 0x18, 0xe3, 0xd8, 0x0a, 0x17, 0x58, 0x15, 0x14, 0xca, 0xaf, 0x73,
-	0x00, 0x83, 0x87, 0xff, 0x87, 0x7c, 0xdb, 0x47, 0xbe, 0x5f, 0xe1, 0x81, 0xdc, 0xb5, 0x61, 0x8c,
-	0x13, 0x3d, 0xca, 0x76, 0xd8, 0xa3, 0xf0, 0x90, 0xee, 0xe6, 0x50, 0x36, 0x3f, 0x58, 0x5f, 0xf2,
-	0x0c, 0x4e, 0x46, 0x6d, 0x43, 0x38, 0x92, 0x35, 0x98, 0x36, 0x3d, 0xdc, 0xe3, 0x15, 0xa0, 0xc4,
-	0xec, 0x2c, 0x40, 0xc4, 0x51, 0x95, 0x0b, 0x50, 0xac, 0xf7, 0xf4, 0x0e, 0x6e, 0x39, 0xb8, 0x4d,
-	0x27, 0x35, 0xe9, 0x40, 0x08, 0xc2, 0x07, 0xca, 0x1a, 0x14, 0x7e, 0x8a, 0x8f, 0xf8, 0x4b, 0x3d,
-	0xa6, 0xa0, 0xca, 0x3f, 0x14, 0x60, 0x85, 0x9d, 0x15, 0x1b, 0xb2, 0xfe, 0xa2, 0x62, 0x62, 0xf7,
-	0xdd, 0x36, 0x26, 0x6c, 0xb7, 0x9d, 0xbe, 0xe6, 0x60, 0xd7, 0xb4, 0x0d, 0x51, 0x0a, 0x28, 0xb6,
-	0x9d, 0x7e, 0x93, 0x01, 0xd0, 0x69, 0xa0, 0x03, 0xed, 0xcb, 0xbe, 0x2d, 0x0c, 0x31, 0xa7, 0x16,
-	0xda, 0x4e, 0xff, 0xff, 0xd3, 0xb1, 0xa4, 0x25, 0x07, 0xba, 0x8b, 0x09, 0xb3, 0x33, 0x4e, 0xdb,
-	0x62, 0x00, 0x74, 0x1b, 0x4e, 0xf4, 0x70, 0xcf, 0x76, 0x8f, 0xb4, 0xae, 0xd9, 0x33, 0x3d, 0xcd,
-	0xb4, 0xb4, 0xbd, 0x23, 0x0f, 0x13, 0x61, 0x53, 0x88, 0x3f, 0x7c, 0x46, 0x9f, 0xd5, 0xad, 0x47,
-	0xf4, 0x09, 0x52, 0xa0, 0x6c, 0xdb, 0x3d, 0x8d, 0xb4, 0x6d, 0x17, 0x6b, 0xba, 0xf1, 0x05, 0x3b,
-	0x3e, 0x73, 0x6a, 0xc9, 0xb6, 0x7b, 0x2d, 0x0a, 0x5b, 0x37, 0xbe, 0x40, 0xe7, 0xa0, 0xd4, 0x76,
-	0xfa, 0x04, 0x7b, 0x1a, 0xfd, 0xc3, 0x4e, 0xc7, 0xa2, 0x0a, 0x1c, 0xb4, 0xe1, 0xf4, 0x49, 0x00,
-	0xa1, 0x47, 0xf5, 0x3f, 0x1b, 0x44, 0x78, 0x8e, 0x7b, 0x04, 0xbd, 0x00, 0x30, 0x4c, 0xf2, 0x52,
-	0xac, 0xca, 0x60, 0xfb, 0xf3, 0x41, 0xca, 0xf1, 0x1a, 0x57, 0xd9, 0xea, 0xa6, 0x49, 0x5e, 0x32,
-	0x05, 0x70, 0x53, 0x2c, 0x1a, 0x72, 0x8c, 0x2e, 0xc0, 0xdc, 0x5e, 0xf7, 0xa5, 0x69, 0x6b, 0x87,
-	0xd8, 0xec, 0x1c, 0x78, 0x55, 0xcc, 0xd2, 0xbb, 0x12, 0x83, 0xbd, 0x60, 0x20, 0xd4, 0x80, 0xa5,
-	0x20, 0x8a, 0x66, 0xe0, 0x57, 0x66, 0x1b, 0x57, 0xf7, 0x99, 0x10, 0x67, 0xe3, 0x42, 0x70, 0xb2,
-	0x4d, 0x86, 0xa5, 0x2e, 0x06, 0x38, 0x71, 0x10, 0x6a, 0xc1, 0x09, 0xce, 0x8f, 0x33, 0xd2, 0x5c,
-	0xac, 0x1b, 0xda, 0x9e, 0x43, 0xaa, 0x1d, 0xc6, 0xf1, 0x7c, 0x9c, 0xe3, 0xce, 0x81, 0x6b, 0x7b,
-	0x5e, 0x17, 0x0b, 0x9e, 0x88, 0x91, 0x8b, 0x01, 0xd6, 0x8d, 0x47, 0x0e, 0x3d, 0xf3, 0x4f, 0x86,
-	0x98, 0x1e, 0xba, 0xa6, 0x87, 0x19, 0xd7, 0x83, 0x31, 0xb9, 0x2e, 0x05, 0xb8, 0xbe, 0xa0, 0xd4,
-	0x49, 0x6c, 0x99, 0xac, 0xf5, 0x6d, 0x87, 0x54, 0xcd, 0x63, 0xb0, 0xa5, 0xc2, 0x52, 0x62, 0xf4,
-	0x02, 0x56, 0x12, 0xa4, 0x65, 0x7c, 0xbf, 0x18, 0x93, 0xef, 0x72, 0x54, 0x5c, 0xc6, 0xf8, 0x22,
-	0x94, 0x5f, 0x62, 0xd7, 0xc2, 0x5d, 0x8d, 0x9b, 0x6a, 0xf5, 0x25, 0xb3, 0xc6, 0x39, 0x0e, 0x7c,
-	0xce, 0x60, 0xe8, 0x26, 0x08, 0x43, 0xd6, 0x5c, 0x4c, 0xb0, 0xfb, 0x8a, 0x97, 0x1a, 0xbb, 0x0c,
-	0x73, 0x91, 0x3f, 0x51, 0x07, 0x0f, 0x50, 0x1d, 0x04, 0x50, 0x23, 0x87, 0x2c, 0xbd, 0xc5, 0x84,
-	0x54, 0x7b, 0x63, 0x14, 0x70, 0x2a, 0x9c, 0xac, 0xe5, 0x53, 0xa1, 0x35, 0x98, 0xed, 0xb3, 0x37,
-	0x8b, 0x54, 0x2d, 0xb6, 0xce, 0x6a, 0x9c, 0xc1, 0x2e, 0x43, 0x50, 0x25, 0x62, 0xed, 0x01, 0xcc,
-	0x87, 0xcd, 0x77, 0x22, 0x6f, 0x77, 0x0f, 0xe6, 0x42, 0xc6, 0x87, 0x20, 0x1f, 0x28, 0xef, 0xb2,
-	0xdf, 0xe8, 0x24, 0xcc, 0x70, 0x1c, 0x46, 0x5e, 0x56, 0xc5, 0x48, 0xf9, 0x00, 0xe6, 0xc3, 0x4a,
-	0x4f, 0xa4, 0x46, 0x90, 0x77, 0x65, 0x20, 0x91, 0x57, 0xd9, 0x6f, 0x65, 0x13, 0x66, 0xf8, 0x32,
-	0x12, 0xab, 0x2f, 0x08, 0xf2, 0x07, 0xba, 0x6b, 0x08, 0xe7, 0xc4, 0x7e, 0x53, 0x18, 0xb1, 0xf7,
-	0x3d, 0xe1, 0x92, 0xd8, 0x6f, 0x45, 0x87, 0x72, 0xa8, 0x7e, 0x48, 0x91, 0x58, 0xa1, 0x50, 0x30,
-	0xa3, 0xbf, 0xd9, 0xf4, 0x76, 0x57, 0xae, 0x9c, 0xfd, 0xa6, 0x30, 0xef, 0xc8, 0x91, 0x75, 0x1c,
-	0xf6, 0x9b, 0xaa, 0xa8, 0x8b, 0x5f, 0x89, 0xba, 0x73, 0x51, 0xe5, 0x03, 0xc5, 0x00, 0xd8, 0xd0,
-	0x1d, 0x7d, 0xcf, 0xec, 0x9a, 0xde, 0x11, 0xba, 0x0e, 0x15, 0xdd, 0x30, 0xb4, 0xb6, 0x84, 0x98,
-	0x58, 0x76, 0x03, 0x16, 0x74, 0xc3, 0xd8, 0x08, 0x80, 0xd1, 0xdb, 0xb0, 0x68, 0xb8, 0xb6, 0x13,
-	0xc6, 0xe5, 0xed, 0x81, 0x0a, 0x7d, 0x10, 0x44, 0x56, 0xfe, 0x73, 0x1a, 0xce, 0x84, 0x5d, 0x53,
-	0xb4, 0x46, 0xfb, 0x11, 0xcc, 0x45, 0x66, 0x4d, 0x31, 0xaf, 0x81, 0xb4, 0x6a, 0x88, 0x22, 0x52,
-	0xb3, 0xcc, 0xc6, 0x6a, 0x96, 0x89, 0x55, 0xe0, 0xdc, 0x1b, 0xad, 0x02, 0xe7, 0xdf, 0x48, 0x15,
-	0x78, 0x7a, 0xb2, 0x2a, 0xf0, 0x15, 0x56, 0xbb, 0x90, 0xd4, 0xcc, 0xd6, 0xf8, 0xf9, 0x52, 0xf6,
-	0x71, 0x2c, 0xd9, 0x46, 0x8a, 0x54, 0x8b, 0x67, 0x27, 0xa9, 0x16, 0x17, 0x52, 0xab, 0xc5, 0xd4,
-	0x6a, 0x1c, 0x47, 0x77, 0x7b, 0xb6, 0x2b, 0xcb, 0xc1, 0xd5, 0x22, 0x13, 0x61, 0x41, 0xc2, 0x45,
-	0x29, 0x38, 0xb5, 0x70, 0x0c, 0xa9, 0x85, 0xe3, 0xf3, 0x30, 0x67, 0xd9, 0x9a, 0x85, 0x0f, 0x35,
-	0xba, 0x97, 0xa4, 0x5a, 0xe2, 0x1b, 0x6b, 0xd9, 0x0d, 0x7c, 0xd8, 0xa4, 0x90, 0x58, 0x69, 0x79,
-	0x6e, 0xb2, 0xd2, 0x32, 0x3d, 0x01, 0x7b, 0x3a, 0x79, 0x89, 0x0d, 0x26, 0x0a, 0xa9, 0x96, 0x99,
-	0x11, 0x97, 0x38, 0x8c, 0xca, 0x40, 0xd0, 0x65, 0xf0, 0x95, 0x24, 0x90, 0xe6, 0x19, 0x52, 0x59,
-	0x42, 0x19, 0x9a, 0xf2, 0x37, 0x19, 0x58, 0x0e, 0x9b, 0xb9, 0x28, 0x28, 0x3e, 0x81, 0xa2, 0x2b,
-	0xcf, 0x62, 0x61, 0xda, 0xd7, 0xc7, 0x3e, 0xbc, 0xd5, 0x01, 0x2d, 0xfa, 0x59, 0x6a, 0x1d, 0xfb,
-	0xd6, 0x28, 0x7e, 0xa3, 0x2a, 0xd9, 0x4a, 0x1d, 0xce, 0xbd, 0x30, 0x2d, 0xc3, 0x3e, 0x24, 0xa9,
-	0x6f, 0x69, 0x82, 0xad, 0x65, 0x12, 0x6c, 0x4d, 0xf9, 0xbb, 0x0c, 0x9c, 0x8c, 0xf2, 0x12, 0xaa,
-	0xa8, 0xc7, 0x55, 0xf1, 0x76, 0x42, 0x08, 0x11, 0x21, 0x4e, 0x54, 0xc6, 0xe7, 0xa9, 0xca, 0xb8,
-	0x3d, 0x9a, 0xe3, 0x48, 0x75, 0xfc, 0x45, 0x06, 0x4e, 0xa5, 0x8a, 0x11, 0x89, 0x23, 0x33, 0xd1,
-	0x38, 0x52, 0xc4, 0xa0, 0x6d, 0xbb, 0x6f, 0x79, 0x81, 0x18, 0x74, 0x83, 0xf5, 0x1a, 0x79, 0xb0,
-	0xa7, 0xf5, 0xf4, 0xd7, 0x66, 0xaf, 0xdf, 0x13, 0x1e, 0x9f, 0xb2, 0x7b, 0xce, 0x21, 0xc7, 0x88,
-	0x42, 0x95, 0x75, 0x58, 0xf4, 0xa5, 0x1c, 0x5a, 0xf9, 0x0f, 0x54, 0xf2, 0xb3, 0xe1, 0x4a, 0xbe,
-	0x05, 0x33, 0xe2, 0x94, 0x7b, 0x13, 0xcd, 0xd0, 0xf3, 0x50, 0x72, 0xb0, 0xdb, 0x33, 0x09, 0xf1,
-	0x1d, 0x6d, 0x51, 0x0d, 0x82, 0x94, 0x5f, 0xcd, 0xc2, 0x42, 0xd4, 0x3a, 0x3e, 0x8c, 0x35, 0x0e,
-	0x2e, 0x26, 0x1c, 0x01, 0xd1, 0x85, 0x06, 0x52, 0xc8, 0xdb, 0x32, 0x03, 0xc9, 0xa6, 0x55, 0xef,
-	0xfc, 0x6c, 0x45, 0xa4, 0x27, 0x54, 0x23, 0x6d, 0xbb, 0xd7, 0xd3, 0x2d, 0x43, 0xf6, 0xb0, 0xc5,
-	0x90, 0xea, 0x4f, 0x77, 0x3b, 0x54, 0xed, 0x14, 0xcc, 0x7e, 0xd3, 0xcd, 0x3b, 0xb4, 0xdd, 0x97,
-	0xa6, 0xc5, 0x1a, 0x10, 0xcc, 0x59, 0x17, 0x55, 0x10, 0xa0, 0x4d, 0xd3, 0x45, 0xab, 0x90, 0xc7,
-	0xd6, 0x2b, 0x99, 0x23, 0x26, 0x34, 0xb9, 0x65, 0x2e, 0xa4, 0x32, 0x3c, 0x74, 0x0b, 0x66, 0x7a,
-	0xd4, 0x2c, 0x64, 0xd1, 0x6b, 0x25, 0xa5, 0xd7, 0xab, 0x0a, 0x34, 0x1a, 0x43, 0xf1, 0xa8, 0x51,
-	0x56, 0xb6, 0x12, 0x62, 0x28, 0x11, 0x23, 0x4a, 0x44, 0xb4, 0xe5, 0x67, 0xc0, 0xc5, 0xb4, 0xd4,
-	0x35, 0xb2, 0x15, 0x89, 0x69, 0xf0, 0x4e, 0x38, 0x0d, 0x06, 0xc6, 0x6b, 0x6d, 0x34, 0xaf, 0xe1,
-	0xbd, 0x88, 0x53, 0x50, 0xe8, 0xda, 0x1d, 0x6e, 0x46, 0x25, 0x7e, 0x3d, 0xa2, 0x6b, 0x77, 0x98,
-	0x15, 0x2d, 0xc3, 0x34, 0xf1, 0x0c, 0xd3, 0x62, 0x4e, 0xbd, 0xa0, 0xf2, 0x01, 0x7d, 0xf9, 0xd8,
-	0x0f, 0xcd, 0xb6, 0xda, 0xb8, 0x5a, 0x66, 0x8f, 0x8a, 0x0c, 0xb2, 0x6d, 0xb5, 0x59, 0x8e, 0xe9,
-	0x79, 0x47, 0xd5, 0x79, 0x06, 0xa7, 0x3f, 0xd1, 0x03, 0x59, 0x97, 0x5c, 0x48, 0x2b, 0xf6, 0x24,
-	0xb9, 0x6d, 0x59, 0x96, 0x7c, 0x04, 0xb3, 0x87, 0xdc, 0x11, 0x54, 0x2b, 0x8c, 0xfe, 0xda, 0x68,
-	0xf7, 0x22, 0x38, 0x48, 0x42, 0x7a, 0xc8, 0x58, 0xd8, 0xa3, 0x67, 0x98, 0x4d, 0x9d, 0x0c, 0x6b,
-	0xcc, 0xe7, 0xd4, 0x92, 0x85, 0xbd, 0xa6, 0x00, 0x51, 0x35, 0xb0, 0xec, 0x4e, 0x33, 0x0d, 0x96,
-	0x85, 0x15, 0xd5, 0x59, 0x36, 0xae, 0x1b, 0xdf, 0x67, 0xb5, 0xe0, 0xd7, 0x19, 0x38, 0xb9, 0xc1,
-	0x2a, 0x29, 0x01, 0x2f, 0x38, 0x49, 0xf1, 0xff, 0xae, 0xdf, 0x97, 0x49, 0xad, 0xd4, 0x47, 0xb5,
-	0x26, 0xdb, 0x32, 0x75, 0x98, 0x97, 0xcc, 0x05, 0x8b, 0xdc, 0xd8, 0xad, 0x9d, 0x32, 0x09, 0x0e,
-	0x95, 0x07, 0xb0, 0x12, 0x5b, 0x85, 0xa8, 0x7a, 0x5c, 0x80, 0xb9, 0x81, 0xb7, 0xf3, 0x17, 0x51,
-	0xf2, 0x61, 0x75, 0x43, 0xb9, 0x07, 0x27, 0x5a, 0x9e, 0xee, 0x7a, 0x31, 0x15, 0x8c, 0x41, 0xcb,
-	0x9a, 0x36, 0x61, 0x5a, 0xd1, 0x57, 0x69, 0xc1, 0x72, 0xcb, 0xb3, 0x9d, 0x63, 0x30, 0xa5, 0x3e,
-	0x8b, 0xae, 0xdf, 0xee, 0xcb, 0xd3, 0x45, 0x0e, 0x95, 0x15, 0xde, 0x62, 0x8a, 0xcf, 0x76, 0x1f,
-	0x4e, 0xf2, 0x0e, 0xcf, 0x71, 0x16, 0x71, 0x4a, 0xf6, 0x97, 0xe2, 0x7c, 0x9f, 0xc3, 0xd2, 0xe0,
-	0x50, 0x1d, 0x54, 0x6f, 0xef, 0x84, 0xab, 0xb7, 0xe7, 0x87, 0xec, 0x7a, 0xa8, 0x78, 0xfb, 0xab,
-	0x6c, 0xe0, 0x54, 0x48, 0xa9, 0xdd, 0xde, 0x0f, 0xd7, 0x6e, 0x2f, 0x8f, 0xe2, 0x1d, 0x2a, 0xdd,
-	0xc6, 0xad, 0x36, 0x97, 0x60, 0xb5, 0x9f, 0xc5, 0x0a, 0xbc, 0xf9, 0xb4, 0x0a, 0x79, 0x44, 0xda,
-	0xdf, 0x4b, 0x7d, 0x57, 0xe5, 0xf5, 0x5d, 0x7f, 0x6a, 0xbf, 0x21, 0x77, 0x37, 0x52, 0xdf, 0xbd,
-	0x30, 0x52, 0x5e, 0xbf, 0xbc, 0xfb, 0xd7, 0x79, 0x28, 0xfa, 0xcf, 0x62, 0x3a, 0x8f, 0xab, 0x2d,
-	0x9b, 0xa0, 0xb6, 0xe0, 0xf9, 0x9d, 0xfb, 0x56, 0xe7, 0x77, 0x7e, 0xec, 0xf3, 0xfb, 0x34, 0x14,
-	0xd9, 0x0f, 0xcd, 0xc5, 0xfb, 0xe2, 0x3c, 0x2e, 0x30, 0x80, 0x8a, 0xf7, 0x07, 0x66, 0x38, 0x33,
-	0x91, 0x19, 0x46, 0x2a, 0xca, 0xb3, 0xd1, 0x8a, 0xf2, 0x87, 0xfe, 0x79, 0xca, 0x8f, 0xe0, 0xab,
-	0x43, 0xf8, 0x26, 0x9e, 0xa4, 0x8d, 0xf0, 0x49, 0xca, 0x4f, 0xe5, 0x77, 0x86, 0x71, 0xf9, 0xc1,
-	0xd6, 0x93, 0x77, 0x79, 0x3d, 0x39, 0x68, 0x8b, 0xc2, 0xb3, 0xde, 0x07, 0xf0, 0x9d, 0x88, 0x2c,
-	0x2a, 0x9f, 0x1e, 0xb2, 0x46, 0x35, 0x80, 0x4e, 0xd9, 0x86, 0xb6, 0x66, 0xd0, 0x74, 0x1e, 0xcf,
-	0x3f, 0xa6, 0x74, 0x9c, 0xff, 0xb2, 0x10, 0xf0, 0x2f, 0x29, 0x5d, 0xda, 0x0f, 0x63, 0x9d, 0x8c,
-	0x09, 0xad, 0xf8, 0x4e, 0xb8, 0x91, 0x71, 0x4c, 0xab, 0x8b, 0xf5, 0x31, 0x58, 0xdc, 0xa3, 0xbb,
-	0xe2, 0x31, 0xaf, 0x33, 0x17, 0x05, 0x64, 0x9d, 0xe5, 0x15, 0xfb, 0xa6, 0x65, 0x92, 0x03, 0xfe,
-	0x7c, 0x86, 0xe7, 0x15, 0x12, 0xb4, 0xce, 0x2e, 0x49, 0xe2, 0xd7, 0xa6, 0xa7, 0xb5, 0x6d, 0x03,
-	0x33, 0x9b, 0x9e, 0x56, 0x0b, 0x14, 0xb0, 0x61, 0x1b, 0x78, 0xf0, 0xe6, 0x15, 0x8e, 0xf7, 0xe6,
-	0x15, 0x23, 0x6f, 0xde, 0x49, 0x98, 0x71, 0xb1, 0x4e, 0x6c, 0x4b, 0x24, 0xf7, 0x62, 0x44, 0xb7,
-	0xa6, 0x87, 0x09, 0xa1, 0x33, 0x89, 0x60, 0x4f, 0x0c, 0x03, 0x41, 0xea, 0xdc, 0xc8, 0x20, 0x75,
-	0x48, 0xf7, 0x37, 0x12, 0xa4, 0x96, 0x47, 0x06, 0xa9, 0xe3, 0x34, 0x7f, 0x03, 0x61, 0xfa, 0xfc,
-	0x78, 0x61, 0x7a, 0x30, 0xaa, 0x5d, 0x08, 0x47, 0xb5, 0x4f, 0x61, 0xf6, 0x95, 0xdd, 0xed, 0xf7,
-	0x30, 0x11, 0x95, 0xfc, 0xd5, 0xd1, 0xd2, 0x7d, 0xcc, 0x09, 0xc4, 0x6d, 0x31, 0x41, 0x1e, 0x2e,
-	0x2c, 0xe0, 0x6f, 0x51, 0x58, 0x08, 0x06, 0x9f, 0xfb, 0xa1, 0xe0, 0xd3, 0x4f, 0x68, 0x3a, 0xe3,
-	0x25, 0x34, 0xdf, 0xa3, 0x2b, 0xaa, 0xed, 0xc0, 0x5c, 0x50, 0x4f, 0x09, 0xb4, 0xab, 0x41, 0xda,
-	0xc4, 0xd4, 0x89, 0x33, 0x08, 0x3a, 0xb8, 0x02, 0xcc, 0x70, 0xa0, 0xf2, 0x4f, 0x19, 0x58, 0x89,
-	0x39, 0x25, 0xe1, 0xec, 0xee, 0x46, 0xba, 0xf0, 0x17, 0x46, 0xee, 0xa9, 0xdf, 0x84, 0x7f, 0x12,
-	0x6a, 0xc2, 0xbf, 0x37, 0x9a, 0xf0, 0x8d, 0xf7, 0xe0, 0xff, 0x36, 0x0b, 0xe7, 0x76, 0x1d, 0x23,
-	0x12, 0x1f, 0x0b, 0x33, 0x19, 0xdf, 0xed, 0x7e, 0x28, 0xf3, 0xac, 0xec, 0xa4, 0xa6, 0x28, 0x52,
-	0xad, 0x2f, 0xa1, 0x42, 0x1c, 0xdc, 0xd6, 0x82, 0x2f, 0x30, 0x7f, 0x45, 0x1e, 0x27, 0x34, 0x0a,
-	0x86, 0x0b, 0xbc, 0x4a, 0x5d, 0x55, 0xec, 0xa5, 0x5e, 0x20, 0x61, 0x68, 0xed, 0x11, 0x2c, 0x27,
-	0x21, 0x4e, 0xa4, 0x3e, 0x05, 0xce, 0xa7, 0x0b, 0x23, 0xe2, 0xe4, 0x9f, 0xc3, 0xc2, 0xd6, 0x6b,
-	0xdc, 0x6e, 0x1d, 0x59, 0xed, 0x09, 0x34, 0x5a, 0x81, 0x5c, 0xbb, 0x67, 0x88, 0xc2, 0x3a, 0xfd,
-	0x19, 0x0c, 0xfd, 0x73, 0xe1, 0xd0, 0x5f, 0x83, 0xca, 0x60, 0x06, 0x61, 0x95, 0x27, 0xa9, 0x55,
-	0x1a, 0x14, 0x99, 0x32, 0x9f, 0x53, 0xc5, 0x48, 0xc0, 0xb1, 0xcb, 0x2f, 0xba, 0x71, 0x38, 0x76,
-	0xdd, 0xf0, 0x11, 0x91, 0x0b, 0x1f, 0x11, 0xca, 0x9f, 0x65, 0xa0, 0x44, 0x67, 0xf8, 0x56, 0xf2,
-	0x8b, 0xec, 0x3c, 0x37, 0xc8, 0xce, 0xfd, 0x24, 0x3f, 0x1f, 0x4c, 0xf2, 0x07, 0x92, 0x4f, 0x33,
-	0x70, 0x5c, 0xf2, 0x19, 0x1f, 0x8e, 0x5d, 0x57, 0x39, 0x0f, 0x73, 0x5c, 0x36, 0xb1, 0xf2, 0x0a,
-	0xe4, 0xfa, 0x6e, 0x57, 0xee, 0x5f, 0xdf, 0xed, 0x2a, 0x7f, 0x92, 0x81, 0xf2, 0xba, 0xe7, 0xe9,
-	0xed, 0x83, 0x09, 0x16, 0xe0, 0x0b, 0x97, 0x0d, 0x0a, 0x17, 0x5f, 0xc4, 0x40, 0xdc, 0x7c, 0x8a,
-	0xb8, 0xd3, 0x21, 0x71, 0x15, 0x98, 0x97, 0xb2, 0xa4, 0x0a, 0xdc, 0x00, 0xd4, 0xb4, 0x5d, 0xef,
-	0xb1, 0xed, 0x1e, 0xea, 0xae, 0x31, 0x59, 0xda, 0x8d, 0x20, 0x2f, 0xbe, 0x96, 0xc8, 0x5d, 0x9b,
-	0x56, 0xd9, 0x6f, 0xe5, 0x2a, 0x2c, 0x85, 0xf8, 0xa5, 0x4e, 0xfc, 0x11, 0x94, 0xd8, 0x61, 0x2f,
-	0xf2, 0xaf, 0xdb, 0xc1, 0xb6, 0xfe, 0x58, 0xa1, 0x81, 0xf2, 0xff, 0x60, 0x91, 0x06, 0x85, 0x0c,
-	0xee, 0x7b, 0x90, 0x1f, 0x47, 0x92, 0x93, 0x33, 0x29, 0x8c, 0x22, 0x89, 0xc9, 0x6f, 0xb3, 0x30,
-	0xcd, 0xe0, 0xb1, 0x40, 0xed, 0x34, 0x3d, 0xfe, 0x1c, 0x5b, 0xf3, 0xf4, 0x8e, 0xff, 0x6d, 0x0a,
-	0x05, 0xec, 0xe8, 0x1d, 0x56, 0x72, 0x61, 0x0f, 0x0d, 0xb3, 0x83, 0x89, 0x27, 0x3f, 0x50, 0x29,
-	0x51, 0xd8, 0x26, 0x07, 0xb1, 0xa6, 0x9b, 0xf9, 0x07, 0x3c, 0xd9, 0xc8, 0xab, 0xec, 0x37, 0x5a,
-	0xe5, 0x57, 0xa3, 0xc7, 0xe9, 0xc2, 0xb0, 0x8b, 0xd3, 0x35, 0x28, 0x44, 0x1a, 0x2f, 0xfe, 0x18,
-	0x3d, 0x8c, 0x1e, 0xf4, 0x97, 0x52, 0x56, 0x9c, 0x7c, 0xbc, 0x7f, 0x47, 0xe7, 0xd9, 0x16, 0xa0,
-	0xe0, 0xde, 0x08, 0x2b, 0xb8, 0x05, 0x33, 0x6c, 0xeb, 0x64, 0xa0, 0xbe, 0x92, 0x22, 0xaa, 0x2a,
-	0xd0, 0x14, 0x1d, 0x10, 0xdf, 0xf6, 0x50, 0x70, 0x3e, 0xb9, 0xad, 0x0c, 0x09, 0xd6, 0xff, 0x3e,
-	0x03, 0x4b, 0xa1, 0x39, 0x84, 0xac, 0x37, 0xc3, 0x93, 0xa4, 0x8a, 0x2a, 0x26, 0xd8, 0x08, 0x9d,
-	0xaf, 0xb7, 0xd2, 0x44, 0xfa, 0x8e, 0xce, 0xd6, 0xdf, 0x66, 0x00, 0xd6, 0xfb, 0xde, 0x81, 0x28,
-	0x71, 0x07, 0xed, 0x25, 0x13, 0xb1, 0x97, 0x1a, 0x14, 0x1c, 0x9d, 0x90, 0x43, 0xdb, 0x95, 0xe9,
-	0xb5, 0x3f, 0x66, 0xc5, 0xe8, 0xbe, 0x77, 0x20, 0x7b, 0xba, 0xf4, 0x37, 0xba, 0x0c, 0xf3, 0xfc,
-	0x2b, 0x2d, 0x4d, 0x37, 0x0c, 0x17, 0x13, 0x22, 0x9a, 0xbb, 0x65, 0x0e, 0x5d, 0xe7, 0x40, 0x8a,
-	0x66, 0x1a, 0xd8, 0xf2, 0x4c, 0xef, 0x48, 0xf3, 0xec, 0x97, 0xd8, 0x12, 0x69, 0x72, 0x59, 0x42,
-	0x77, 0x28, 0x90, 0x77, 0xb9, 0x3a, 0x26, 0xf1, 0x5c, 0x89, 0x26, 0x1b, 0x89, 0x02, 0xca, 0xd0,
-	0xe8, 0xa6, 0x54, 0x9a, 0xfd, 0x6e, 0x97, 0xab, 0xf8, 0xf8, 0xdb, 0xfe, 0xae, 0x58, 0x50, 0x36,
-	0xed, 0x4d, 0x1b, 0x28, 0x4d, 0x2c, 0xf7, 0x0d, 0xd6, 0x03, 0xdf, 0x85, 0xc5, 0xc0, 0x1a, 0x84,
-	0x59, 0x85, 0xf2, 0x99, 0x4c, 0x38, 0x9f, 0x51, 0x9e, 0x00, 0xe2, 0x25, 0xb0, 0x6f, 0xb9, 0x6e,
-	0xe5, 0x04, 0x2c, 0x85, 0x18, 0x89, 0xf8, 0xe0, 0x06, 0x94, 0xc5, 0xad, 0x5a, 0x61, 0x28, 0xa7,
-	0xa0, 0x40, 0xfd, 0x7c, 0xdb, 0x34, 0x64, 0xc3, 0x7f, 0xd6, 0xb1, 0x8d, 0x0d, 0xd3, 0x70, 0x95,
-	0x17, 0x50, 0x56, 0xf9, 0x3c, 0x02, 0xf7, 0x31, 0xcc, 0x8b, 0x3b, 0xb8, 0x5a, 0xe8, 0x12, 0x7c,
-	0xd2, 0x47, 0x56, 0xc1, 0x49, 0xd4, 0xb2, 0x15, 0x1c, 0x2a, 0x06, 0xd4, 0x78, 0x20, 0x13, 0x62,
-	0x2f, 0x17, 0xfb, 0x18, 0xe4, 0x7d, 0xf8, 0x91, 0xb3, 0x84, 0xe9, 0xcb, 0x6e, 0x70, 0xa8, 0x9c,
-	0x81, 0xd3, 0x89, 0xb3, 0x08, 0x4d, 0x38, 0x50, 0x19, 0x3c, 0x30, 0x4c, 0x79, 0xf3, 0x81, 0xdd,
-	0x68, 0xc8, 0x04, 0x6e, 0x34, 0x9c, 0xf4, 0x23, 0xee, 0xac, 0x3c, 0x5a, 0x59, 0x38, 0x3d, 0xc8,
-	0x3c, 0x73, 0x69, 0x99, 0x67, 0x3e, 0x94, 0x79, 0x2a, 0x2d, 0x5f, 0x9f, 0xa2, 0x22, 0xf0, 0x88,
-	0x55, 0x2e, 0xf8, 0xdc, 0xd2, 0x21, 0x2a, 0xc3, 0x56, 0xc9, 0x51, 0xd5, 0x00, 0x95, 0x72, 0x1d,
-	0xca, 0x61, 0xd7, 0x18, 0xf0, 0x73, 0x99, 0x98, 0x9f, 0x9b, 0x8f, 0xb8, 0xb8, 0xf7, 0x23, 0xe9,
-	0x44, 0xba, 0x8e, 0x23, 0xc9, 0xc4, 0xc3, 0x90, 0xb3, 0xbb, 0x91, 0x70, 0x19, 0xe1, 0x3b, 0xf2,
-	0x73, 0xcb, 0xe2, 0x3c, 0x78, 0x4c, 0x28, 0xbd, 0x58, 0xb4, 0x72, 0x11, 0x4a, 0xbb, 0x69, 0x5f,
-	0xf0, 0xe5, 0xe5, 0x6d, 0xbf, 0x3b, 0xb0, 0xfc, 0xd8, 0xec, 0x62, 0x72, 0x44, 0x3c, 0xdc, 0xab,
-	0x33, 0xa7, 0xb4, 0x6f, 0x62, 0x17, 0x9d, 0x05, 0x60, 0xd9, 0xb4, 0x63, 0x9b, 0xfe, 0x87, 0x5d,
-	0x01, 0x88, 0xf2, 0xbb, 0x0c, 0x2c, 0x0c, 0x08, 0x77, 0x59, 0x15, 0xe1, 0x2d, 0x28, 0xd2, 0xf5,
-	0x12, 0x4f, 0xef, 0x39, 0xb2, 0x31, 0xeb, 0x03, 0xd0, 0x7d, 0x98, 0xde, 0x27, 0xb2, 0x7a, 0x99,
-	0xd8, 0x09, 0x4a, 0x12, 0x44, 0xcd, 0xef, 0x93, 0xba, 0x81, 0x1e, 0x00, 0xf4, 0x09, 0x36, 0x44,
-	0x33, 0x36, 0x97, 0x16, 0xc3, 0xec, 0x06, 0x2f, 0x6a, 0x50, 0x02, 0x7e, 0x51, 0xf0, 0x21, 0x94,
-	0x4c, 0xcb, 0x36, 0x30, 0x6b, 0x9e, 0x1b, 0xa2, 0xc0, 0x39, 0x82, 0x1c, 0x38, 0xc5, 0x2e, 0xc1,
-	0x86, 0x82, 0xc5, 0x59, 0x28, 0xf5, 0x2b, 0x0c, 0xa5, 0x01, 0x8b, 0xdc, 0x69, 0xed, 0xfb, 0x82,
-	0x4b, 0x8b, 0xbd, 0x30, 0x6c, 0x75, 0x4c, 0x5b, 0x6a, 0xc5, 0x14, 0x01, 0x97, 0x24, 0x55, 0xee,
-	0xc1, 0x89, 0x50, 0xba, 0x39, 0x41, 0xfe, 0xa7, 0x34, 0x23, 0x35, 0xbb, 0x81, 0x39, 0x8b, 0x8a,
-	0x98, 0xb4, 0xe6, 0x51, 0x15, 0x31, 0xc2, 0x2b, 0x62, 0x44, 0xf9, 0x0c, 0x4e, 0x85, 0x8a, 0x8b,
-	0x21, 0x89, 0x1e, 0x46, 0xe2, 0xc9, 0x2b, 0xa3, 0xb8, 0x46, 0x02, 0xcb, 0xff, 0xce, 0xc0, 0x72,
-	0x12, 0xc2, 0x31, 0x8b, 0xdf, 0x3f, 0x4f, 0xb9, 0x14, 0x7e, 0x77, 0x3c, 0xb1, 0x7e, 0x2f, 0x8d,
-	0x83, 0x1d, 0xa8, 0x25, 0xe9, 0x33, 0xbe, 0x4b, 0xb9, 0x49, 0x76, 0xe9, 0x17, 0xb9, 0x40, 0x13,
-	0x68, 0xdd, 0xf3, 0x5c, 0x73, 0xaf, 0x4f, 0x4d, 0xfe, 0x8d, 0x17, 0x56, 0xeb, 0x7e, 0x89, 0x90,
-	0xab, 0xf6, 0xf6, 0x10, 0xf2, 0x81, 0x1c, 0x89, 0x65, 0xc2, 0x4f, 0xc2, 0x65, 0x42, 0xde, 0xde,
-	0xb9, 0x33, 0x1e, 0xbf, 0x1f, 0x6c, 0x2d, 0xfe, 0x17, 0x59, 0x98, 0x0f, 0x6f, 0x11, 0xda, 0x02,
-	0xd0, 0x7d, 0xc9, 0xc5, 0x8b, 0x72, 0x79, 0xac, 0x65, 0xaa, 0x01, 0x42, 0xf4, 0x0e, 0xe4, 0xda,
-	0x4e, 0x5f, 0xec, 0x5a, 0x42, 0x11, 0x70, 0xc3, 0xe9, 0x73, 0x8f, 0x42, 0xd1, 0x68, 0xa6, 0x27,
-	0xee, 0x9f, 0xa6, 0x7a, 0x49, 0x7e, 0x17, 0x95, 0xd3, 0x08, 0x64, 0xf4, 0x14, 0xe6, 0x0f, 0x5d,
-	0xd3, 0xd3, 0xf7, 0xba, 0x58, 0xeb, 0xea, 0x47, 0xd8, 0x15, 0x5e, 0x72, 0x0c, 0x47, 0x56, 0x96,
-	0x84, 0xcf, 0x28, 0x9d, 0xf2, 0x47, 0x50, 0x90, 0x12, 0x8d, 0x38, 0x11, 0x76, 0x60, 0xa5, 0x4f,
-	0xd1, 0x34, 0x76, 0x81, 0xdb, 0xd2, 0x2d, 0x5b, 0x23, 0x98, 0x1e, 0xe3, 0xf2, 0xd3, 0xb2, 0x11,
-	0x2e, 0x7a, 0x99, 0x51, 0x6f, 0xd8, 0x2e, 0x6e, 0xe8, 0x96, 0xdd, 0xe2, 0xa4, 0xca, 0x2b, 0x28,
-	0x05, 0x16, 0x38, 0x42, 0x84, 0x3a, 0x2c, 0xca, 0x3b, 0x25, 0x04, 0x7b, 0xe2, 0x78, 0x19, 0x6b,
-	0xf2, 0x05, 0x41, 0xd7, 0xc2, 0x1e, 0xbf, 0x07, 0xf4, 0x10, 0x4e, 0xa9, 0xd8, 0x76, 0xb0, 0xe5,
-	0xef, 0xe7, 0x33, 0xbb, 0x33, 0x81, 0x07, 0x7f, 0x0b, 0x6a, 0x49, 0xf4, 0x22, 0x32, 0xbb, 0x07,
-	0x27, 0x9a, 0x7a, 0x9f, 0xe0, 0x63, 0xf6, 0xc1, 0xa3, 0xb4, 0x82, 0xeb, 0x03, 0x58, 0xd9, 0xb5,
-	0x9c, 0xe3, 0xf2, 0xad, 0x41, 0x35, 0x4e, 0x2d, 0x38, 0xdf, 0x91, 0xa1, 0xb6, 0x48, 0x82, 0x05,
-	0xd7, 0x73, 0x50, 0xe2, 0x19, 0xb6, 0x16, 0xc8, 0xc2, 0x80, 0x83, 0x1a, 0x7a, 0x0f, 0x2b, 0x27,
-	0x61, 0x39, 0x4c, 0x27, 0xf8, 0x3d, 0x14, 0xbd, 0xfc, 0xe3, 0x7e, 0x65, 0x79, 0x0a, 0x56, 0x62,
-	0xf4, 0x9c, 0xf5, 0x8d, 0x2b, 0x50, 0x90, 0xff, 0xfd, 0x02, 0xcd, 0x42, 0x6e, 0x67, 0xa3, 0x59,
-	0x99, 0xa2, 0x3f, 0x76, 0x37, 0x9b, 0x95, 0x0c, 0x2a, 0x40, 0xbe, 0xb5, 0xb1, 0xd3, 0xac, 0x64,
-	0x6f, 0xf4, 0xa0, 0x12, 0xfd, 0xd7, 0x0f, 0x68, 0x05, 0x96, 0x9a, 0xea, 0x76, 0x73, 0xfd, 0xc9,
-	0xfa, 0x4e, 0x7d, 0xbb, 0xa1, 0x35, 0xd5, 0xfa, 0xc7, 0xeb, 0x3b, 0x5b, 0x95, 0x29, 0x74, 0x01,
-	0xce, 0x04, 0x1f, 0x3c, 0xdd, 0x6e, 0xed, 0x68, 0x3b, 0xdb, 0xda, 0xc6, 0x76, 0x63, 0x67, 0xbd,
-	0xde, 0xd8, 0x52, 0x2b, 0x19, 0x74, 0x06, 0x4e, 0x05, 0x51, 0x1e, 0xd5, 0x37, 0xeb, 0xea, 0xd6,
-	0x06, 0xfd, 0xbd, 0xfe, 0xac, 0x92, 0xbd, 0x71, 0x1b, 0xca, 0xa1, 0xff, 0xca, 0x40, 0x45, 0x6a,
-	0x6e, 0x6f, 0x56, 0xa6, 0x50, 0x19, 0x8a, 0x41, 0x3e, 0x05, 0xc8, 0x37, 0xb6, 0x37, 0xb7, 0x2a,
-	0xd9, 0x1b, 0x4d, 0x58, 0x88, 0x7c, 0xa6, 0x83, 0x16, 0xa1, 0xdc, 0x5a, 0x6f, 0x6c, 0x3e, 0xda,
-	0xfe, 0x44, 0x53, 0xb7, 0xd6, 0x37, 0x3f, 0xad, 0x4c, 0xa1, 0x65, 0xa8, 0x48, 0x50, 0x63, 0x7b,
-	0x87, 0x43, 0x33, 0x11, 0xe8, 0xe3, 0xed, 0xdd, 0xc6, 0x66, 0xc5, 0xb8, 0xf1, 0x55, 0x26, 0xe2,
-	0xd5, 0x30, 0x3a, 0x01, 0x8b, 0xfe, 0xec, 0xda, 0x86, 0xba, 0xb5, 0xbe, 0xb3, 0x45, 0x85, 0x0a,
-	0x81, 0xd5, 0xdd, 0x46, 0xa3, 0xde, 0x78, 0xc2, 0xd9, 0x0e, 0xc0, 0x5b, 0x9f, 0xd4, 0x29, 0x72,
-	0x36, 0x8c, 0xbc, 0xdb, 0xf8, 0x69, 0x63, 0xfb, 0x45, 0xa3, 0x92, 0x43, 0x4b, 0xb0, 0x30, 0x00,
-	0x37, 0xd7, 0x77, 0x5b, 0x5b, 0x95, 0xfc, 0xda, 0xff, 0x2c, 0xc1, 0xbc, 0x8c, 0xb7, 0xb1, 0xcb,
-	0x6e, 0xc9, 0x35, 0x61, 0x56, 0xfe, 0xe7, 0x95, 0x84, 0x83, 0x32, 0xfc, 0xff, 0x62, 0x6a, 0x17,
-	0x86, 0x60, 0x08, 0xe3, 0x9a, 0x42, 0x7b, 0x2c, 0x0d, 0x09, 0x7c, 0x62, 0x75, 0x25, 0x31, 0xe8,
-	0x8f, 0x59, 0x5f, 0xed, 0xea, 0x48, 0x3c, 0x7f, 0x0e, 0x4c, 0x33, 0x8d, 0xe0, 0x37, 0xc4, 0xe8,
-	0x6a, 0x52, 0x8a, 0x90, 0xf0, 0x91, 0x72, 0xed, 0xda, 0x68, 0x44, 0x7f, 0x9a, 0x97, 0x50, 0x89,
-	0x7e, 0x4f, 0x8c, 0x12, 0xda, 0x01, 0x29, 0x1f, 0x2d, 0xd7, 0x6e, 0x8c, 0x83, 0x1a, 0x9c, 0x2c,
-	0xf6, 0xe5, 0xed, 0xf5, 0x71, 0x3e, 0x65, 0x4c, 0x9d, 0x2c, 0xed, 0xab, 0x47, 0xae, 0xc0, 0xf0,
-	0xe7, 0x53, 0x28, 0xf1, 0x33, 0xd7, 0x84, 0x8f, 0xef, 0x92, 0x14, 0x98, 0xfc, 0x25, 0x96, 0x32,
-	0x85, 0x0e, 0x60, 0x21, 0x72, 0x61, 0x09, 0x25, 0x90, 0x27, 0xdf, 0xcc, 0xaa, 0x5d, 0x1f, 0x03,
-	0x33, 0x6c, 0x11, 0xc1, 0x0b, 0x4a, 0xc9, 0x16, 0x91, 0x70, 0xfd, 0x29, 0xd9, 0x22, 0x12, 0xef,
-	0x3a, 0x31, 0xe3, 0x0e, 0x5d, 0x4c, 0x4a, 0x32, 0xee, 0xa4, 0xeb, 0x50, 0xb5, 0xab, 0x23, 0xf1,
-	0x82, 0x4a, 0x8b, 0x5c, 0x53, 0x4a, 0x52, 0x5a, 0xf2, 0x35, 0xa8, 0xda, 0xf5, 0x31, 0x30, 0xa3,
-	0x56, 0x30, 0xb8, 0xf4, 0x90, 0x66, 0x05, 0xb1, 0x2b, 0x3a, 0x69, 0x56, 0x10, 0xbf, 0x3f, 0x21,
-	0xac, 0x20, 0x72, 0x59, 0xe1, 0xda, 0x18, 0xed, 0xc1, 0x74, 0x2b, 0x48, 0x6e, 0x24, 0x2a, 0x53,
-	0xe8, 0x8f, 0x33, 0x50, 0x4d, 0xeb, 0x61, 0xa1, 0xdb, 0x13, 0x37, 0xdf, 0x6a, 0x6b, 0x93, 0x90,
-	0xf8, 0x52, 0x7c, 0x09, 0x28, 0x1e, 0x7e, 0xa0, 0xb7, 0x93, 0x76, 0x26, 0x25, 0xc8, 0xa9, 0xbd,
-	0x33, 0x1e, 0x72, 0x70, 0x27, 0xc3, 0x71, 0x49, 0xd2, 0x4e, 0x26, 0x46, 0x3d, 0x49, 0x3b, 0x99,
-	0x12, 0xe2, 0x30, 0x1f, 0x15, 0x0d, 0x53, 0x92, 0x7c, 0x54, 0x4a, 0x20, 0x94, 0xe4, 0xa3, 0x52,
-	0xa3, 0x9e, 0x29, 0xd4, 0x82, 0x82, 0xec, 0x04, 0xa2, 0x84, 0x93, 0x27, 0xd2, 0x87, 0xac, 0x29,
-	0xc3, 0x50, 0x7c, 0xa6, 0x4f, 0x20, 0x4f, 0xa1, 0xe8, 0x4c, 0x32, 0xb6, 0x64, 0x76, 0x36, 0xed,
-	0xb1, 0xcf, 0xe8, 0x39, 0xcc, 0xf0, 0xd6, 0x17, 0x4a, 0x28, 0x6a, 0x85, 0x1a, 0x74, 0xb5, 0xf3,
-	0xe9, 0x08, 0x3e, 0xbb, 0xcf, 0xf9, 0x3f, 0x1a, 0x13, 0x5d, 0x2d, 0x74, 0x29, 0xf9, 0xbf, 0xb4,
-	0x84, 0x9b, 0x68, 0xb5, 0xcb, 0x23, 0xb0, 0x82, 0xe6, 0x11, 0x49, 0xa8, 0xae, 0x8e, 0xcc, 0x8a,
-	0xd3, 0xcd, 0x23, 0x39, 0xef, 0xe6, 0x86, 0x1f, 0xcf, 0xcb, 0x93, 0x0c, 0x3f, 0xb5, 0x1a, 0x92,
-	0x64, 0xf8, 0xe9, 0xa9, 0xbe, 0x32, 0x85, 0x3c, 0x58, 0x4a, 0xa8, 0xc2, 0xa2, 0x77, 0xd2, 0x5e,
-	0xdc, 0xa4, 0x92, 0x70, 0xed, 0xe6, 0x98, 0xd8, 0xc1, 0xcd, 0x17, 0x8e, 0xec, 0x5c, 0x7a, 0x69,
-	0x32, 0x75, 0xf3, 0x63, 0x6e, 0xeb, 0x00, 0x16, 0x22, 0x11, 0x35, 0x4a, 0x3b, 0x94, 0xe2, 0xe7,
-	0xf1, 0xf5, 0x31, 0x30, 0xe5, 0x4c, 0x6b, 0xff, 0x96, 0x83, 0x39, 0x5e, 0xcb, 0x17, 0xf1, 0xdf,
-	0xa7, 0x00, 0x83, 0x36, 0x1a, 0xba, 0x98, 0xac, 0xfd, 0x50, 0x03, 0xb4, 0x76, 0x69, 0x38, 0x52,
-	0xd0, 0xa4, 0x03, 0x2d, 0x29, 0x74, 0x69, 0x44, 0xc7, 0x2a, 0xd5, 0xa4, 0x13, 0xfa, 0x5a, 0xca,
-	0x14, 0xfa, 0x18, 0x8a, 0x7e, 0xef, 0x03, 0x25, 0xf5, 0x4e, 0x22, 0xcd, 0x9d, 0xda, 0xc5, 0xa1,
-	0x38, 0x41, 0xa9, 0x03, 0x8d, 0x8d, 0x24, 0xa9, 0xe3, 0x0d, 0x94, 0x24, 0xa9, 0x93, 0xba, 0x23,
-	0x03, 0x9d, 0xf0, 0xf2, 0x67, 0xaa, 0x4e, 0x42, 0xd5, 0xe7, 0x54, 0x9d, 0x84, 0x6b, 0xa8, 0xca,
-	0xd4, 0x9a, 0x03, 0x65, 0x9e, 0xeb, 0xc9, 0xdd, 0xd5, 0x60, 0x2e, 0x98, 0x02, 0xa2, 0x54, 0x39,
-	0x43, 0xa9, 0x65, 0xed, 0xca, 0x28, 0x34, 0x39, 0xe3, 0xa3, 0x2b, 0xbf, 0xf9, 0xfa, 0x6c, 0xe6,
-	0x9f, 0xbf, 0x3e, 0x3b, 0xf5, 0xd5, 0x37, 0x67, 0x33, 0xbf, 0xf9, 0xe6, 0x6c, 0xe6, 0x1f, 0xbf,
-	0x39, 0x9b, 0xf9, 0xf7, 0x6f, 0xce, 0x66, 0xfe, 0xf4, 0x3f, 0xce, 0x4e, 0xfd, 0xac, 0x20, 0xc9,
-	0xf7, 0x66, 0xd8, 0xbf, 0x42, 0x7c, 0xef, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x3f, 0x1a,
-	0x13, 0xd0, 0x52, 0x00, 0x00,
+	// 5332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7c, 0xcd, 0x73, 0x1b, 0x47,
+	0x76, 0xb8, 0xf0, 0x41, 0x12, 0x78, 0x20, 0x48, 0xb0, 0x49, 0x91, 0x10, 0x64, 0x49, 0xd4, 0x48,
+	0xd6, 0xd7, 0x5a, 0xd4, 0x8a, 0xde, 0x95, 0x2d, 0xd9, 0x2b, 0x1b, 0x22, 0x29, 0x09, 0xbf, 0x95,
+	0x40, 0xfc, 0x06, 0xa4, 0x65, 0xaf, 0x5d, 0x35, 0x3b, 0xc4, 0x34, 0xc1, 0xb1, 0x80, 0x99, 0xf1,
+	0xf4, 0x40, 0x12, 0x93, 0xaa, 0x94, 0xab, 0x52, 0xb5, 0x87, 0x9c, 0x72, 0x4e, 0xe5, 0xb4, 0x7b,
+	0xc8, 0x21, 0x97, 0x54, 0xaa, 0x72, 0x4a, 0x2e, 0x49, 0x6d, 0xa5, 0xf6, 0xb2, 0x55, 0x39, 0xa5,
+	0xf2, 0x71, 0x89, 0x9d, 0x9c, 0x72, 0x48, 0xe5, 0x3f, 0x48, 0xaa, 0xbf, 0x06, 0xf3, 0x89, 0x0f,
+	0x5a, 0x5e, 0x3b, 0x27, 0x4c, 0xbf, 0x7e, 0xef, 0xf5, 0xeb, 0xd7, 0x6f, 0x5e, 0xbf, 0x7e, 0xaf,
+	0x07, 0x50, 0xd4, 0x1d, 0x73, 0xc3, 0x71, 0x6d, 0xcf, 0x46, 0x15, 0x77, 0x60, 0x79, 0x66, 0x1f,
+	0x6f, 0xbc, 0xb8, 0xad, 0xf7, 0x9c, 0x23, 0x7d, 0xb3, 0x76, 0xb3, 0x6b, 0x7a, 0x47, 0x83, 0x83,
+	0x8d, 0x8e, 0xdd, 0xbf, 0xd5, 0xb5, 0xbb, 0xf6, 0x2d, 0x86, 0x78, 0x30, 0x38, 0x64, 0x2d, 0xd6,
+	0x60, 0x4f, 0x9c, 0x81, 0x72, 0x03, 0x16, 0x3e, 0xc2, 0x2e, 0x31, 0x6d, 0x4b, 0xc5, 0x5f, 0x0c,
+	0x30, 0xf1, 0x50, 0x15, 0xe6, 0x5e, 0x70, 0x48, 0x35, 0xb3, 0x9e, 0xb9, 0x56, 0x54, 0x65, 0x53,
+	0xf9, 0xb3, 0x0c, 0x2c, 0xfa, 0xc8, 0xc4, 0xb1, 0x2d, 0x82, 0xd3, 0xb1, 0xd1, 0x45, 0x98, 0x17,
+	0xc2, 0x69, 0x96, 0xde, 0xc7, 0xd5, 0x2c, 0xeb, 0x2e, 0x09, 0x58, 0x53, 0xef, 0x63, 0x74, 0x15,
+	0x16, 0x25, 0x8a, 0x64, 0x92, 0x63, 0x58, 0x0b, 0x02, 0x2c, 0x46, 0x43, 0x1b, 0xb0, 0x2c, 0x11,
+	0x75, 0xc7, 0xf4, 0x91, 0xf3, 0x0c, 0x79, 0x49, 0x74, 0xd5, 0x1d, 0x53, 0xe0, 0x2b, 0x9f, 0x42,
+	0x71, 0xbb, 0xd9, 0xde, 0xb2, 0xad, 0x43, 0xb3, 0x4b, 0x45, 0x24, 0xd8, 0xa5, 0x34, 0xd5, 0xcc,
+	0x7a, 0x8e, 0x8a, 0x28, 0x9a, 0xa8, 0x06, 0x05, 0x82, 0x75, 0xb7, 0x73, 0x84, 0x49, 0x35, 0xcb,
+	0xba, 0xfc, 0x36, 0xa5, 0xb2, 0x1d, 0xcf, 0xb4, 0x2d, 0x52, 0xcd, 0x71, 0x2a, 0xd1, 0x54, 0x7e,
+	0x99, 0x81, 0x52, 0xcb, 0x76, 0xbd, 0xa7, 0xba, 0xe3, 0x98, 0x56, 0x17, 0xdd, 0x81, 0x02, 0xd3,
+	0x65, 0xc7, 0xee, 0x31, 0x1d, 0x2c, 0x6c, 0xd6, 0x36, 0xa2, 0xcb, 0xb2, 0xd1, 0x12, 0x18, 0xaa,
+	0x8f, 0x8b, 0xde, 0x84, 0x85, 0x8e, 0x6d, 0x79, 0xba, 0x69, 0x61, 0x57, 0x73, 0x6c, 0xd7, 0x63,
+	0x2a, 0x9a, 0x51, 0xcb, 0x3e, 0x94, 0x8e, 0x82, 0xce, 0x42, 0xf1, 0xc8, 0x26, 0x1e, 0xc7, 0xc8,
+	0x31, 0x8c, 0x02, 0x05, 0xb0, 0xce, 0x35, 0x98, 0x63, 0x9d, 0xa6, 0x23, 0x94, 0x31, 0x4b, 0x9b,
+	0x0d, 0x47, 0xf9, 0xd3, 0x2c, 0xcc, 0x3c, 0xb5, 0x07, 0x96, 0x17, 0x19, 0x46, 0xf7, 0x8e, 0xc4,
+	0x42, 0x05, 0x86, 0xd1, 0xbd, 0xa3, 0xe1, 0x30, 0x14, 0x83, 0xaf, 0x15, 0x1f, 0x86, 0x76, 0xd6,
+	0xa0, 0xe0, 0x62, 0xdd, 0xb0, 0xad, 0xde, 0x31, 0x13, 0xa1, 0xa0, 0xfa, 0x6d, 0xba, 0x88, 0x04,
+	0xf7, 0x4c, 0x6b, 0xf0, 0x4a, 0x73, 0x71, 0x4f, 0x3f, 0xc0, 0x3d, 0x26, 0x4a, 0x41, 0x5d, 0x10,
+	0x60, 0x95, 0x43, 0xd1, 0x36, 0x94, 0x1c, 0xd7, 0x76, 0xf4, 0xae, 0x4e, 0xf5, 0x58, 0x9d, 0x61,
+	0xaa, 0x52, 0xe2, 0xaa, 0x62, 0x62, 0xb7, 0x86, 0x98, 0x6a, 0x90, 0x0c, 0x21, 0xc8, 0x33, 0x73,
+	0x32, 0x98, 0x88, 0xec, 0x19, 0xad, 0xc2, 0xac, 0x65, 0x77, 0x6c, 0xe7, 0xb8, 0x8a, 0xd9, 0xc8,
+	0xa2, 0xc5, 0x56, 0x7e, 0x70, 0xc0, 0x66, 0x74, 0xc8, 0x8d, 0x53, 0x34, 0x95, 0xbf, 0xcc, 0xc0,
+	0x22, 0x35, 0x41, 0xe2, 0xe8, 0x1d, 0xbc, 0xcb, 0x16, 0x16, 0xdd, 0x85, 0x39, 0x0b, 0x7b, 0x2f,
+	0x6d, 0xf7, 0xb9, 0x58, 0xc6, 0x0b, 0x71, 0xd9, 0x7c, 0x9a, 0xa7, 0xb6, 0x81, 0x55, 0x89, 0x8f,
+	0x6e, 0x43, 0xce, 0x31, 0x8d, 0x6a, 0x76, 0x32, 0x32, 0x8a, 0x4b, 0x49, 0x4c, 0xa7, 0x53, 0xcd,
+	0x4d, 0x48, 0x62, 0x3a, 0x1d, 0x45, 0x01, 0x68, 0x58, 0xde, 0x9d, 0x1f, 0x7d, 0xa4, 0xf7, 0x06,
+	0x18, 0xad, 0xc0, 0xcc, 0x0b, 0xfa, 0xc0, 0x84, 0xcd, 0xa9, 0xbc, 0xa1, 0x7c, 0x95, 0x83, 0xb3,
+	0x4f, 0xa8, 0xd6, 0xdb, 0xba, 0x65, 0x1c, 0xd8, 0xaf, 0xda, 0xb8, 0x33, 0x70, 0x4d, 0xef, 0x78,
+	0xcb, 0xb6, 0x3c, 0xfc, 0xca, 0x43, 0x4d, 0x58, 0xb2, 0x24, 0x67, 0x4d, 0x1a, 0x38, 0xe5, 0x50,
+	0xda, 0xbc, 0x38, 0x42, 0x08, 0xae, 0x22, 0xb5, 0x62, 0x85, 0x01, 0x04, 0x3d, 0x1e, 0xae, 0xbe,
+	0xe4, 0x96, 0x65, 0xdc, 0x12, 0xa6, 0xd4, 0xde, 0x61, 0x92, 0x09, 0x5e, 0xd2, 0x3c, 0x24, 0xa7,
+	0xf7, 0x81, 0xfa, 0x06, 0x4d, 0x27, 0xda, 0x80, 0x60, 0x97, 0x29, 0xa6, 0xb4, 0xf9, 0x46, 0x9c,
+	0xcb, 0x50, 0x05, 0x6a, 0xd1, 0x1d, 0x58, 0x75, 0xb2, 0x4f, 0xb0, 0xcb, 0x5c, 0x89, 0xb0, 0x48,
+	0xcd, 0xb5, 0x6d, 0xef, 0x90, 0x48, 0x2b, 0x94, 0x60, 0x95, 0x41, 0xd1, 0x2d, 0x58, 0x26, 0x03,
+	0xc7, 0xe9, 0xe1, 0x3e, 0xb6, 0x3c, 0xbd, 0xa7, 0x75, 0x5d, 0x7b, 0xe0, 0x90, 0xea, 0xcc, 0x7a,
+	0xee, 0x5a, 0x4e, 0x45, 0xc1, 0xae, 0x47, 0xac, 0x07, 0x9d, 0x07, 0x70, 0x5c, 0xf3, 0x85, 0xd9,
+	0xc3, 0x5d, 0x6c, 0x54, 0x67, 0x19, 0xd3, 0x00, 0x04, 0xfd, 0x10, 0x56, 0x08, 0xee, 0x74, 0xec,
+	0xbe, 0xa3, 0x39, 0xae, 0x7d, 0x68, 0xf6, 0x30, 0x7f, 0x87, 0xe6, 0x98, 0xc5, 0x21, 0xd1, 0xd7,
+	0xe2, 0x5d, 0xec, 0x6d, 0xba, 0x0f, 0xf3, 0x62, 0xa6, 0x6c, 0xf0, 0x6a, 0x61, 0x82, 0xa9, 0x02,
+	0x9b, 0x2a, 0x13, 0x49, 0xf9, 0x65, 0x16, 0x4e, 0x33, 0x4d, 0xb6, 0x6c, 0x43, 0x2c, 0xb3, 0x70,
+	0x75, 0x97, 0xa0, 0xdc, 0x61, 0x3c, 0x35, 0x47, 0x77, 0xb1, 0xe5, 0x89, 0x57, 0x7d, 0x9e, 0x03,
+	0x5b, 0x0c, 0x86, 0x3e, 0x86, 0x0a, 0x11, 0x56, 0xa1, 0x75, 0xb8, 0x59, 0x88, 0x35, 0xbb, 0x19,
+	0x17, 0x61, 0x84, 0x2d, 0xa9, 0x8b, 0x24, 0x66, 0x5c, 0x73, 0xe4, 0x98, 0x74, 0xbc, 0x1e, 0xf7,
+	0x99, 0xa5, 0xcd, 0x1f, 0xa5, 0x30, 0x8c, 0x0a, 0xbe, 0xd1, 0xe6, 0x64, 0x3b, 0x96, 0xe7, 0x1e,
+	0xab, 0x92, 0x49, 0xed, 0x1e, 0xcc, 0x07, 0x3b, 0x50, 0x05, 0x72, 0xcf, 0xf1, 0xb1, 0x98, 0x14,
+	0x7d, 0x1c, 0xbe, 0x04, 0xdc, 0x63, 0xf1, 0xc6, 0xbd, 0xec, 0xbb, 0x19, 0xc5, 0x05, 0x34, 0x1c,
+	0xe5, 0x29, 0xf6, 0x74, 0x43, 0xf7, 0x74, 0xdf, 0x7b, 0x64, 0x02, 0xde, 0xa3, 0x02, 0xb9, 0x81,
+	0x78, 0x79, 0x8b, 0x2a, 0x7d, 0x44, 0x6f, 0x40, 0xd1, 0x37, 0x74, 0xb1, 0x23, 0x0d, 0x01, 0xd4,
+	0xab, 0xe8, 0x9e, 0x87, 0xfb, 0x8e, 0xc7, 0x4c, 0xac, 0xac, 0xca, 0xa6, 0xf2, 0x5f, 0x79, 0xa8,
+	0xc4, 0xd6, 0xe4, 0x43, 0x28, 0xf4, 0xc5, 0xf0, 0xe2, 0x45, 0xbb, 0x9c, 0xb0, 0x3d, 0xc4, 0x44,
+	0x55, 0x7d, 0x2a, 0xea, 0x7d, 0xa9, 0x27, 0x0e, 0xec, 0xa2, 0x7e, 0x9b, 0xae, 0x78, 0xcf, 0xee,
+	0x6a, 0x86, 0xe9, 0xe2, 0x8e, 0x67, 0xbb, 0xc7, 0x42, 0xdc, 0xf9, 0x9e, 0xdd, 0xdd, 0x96, 0x30,
+	0x74, 0x0f, 0xc0, 0xb0, 0x08, 0x5d, 0xec, 0x43, 0xb3, 0xcb, 0x84, 0x2e, 0x6d, 0x9e, 0x8d, 0x0b,
+	0xe1, 0x6f, 0x99, 0x6a, 0xd1, 0xb0, 0x88, 0x10, 0xff, 0x01, 0x94, 0xe9, 0xce, 0xa3, 0xf5, 0xf9,
+	0x6e, 0xc7, 0xdf, 0x94, 0xd2, 0xe6, 0xb9, 0xa4, 0x39, 0xf8, 0x7b, 0xa2, 0x3a, 0xef, 0x0c, 0x1b,
+	0x04, 0x3d, 0x84, 0x59, 0xb6, 0x05, 0x90, 0xea, 0x2c, 0x23, 0xde, 0x18, 0xa5, 0x00, 0x61, 0x11,
+	0x4f, 0x18, 0x01, 0x37, 0x08, 0x41, 0x8d, 0xf6, 0xa1, 0xa4, 0x5b, 0x96, 0xed, 0xe9, 0xdc, 0xd1,
+	0xcc, 0x31, 0x66, 0x6f, 0x4f, 0xc0, 0xac, 0x3e, 0xa4, 0xe2, 0x1c, 0x83, 0x7c, 0xd0, 0x4f, 0x60,
+	0x86, 0x79, 0x22, 0xf1, 0x22, 0x5e, 0x9d, 0xd0, 0x68, 0x55, 0x4e, 0x55, 0xbb, 0x0b, 0xa5, 0x80,
+	0xb0, 0xd3, 0x18, 0x69, 0xed, 0x3e, 0x54, 0xa2, 0xa2, 0x4d, 0x65, 0xe4, 0xbf, 0x0f, 0x2b, 0xea,
+	0xc0, 0x1a, 0x0a, 0x26, 0x63, 0xb8, 0x7b, 0x30, 0x2b, 0x16, 0x9b, 0x5b, 0x9c, 0x32, 0x5e, 0x47,
+	0xaa, 0xa0, 0x08, 0x06, 0x65, 0x47, 0xba, 0x65, 0xf4, 0xb0, 0x5b, 0xcd, 0x86, 0x82, 0xb2, 0xc7,
+	0x1c, 0xaa, 0xfc, 0x04, 0x4e, 0x47, 0x06, 0x17, 0x31, 0xe1, 0x65, 0x58, 0x70, 0x6c, 0x43, 0x23,
+	0x1c, 0xac, 0x99, 0x86, 0x74, 0x43, 0x8e, 0x8f, 0xdb, 0x30, 0x28, 0x79, 0xdb, 0xb3, 0x9d, 0xb8,
+	0xf0, 0x93, 0x91, 0x57, 0x61, 0x35, 0x4a, 0xce, 0x87, 0x57, 0x3e, 0x80, 0x35, 0x15, 0xf7, 0xed,
+	0x17, 0xf8, 0xa4, 0xac, 0x6b, 0x50, 0x8d, 0x33, 0x10, 0xcc, 0x3f, 0x81, 0xb5, 0x21, 0xb4, 0xed,
+	0xe9, 0xde, 0x80, 0x4c, 0xc5, 0x5c, 0x04, 0xcc, 0x07, 0x36, 0xe1, 0xcb, 0x59, 0x50, 0x65, 0x53,
+	0xb9, 0x1e, 0x64, 0xdd, 0xe4, 0x91, 0x05, 0x1f, 0x01, 0x2d, 0x40, 0xd6, 0x74, 0x04, 0xbb, 0xac,
+	0xe9, 0x28, 0x8f, 0xa1, 0xe8, 0x6f, 0xcd, 0xe8, 0xbd, 0x61, 0xa4, 0x9a, 0x9d, 0x74, 0x23, 0xf7,
+	0x83, 0xd9, 0xbd, 0xd8, 0x56, 0x22, 0x86, 0x7c, 0x0f, 0xc0, 0x77, 0x79, 0x32, 0x42, 0x38, 0x3b,
+	0x82, 0xb1, 0x1a, 0x40, 0x57, 0xfe, 0x25, 0xe4, 0x08, 0x03, 0x93, 0x30, 0xfc, 0x49, 0x18, 0x21,
+	0xc7, 0x98, 0x3d, 0x91, 0x63, 0x7c, 0x07, 0x66, 0x88, 0xa7, 0x7b, 0x58, 0x44, 0x51, 0x17, 0x47,
+	0x91, 0x53, 0x21, 0xb0, 0xca, 0xf1, 0xd1, 0x39, 0x80, 0x8e, 0x8b, 0x75, 0x0f, 0x1b, 0x9a, 0xce,
+	0xbd, 0x78, 0x4e, 0x2d, 0x0a, 0x48, 0xdd, 0x43, 0x5b, 0xc3, 0x48, 0x70, 0x86, 0x09, 0x76, 0x7d,
+	0x14, 0xe7, 0xd0, 0x52, 0x0d, 0x63, 0x42, 0xdf, 0xab, 0xcc, 0x4e, 0xe8, 0x55, 0x04, 0x03, 0x4e,
+	0x15, 0xf0, 0x99, 0x73, 0xe3, 0x7d, 0x26, 0x27, 0x9d, 0xc4, 0x67, 0x16, 0xc6, 0xfb, 0x4c, 0xc1,
+	0x6c, 0xa4, 0xcf, 0xfc, 0x2e, 0x9d, 0xde, 0x3f, 0x67, 0xa0, 0x1a, 0x7f, 0x07, 0x85, 0xef, 0xb9,
+	0x07, 0xb3, 0x84, 0x41, 0x26, 0xf1, 0x7c, 0x82, 0x56, 0x50, 0xa0, 0xc7, 0x90, 0x37, 0xad, 0x43,
+	0xbb, 0x9a, 0x4d, 0x8b, 0x5d, 0xd2, 0x46, 0xdd, 0x68, 0x58, 0x87, 0x36, 0x57, 0x12, 0xe3, 0x50,
+	0x7b, 0x07, 0x8a, 0x3e, 0x68, 0xaa, 0xb9, 0xed, 0xc2, 0x4a, 0xc4, 0x64, 0x79, 0xb0, 0xef, 0x5b,
+	0x7a, 0x66, 0x3a, 0x4b, 0x57, 0xbe, 0xcc, 0x06, 0xdf, 0xc4, 0x87, 0x66, 0xcf, 0xc3, 0x6e, 0xec,
+	0x4d, 0x7c, 0x5f, 0x72, 0xe7, 0xaf, 0xe1, 0x95, 0xb1, 0xdc, 0x79, 0x4c, 0x2a, 0x5e, 0xa6, 0xcf,
+	0x60, 0x81, 0xd9, 0x9a, 0x46, 0x70, 0x8f, 0x05, 0x1c, 0x22, 0xf8, 0xfb, 0xf1, 0x28, 0x36, 0x5c,
+	0x12, 0x6e, 0xb1, 0x6d, 0x41, 0xc7, 0x35, 0x58, 0xee, 0x05, 0x61, 0xb5, 0x0f, 0x01, 0xc5, 0x91,
+	0xa6, 0xd2, 0x69, 0x9b, 0xba, 0x38, 0xe2, 0x0d, 0xc7, 0x0e, 0xec, 0x92, 0x87, 0x4c, 0x8c, 0x49,
+	0x6c, 0x85, 0x0b, 0xac, 0x0a, 0x0a, 0xe5, 0xd7, 0x39, 0x80, 0x61, 0xe7, 0xff, 0x21, 0xdf, 0xf6,
+	0xa1, 0xef, 0x57, 0x78, 0x20, 0x77, 0x6d, 0x14, 0xe3, 0x44, 0x8f, 0xb2, 0x1b, 0xf6, 0x28, 0x3c,
+	0xa4, 0xbb, 0x39, 0x92, 0xcd, 0xf7, 0xd6, 0x97, 0x3c, 0x81, 0xd5, 0xa8, 0x6d, 0x08, 0x47, 0xb2,
+	0x09, 0x33, 0xa6, 0x87, 0xfb, 0x3c, 0x67, 0x94, 0x78, 0x3a, 0x0b, 0x10, 0x71, 0x54, 0xe5, 0x22,
+	0x14, 0x1b, 0x7d, 0xbd, 0x8b, 0xdb, 0x0e, 0xee, 0xd0, 0x41, 0x4d, 0xda, 0x10, 0x82, 0xf0, 0x86,
+	0xb2, 0x09, 0x85, 0x9f, 0xe2, 0x63, 0xfe, 0x52, 0x4f, 0x28, 0xa8, 0xf2, 0xf7, 0x05, 0x58, 0x63,
+	0x7b, 0xc5, 0x96, 0xcc, 0xd8, 0xa8, 0x98, 0xd8, 0x03, 0xb7, 0x83, 0x09, 0x5b, 0x6d, 0x67, 0xa0,
+	0x39, 0xd8, 0x35, 0x6d, 0x43, 0xa4, 0x02, 0x8a, 0x1d, 0x67, 0xd0, 0x62, 0x00, 0x9a, 0xd5, 0xa1,
+	0xdd, 0x5f, 0x0c, 0x6c, 0x61, 0x88, 0x39, 0xb5, 0xd0, 0x71, 0x06, 0xff, 0x9f, 0xb6, 0x25, 0x2d,
+	0x39, 0xd2, 0x5d, 0x4c, 0xaa, 0x39, 0x9f, 0xb6, 0xcd, 0x00, 0xe8, 0x36, 0x9c, 0xee, 0xe3, 0xbe,
+	0xed, 0x1e, 0x6b, 0x3d, 0xb3, 0x6f, 0x7a, 0x9a, 0x69, 0x69, 0x07, 0xc7, 0x1e, 0x26, 0xc2, 0xa6,
+	0x10, 0xef, 0x7c, 0x42, 0xfb, 0x1a, 0xd6, 0x03, 0xda, 0x83, 0x14, 0x28, 0xdb, 0x76, 0x5f, 0x23,
+	0x1d, 0xdb, 0xc5, 0x9a, 0x6e, 0x7c, 0xce, 0xb6, 0xcf, 0x9c, 0x5a, 0xb2, 0xed, 0x7e, 0x9b, 0xc2,
+	0xea, 0xc6, 0xe7, 0xe8, 0x02, 0x94, 0x3a, 0xce, 0x80, 0x60, 0x4f, 0xa3, 0x3f, 0x6c, 0x77, 0x2c,
+	0xaa, 0xc0, 0x41, 0x5b, 0xce, 0x80, 0x04, 0x10, 0xfa, 0x54, 0xff, 0x73, 0x41, 0x84, 0xa7, 0xb8,
+	0x4f, 0xd0, 0x33, 0x00, 0xc3, 0x24, 0xcf, 0xc5, 0xac, 0x0c, 0xb6, 0x3e, 0xef, 0xa6, 0x6c, 0xaf,
+	0x71, 0x95, 0x6d, 0x6c, 0x9b, 0xe4, 0x39, 0x53, 0x00, 0x37, 0xc5, 0xa2, 0x21, 0xdb, 0x34, 0x65,
+	0x79, 0xd0, 0x7b, 0x6e, 0xda, 0xda, 0x4b, 0x6c, 0x76, 0x8f, 0x3c, 0x96, 0x4d, 0x2a, 0xab, 0x25,
+	0x06, 0x7b, 0xc6, 0x40, 0xa8, 0x09, 0xcb, 0x41, 0x14, 0xcd, 0xc0, 0x2f, 0xcc, 0x0e, 0xae, 0x1e,
+	0x32, 0x21, 0xce, 0xc7, 0x85, 0xe0, 0x64, 0xdb, 0x0c, 0x4b, 0x5d, 0x0a, 0x70, 0xe2, 0x20, 0xd4,
+	0x86, 0xd3, 0x9c, 0x1f, 0x67, 0xa4, 0xd1, 0x6c, 0x85, 0x76, 0xe0, 0x90, 0x6a, 0x97, 0x71, 0x5c,
+	0x8f, 0x73, 0xdc, 0x3b, 0x72, 0x6d, 0xcf, 0xeb, 0x61, 0xc1, 0x13, 0x31, 0x72, 0xd1, 0xc0, 0xba,
+	0xf1, 0xc0, 0xa1, 0x7b, 0xfe, 0x6a, 0x88, 0xe9, 0x4b, 0xd7, 0xf4, 0x30, 0xe3, 0x7a, 0x34, 0x21,
+	0xd7, 0xe5, 0x00, 0xd7, 0x67, 0x94, 0x3a, 0x89, 0x2d, 0x93, 0xb5, 0xb1, 0xeb, 0x90, 0xaa, 0x79,
+	0x02, 0xb6, 0x54, 0x58, 0x4a, 0x8c, 0x9e, 0xc1, 0x5a, 0x82, 0xb4, 0x8c, 0xef, 0xe7, 0x13, 0xf2,
+	0x5d, 0x89, 0x8a, 0xcb, 0x18, 0x5f, 0x82, 0xf2, 0x73, 0xec, 0x5a, 0xb8, 0xa7, 0x71, 0x53, 0xad,
+	0x3e, 0x67, 0xd6, 0x38, 0xcf, 0x81, 0x4f, 0x19, 0x0c, 0xdd, 0x04, 0x61, 0xc8, 0x9a, 0x8b, 0x69,
+	0x5e, 0x98, 0x27, 0x27, 0x7b, 0x0c, 0x73, 0x89, 0xf7, 0xa8, 0xc3, 0x0e, 0xd4, 0x00, 0x01, 0xd4,
+	0xc8, 0x4b, 0x76, 0xbc, 0xc5, 0x84, 0x54, 0xfb, 0x13, 0x24, 0x70, 0x2a, 0x9c, 0xac, 0xed, 0x53,
+	0xa1, 0x4d, 0x98, 0x1b, 0xb0, 0x37, 0x8b, 0x54, 0x2d, 0x36, 0xcf, 0x6a, 0x9c, 0xc1, 0x3e, 0x43,
+	0x50, 0x25, 0x62, 0xed, 0x7d, 0x58, 0x08, 0x9b, 0xef, 0x54, 0xde, 0xee, 0x1e, 0xcc, 0x87, 0x8c,
+	0x0f, 0x41, 0x3e, 0x90, 0x10, 0x66, 0xcf, 0x34, 0x97, 0xca, 0x71, 0x18, 0x79, 0x59, 0x15, 0x2d,
+	0xe5, 0x5d, 0x58, 0x08, 0x2b, 0x3d, 0x91, 0x1a, 0x41, 0xde, 0x95, 0x81, 0x44, 0x5e, 0x65, 0xcf,
+	0xca, 0x36, 0xcc, 0xf2, 0x69, 0x24, 0x66, 0x5f, 0x10, 0xe4, 0x8f, 0x74, 0xd7, 0x10, 0xce, 0x89,
+	0x3d, 0x53, 0x18, 0xb1, 0x0f, 0x3d, 0xe1, 0x92, 0xd8, 0xb3, 0xa2, 0x43, 0x39, 0x94, 0x3f, 0xa4,
+	0x48, 0x2c, 0x51, 0x28, 0x98, 0xd1, 0x67, 0x36, 0xbc, 0xdd, 0x93, 0x33, 0x67, 0xcf, 0x14, 0xe6,
+	0x1d, 0x3b, 0x32, 0x8f, 0xc3, 0x9e, 0xa9, 0x8a, 0x7a, 0xf8, 0x85, 0xc8, 0x54, 0x17, 0x55, 0xde,
+	0x50, 0x0c, 0x80, 0x2d, 0xdd, 0xd1, 0x0f, 0xcc, 0x9e, 0xe9, 0x1d, 0xa3, 0xeb, 0x50, 0xd1, 0x0d,
+	0x43, 0xeb, 0x48, 0x88, 0x89, 0x65, 0xfd, 0x60, 0x51, 0x37, 0x8c, 0xad, 0x00, 0x18, 0xfd, 0x00,
+	0x96, 0x0c, 0xd7, 0x76, 0xc2, 0xb8, 0xbc, 0xa0, 0x50, 0xa1, 0x1d, 0x41, 0x64, 0xe5, 0x3f, 0x66,
+	0xe0, 0x5c, 0xd8, 0x35, 0x45, 0x73, 0xb4, 0x1f, 0xc2, 0x7c, 0x64, 0xd4, 0x14, 0xf3, 0x1a, 0x4a,
+	0xab, 0x86, 0x28, 0x22, 0x39, 0xcb, 0x6c, 0x2c, 0x67, 0x99, 0x98, 0x05, 0xce, 0xbd, 0xd6, 0x2c,
+	0x70, 0xfe, 0xb5, 0x64, 0x81, 0x67, 0xa6, 0xcb, 0x02, 0x5f, 0x81, 0xc5, 0x00, 0x35, 0xb3, 0x35,
+	0xbe, 0xbf, 0x94, 0x7d, 0x1c, 0x4b, 0x16, 0x9e, 0x22, 0xd9, 0xe2, 0xb9, 0x69, 0xb2, 0xc5, 0x85,
+	0xd4, 0x6c, 0x31, 0xb5, 0x1a, 0xc7, 0xd1, 0xdd, 0xbe, 0xed, 0xca, 0x74, 0x70, 0xb5, 0xc8, 0x44,
+	0x58, 0x94, 0x70, 0x91, 0x0a, 0x4e, 0x4d, 0x1c, 0x43, 0x6a, 0xe2, 0x78, 0x1d, 0xe6, 0x2d, 0x5b,
+	0xb3, 0xf0, 0x4b, 0x8d, 0xae, 0x25, 0xa9, 0x96, 0xf8, 0xc2, 0x5a, 0x76, 0x13, 0xbf, 0x6c, 0x51,
+	0x48, 0x2c, 0xb5, 0x3c, 0x3f, 0x5d, 0x6a, 0x99, 0xee, 0x80, 0x7d, 0x9d, 0x3c, 0xc7, 0x06, 0x13,
+	0x85, 0x54, 0xcb, 0xcc, 0x88, 0x4b, 0x1c, 0x46, 0x65, 0x20, 0xb4, 0x9e, 0xe4, 0xeb, 0x8e, 0x23,
+	0x2d, 0x30, 0xa4, 0xb2, 0x84, 0x32, 0x34, 0xe5, 0xaf, 0x33, 0xb0, 0x12, 0x36, 0x73, 0x91, 0x50,
+	0x7c, 0x04, 0x45, 0x57, 0xee, 0xc5, 0xd5, 0x4c, 0xda, 0xf1, 0x3a, 0x65, 0xf3, 0x56, 0x87, 0xb4,
+	0xe8, 0x67, 0xa9, 0x79, 0xec, 0x5b, 0xe3, 0xf8, 0x8d, 0xcb, 0x64, 0x2b, 0x0d, 0xb8, 0xf0, 0xcc,
+	0xb4, 0x0c, 0xfb, 0x25, 0x49, 0x7d, 0x4b, 0x13, 0x6c, 0x2d, 0x93, 0x60, 0x6b, 0xca, 0xdf, 0x66,
+	0x60, 0x35, 0xca, 0x4b, 0xa8, 0xa2, 0x11, 0x57, 0xc5, 0x0f, 0x12, 0x42, 0x88, 0x08, 0x71, 0xa2,
+	0x32, 0x3e, 0x4b, 0x55, 0xc6, 0xed, 0xf1, 0x1c, 0xc7, 0xaa, 0xe3, 0xcf, 0x33, 0x70, 0x26, 0x55,
+	0x8c, 0x48, 0x1c, 0x99, 0x89, 0xc6, 0x91, 0x22, 0x06, 0xed, 0xd8, 0x03, 0xcb, 0x0b, 0xc4, 0xa0,
+	0x5b, 0xb4, 0x2d, 0x82, 0x3d, 0xad, 0xaf, 0xbf, 0x32, 0xfb, 0x83, 0xbe, 0xf0, 0xf8, 0x94, 0xdd,
+	0x53, 0x0e, 0x39, 0x41, 0x14, 0xaa, 0xd4, 0x61, 0xc9, 0x97, 0x72, 0x64, 0xe6, 0x3f, 0x90, 0xc9,
+	0xcf, 0x86, 0x33, 0xf9, 0x16, 0xcc, 0x8a, 0x5d, 0xee, 0x75, 0x94, 0x4f, 0xd7, 0xa1, 0xe4, 0x60,
+	0xb7, 0x6f, 0x12, 0xe2, 0x3b, 0xda, 0xa2, 0x1a, 0x04, 0x29, 0xbf, 0x9a, 0x83, 0xc5, 0xa8, 0x75,
+	0x7c, 0x10, 0x2b, 0x1c, 0x5c, 0x4a, 0xd8, 0x02, 0xa2, 0x13, 0x0d, 0x1c, 0x21, 0x6f, 0xcb, 0x13,
+	0x48, 0x36, 0x2d, 0x7b, 0xe7, 0x9f, 0x56, 0xc4, 0xf1, 0x84, 0x6a, 0xa4, 0x63, 0xf7, 0xfb, 0xba,
+	0x65, 0xc8, 0xaa, 0xb7, 0x68, 0x52, 0xfd, 0xe9, 0x6e, 0x97, 0xaa, 0x9d, 0x82, 0xd9, 0x33, 0x5d,
+	0x3c, 0x9a, 0xea, 0x32, 0x2d, 0x56, 0x80, 0x60, 0xce, 0xba, 0xa8, 0x82, 0x00, 0x6d, 0x9b, 0x2e,
+	0xda, 0x80, 0x3c, 0xb6, 0x5e, 0xc8, 0x33, 0x62, 0x42, 0x59, 0x5c, 0x9e, 0x85, 0x54, 0x86, 0x87,
+	0x6e, 0xc1, 0x6c, 0x9f, 0x9a, 0x85, 0x4c, 0x7a, 0xad, 0xa5, 0x54, 0x87, 0x55, 0x81, 0x46, 0x63,
+	0x28, 0x1e, 0x35, 0xca, 0xcc, 0x56, 0x42, 0x0c, 0x25, 0x62, 0x44, 0x89, 0x88, 0x76, 0xfc, 0x13,
+	0x70, 0x31, 0xed, 0xe8, 0x1a, 0x59, 0x8a, 0xc4, 0x63, 0xf0, 0x5e, 0xf8, 0x18, 0x0c, 0x8c, 0xd7,
+	0xe6, 0x78, 0x5e, 0xa3, 0x6b, 0x11, 0x67, 0xa0, 0x40, 0xeb, 0x39, 0xcc, 0x8c, 0x4a, 0xbc, 0x66,
+	0xdd, 0xb3, 0xbb, 0xcc, 0x8a, 0x56, 0x68, 0x46, 0xc0, 0x30, 0x2d, 0xe6, 0xd4, 0x0b, 0x2a, 0x6f,
+	0xd0, 0x97, 0x8f, 0x3d, 0x68, 0xb6, 0xd5, 0xc1, 0xd5, 0x32, 0xeb, 0x2a, 0x32, 0xc8, 0xae, 0xd5,
+	0x61, 0x67, 0x4c, 0xcf, 0x3b, 0xae, 0x2e, 0x30, 0x38, 0x7d, 0xa4, 0xc9, 0x1e, 0x9e, 0x97, 0x5c,
+	0x4c, 0x4b, 0xf6, 0x24, 0xb9, 0x6d, 0x99, 0x96, 0x7c, 0x00, 0x73, 0x2f, 0xb9, 0x23, 0xa8, 0x56,
+	0xd6, 0x33, 0xc9, 0xf9, 0x83, 0x64, 0x6f, 0xa7, 0x4a, 0x42, 0xba, 0xc9, 0x58, 0xd8, 0xa3, 0x7b,
+	0x98, 0x4d, 0x9d, 0x0c, 0x2b, 0xe5, 0xe7, 0xd4, 0x92, 0x85, 0xbd, 0x96, 0x00, 0x51, 0x35, 0xb0,
+	0xd3, 0x1d, 0xcd, 0xa2, 0x63, 0xae, 0x06, 0xd6, 0x6e, 0x18, 0xdf, 0x65, 0xb6, 0xe0, 0xd7, 0x19,
+	0x58, 0xdd, 0x62, 0x99, 0x94, 0x80, 0x17, 0x9c, 0x26, 0xf9, 0x7f, 0xd7, 0xaf, 0xcb, 0xa4, 0x66,
+	0xea, 0xa3, 0x5a, 0x13, 0x04, 0xa8, 0x01, 0x0b, 0x92, 0xb9, 0x60, 0x91, 0x9b, 0xb8, 0xb4, 0x53,
+	0x26, 0xc1, 0xa6, 0xf2, 0x3e, 0xac, 0xc5, 0x66, 0x21, 0xb2, 0x1e, 0x17, 0x61, 0x7e, 0xe8, 0xed,
+	0xfc, 0x49, 0x94, 0x7c, 0x58, 0xc3, 0x50, 0xee, 0xd1, 0xba, 0x8d, 0xee, 0x7a, 0x31, 0x15, 0x4c,
+	0x40, 0xcb, 0x8a, 0x36, 0x61, 0x5a, 0x51, 0x57, 0x69, 0xc3, 0x0a, 0x2d, 0xe7, 0x9c, 0x80, 0x29,
+	0xf5, 0x59, 0x74, 0xfe, 0xf6, 0x40, 0xee, 0x2e, 0xb2, 0xa9, 0xac, 0xc1, 0xe9, 0x08, 0x53, 0x31,
+	0xda, 0x7b, 0xb0, 0xca, 0x2b, 0x3c, 0x27, 0x99, 0xc4, 0x19, 0x58, 0x8b, 0x11, 0x0b, 0xbe, 0x4f,
+	0x61, 0x79, 0xb8, 0xa9, 0x0e, 0xb3, 0xb7, 0x77, 0xc2, 0xd9, 0xdb, 0xf5, 0x11, 0xab, 0x1e, 0x4a,
+	0xde, 0xfe, 0x2a, 0x1b, 0xd8, 0x15, 0x52, 0x72, 0xb7, 0xef, 0x85, 0x73, 0xb7, 0x6f, 0x8e, 0xe3,
+	0x1d, 0x4a, 0xdd, 0xc6, 0xad, 0x36, 0x97, 0x60, 0xb5, 0x9f, 0xc6, 0x12, 0xbc, 0xf9, 0xb4, 0x0c,
+	0x79, 0x44, 0xda, 0xdf, 0x49, 0x7e, 0x57, 0xe5, 0xf9, 0x5d, 0x7f, 0x68, 0xbf, 0x20, 0x77, 0x37,
+	0x92, 0xdf, 0xbd, 0x38, 0x56, 0x5e, 0x3f, 0xbd, 0xfb, 0x57, 0x79, 0x28, 0xfa, 0x7d, 0x31, 0x9d,
+	0xc7, 0xd5, 0x96, 0x4d, 0x50, 0x5b, 0x70, 0xff, 0xce, 0x7d, 0xa3, 0xfd, 0x3b, 0x3f, 0xf1, 0xfe,
+	0x7d, 0x16, 0x8a, 0xec, 0x41, 0x73, 0xf1, 0xa1, 0xd8, 0x8f, 0x0b, 0x0c, 0xa0, 0xe2, 0xc3, 0xa1,
+	0x19, 0xce, 0x4e, 0x65, 0x86, 0x91, 0x8c, 0xf2, 0x5c, 0x34, 0xa3, 0xfc, 0x81, 0xbf, 0x9f, 0xf2,
+	0x2d, 0xf8, 0xea, 0x08, 0xbe, 0x89, 0x3b, 0x69, 0x33, 0xbc, 0x93, 0xf2, 0x5d, 0xf9, 0xad, 0x51,
+	0x5c, 0xbe, 0xb7, 0xf9, 0xe4, 0x7d, 0x9e, 0x4f, 0x0e, 0xda, 0xa2, 0xf0, 0xac, 0xef, 0x01, 0xf8,
+	0x4e, 0x44, 0x26, 0x95, 0xcf, 0x8e, 0x98, 0xa3, 0x1a, 0x40, 0xa7, 0x6c, 0x43, 0x4b, 0x33, 0x20,
+	0x93, 0xfb, 0xab, 0x11, 0x15, 0xe7, 0xbf, 0x28, 0xc0, 0x62, 0x84, 0x6f, 0xcc, 0xd6, 0x3f, 0x88,
+	0x55, 0x32, 0xa6, 0xb4, 0xe2, 0x3b, 0xe1, 0x42, 0xc6, 0x09, 0xad, 0x2e, 0x56, 0xc7, 0x60, 0x71,
+	0x8f, 0xee, 0x8a, 0x6e, 0x9e, 0x67, 0x2e, 0x0a, 0x48, 0x9d, 0x9d, 0x2b, 0x0e, 0x4d, 0xcb, 0x24,
+	0x47, 0xbc, 0x7f, 0x96, 0xf5, 0x83, 0x04, 0xd5, 0xd9, 0xb5, 0x4a, 0xfc, 0xca, 0xf4, 0xb4, 0x8e,
+	0x6d, 0x60, 0x66, 0xd3, 0x33, 0x6a, 0x81, 0x02, 0xb6, 0x6c, 0x03, 0x0f, 0xdf, 0xbc, 0xc2, 0xc9,
+	0xde, 0xbc, 0x62, 0xe4, 0xcd, 0x5b, 0x85, 0x59, 0x17, 0xeb, 0xc4, 0xb6, 0xc4, 0xe1, 0x5e, 0xb4,
+	0xe8, 0xd2, 0xf4, 0x31, 0x21, 0x74, 0x24, 0x11, 0xec, 0x89, 0x66, 0x20, 0x48, 0x9d, 0x1f, 0x1b,
+	0xa4, 0x8e, 0xa8, 0xfe, 0x46, 0x82, 0xd4, 0xf2, 0xd8, 0x20, 0x75, 0x92, 0xe2, 0x6f, 0x20, 0x4c,
+	0x5f, 0x98, 0x2c, 0x4c, 0x0f, 0x46, 0xb5, 0x8b, 0xe1, 0xa8, 0xf6, 0x31, 0xcc, 0xbd, 0xb0, 0x7b,
+	0x83, 0x3e, 0x26, 0x55, 0x23, 0xad, 0xd0, 0x1d, 0x95, 0xee, 0x23, 0x4e, 0x20, 0x6e, 0x8b, 0x09,
+	0xf2, 0x70, 0x62, 0x01, 0x7f, 0x83, 0xc4, 0x42, 0x30, 0xf8, 0x3c, 0x0c, 0x05, 0x9f, 0xfe, 0x81,
+	0xa6, 0x3b, 0xd9, 0x81, 0xe6, 0x3b, 0x74, 0x45, 0xb5, 0x3d, 0x98, 0x0f, 0xea, 0x29, 0x81, 0x76,
+	0x23, 0x48, 0x9b, 0x78, 0x74, 0xe2, 0x0c, 0x82, 0x0e, 0xae, 0x00, 0xb3, 0x1c, 0xa8, 0xfc, 0x63,
+	0x06, 0xd6, 0x62, 0x4e, 0x49, 0x38, 0xbb, 0xbb, 0x91, 0x2a, 0xfc, 0xc5, 0xb1, 0x6b, 0xea, 0x17,
+	0xe1, 0x1f, 0x85, 0x8a, 0xf0, 0x6f, 0x8f, 0x27, 0x7c, 0xed, 0x35, 0xf8, 0xbf, 0xc9, 0xc2, 0x85,
+	0x7d, 0xc7, 0x88, 0xc4, 0xc7, 0xc2, 0x4c, 0x26, 0x77, 0xbb, 0x1f, 0xc8, 0x73, 0x56, 0x76, 0x5a,
+	0x53, 0xe4, 0x74, 0xe8, 0x0b, 0xa8, 0x10, 0x07, 0x77, 0xb4, 0xe0, 0x0b, 0xcc, 0x5f, 0x91, 0x87,
+	0x09, 0x85, 0x82, 0xd1, 0x02, 0x6f, 0x50, 0x57, 0x15, 0x7b, 0xa9, 0x17, 0x49, 0x18, 0x5a, 0x7b,
+	0x00, 0x2b, 0x49, 0x88, 0x53, 0xa9, 0x4f, 0x81, 0xf5, 0x74, 0x61, 0x44, 0x9c, 0xfc, 0x73, 0x58,
+	0xdc, 0x79, 0x85, 0x3b, 0xed, 0x63, 0xab, 0x33, 0x85, 0x46, 0x2b, 0x90, 0xeb, 0xf4, 0x0d, 0x91,
+	0x58, 0xa7, 0x8f, 0xc1, 0xd0, 0x3f, 0x17, 0x0e, 0xfd, 0x35, 0xa8, 0x0c, 0x47, 0x10, 0x56, 0xb9,
+	0x4a, 0xad, 0xd2, 0xa0, 0xc8, 0x94, 0xf9, 0xbc, 0x2a, 0x5a, 0x02, 0x8e, 0x5d, 0x7e, 0xd1, 0x8d,
+	0xc3, 0xb1, 0xeb, 0x86, 0xb7, 0x88, 0x5c, 0x78, 0x8b, 0x50, 0xfe, 0x24, 0x03, 0x25, 0x3a, 0xc2,
+	0x37, 0x92, 0x5f, 0x9c, 0xce, 0x73, 0xc3, 0xd3, 0xb9, 0x7f, 0xc8, 0xcf, 0x07, 0x0f, 0xf9, 0x43,
+	0xc9, 0x67, 0x18, 0x38, 0x2e, 0xf9, 0xac, 0x0f, 0xc7, 0xae, 0xab, 0xac, 0xc3, 0x3c, 0x97, 0x4d,
+	0xcc, 0x9c, 0x5e, 0x71, 0x75, 0x7b, 0x72, 0xfd, 0x06, 0x6e, 0x4f, 0xf9, 0xa3, 0x0c, 0x94, 0xeb,
+	0x9e, 0xa7, 0x77, 0x8e, 0xa6, 0x98, 0x80, 0x2f, 0x5c, 0x36, 0x28, 0x5c, 0x7c, 0x12, 0x43, 0x71,
+	0xf3, 0x29, 0xe2, 0xce, 0x84, 0xc4, 0x55, 0x60, 0x41, 0xca, 0x92, 0x2a, 0x70, 0x93, 0xde, 0xe7,
+	0x75, 0xbd, 0x87, 0xb6, 0xfb, 0x52, 0x77, 0x8d, 0xe9, 0x8e, 0xdd, 0xb4, 0x52, 0xc5, 0xbf, 0xaf,
+	0xc8, 0x5d, 0x9b, 0x51, 0xd9, 0xb3, 0x72, 0x15, 0x96, 0x43, 0xfc, 0x52, 0x07, 0xfe, 0x10, 0x4a,
+	0x6c, 0xb3, 0x17, 0xe7, 0xaf, 0xdb, 0xc1, 0xb2, 0xfe, 0x44, 0xa1, 0x81, 0xf2, 0xff, 0x60, 0x89,
+	0x06, 0x85, 0x0c, 0xee, 0x7b, 0x90, 0x1f, 0x47, 0x0e, 0x27, 0xe7, 0x52, 0x18, 0x45, 0x0e, 0x26,
+	0xbf, 0xcd, 0xc2, 0x0c, 0x83, 0xc7, 0x02, 0xb5, 0xb3, 0x74, 0xfb, 0x73, 0x6c, 0xcd, 0xd3, 0xbb,
+	0xfe, 0xd7, 0x2c, 0x14, 0xb0, 0xa7, 0x77, 0x59, 0xca, 0x85, 0x75, 0x1a, 0x66, 0x17, 0x13, 0x4f,
+	0x7e, 0xd2, 0x52, 0xa2, 0xb0, 0x6d, 0x0e, 0x62, 0x45, 0x37, 0xf3, 0xf7, 0xf8, 0x61, 0x23, 0xaf,
+	0xb2, 0x67, 0xb4, 0xc1, 0xaf, 0x46, 0x4f, 0x52, 0x85, 0xa1, 0x88, 0xf4, 0xa6, 0x72, 0xa4, 0xf0,
+	0xe2, 0xb7, 0xd1, 0xfd, 0xe8, 0x46, 0x7f, 0x39, 0x65, 0xc6, 0xc9, 0xdb, 0xfb, 0xb7, 0xb4, 0x9f,
+	0xed, 0x00, 0x0a, 0xae, 0x8d, 0xb0, 0x82, 0x5b, 0x30, 0xcb, 0x96, 0x4e, 0x06, 0xea, 0x6b, 0x29,
+	0xa2, 0xaa, 0x02, 0x4d, 0xd1, 0x01, 0xf1, 0x65, 0x0f, 0x05, 0xe7, 0xd3, 0xdb, 0xca, 0x88, 0x60,
+	0xfd, 0xef, 0x32, 0xb0, 0x1c, 0x1a, 0x43, 0xc8, 0x7a, 0x33, 0x3c, 0x48, 0xaa, 0xa8, 0x62, 0x80,
+	0xad, 0xd0, 0xfe, 0x7a, 0x2b, 0x4d, 0xa4, 0x6f, 0x69, 0x6f, 0xfd, 0x6d, 0x06, 0xa0, 0x3e, 0xf0,
+	0x8e, 0x44, 0x8a, 0x3b, 0x68, 0x2f, 0x99, 0x88, 0xbd, 0xd4, 0xa0, 0xe0, 0xe8, 0x84, 0xbc, 0xb4,
+	0x5d, 0x79, 0xbc, 0xf6, 0xdb, 0x2c, 0x19, 0x3d, 0xf0, 0x8e, 0x64, 0x4d, 0x97, 0x3e, 0xd3, 0x44,
+	0x3d, 0xff, 0xae, 0x4b, 0xd3, 0x0d, 0xc3, 0xa5, 0x65, 0x79, 0x5e, 0xdc, 0x2d, 0x73, 0x68, 0x9d,
+	0x03, 0x29, 0x9a, 0x69, 0x60, 0xcb, 0xa3, 0x85, 0x12, 0xcf, 0x7e, 0x8e, 0x2d, 0x71, 0x4c, 0x2e,
+	0x4b, 0xe8, 0x1e, 0x05, 0xf2, 0x2a, 0x57, 0xd7, 0x24, 0x9e, 0x2b, 0xd1, 0x64, 0x21, 0x51, 0x40,
+	0x19, 0x1a, 0x5d, 0x94, 0x4a, 0x6b, 0xd0, 0xeb, 0x71, 0x15, 0x9f, 0x7c, 0xd9, 0x7f, 0x28, 0x26,
+	0x94, 0x4d, 0x7b, 0xd3, 0x86, 0x4a, 0x13, 0xd3, 0x7d, 0x8d, 0xf9, 0xc0, 0x1f, 0xc2, 0x52, 0x60,
+	0x0e, 0xc2, 0xac, 0x42, 0xe7, 0x99, 0x4c, 0xf8, 0x3c, 0xa3, 0x3c, 0x02, 0xc4, 0x53, 0x60, 0xdf,
+	0x70, 0xde, 0xca, 0x69, 0x58, 0x0e, 0x31, 0x12, 0xf1, 0xc1, 0x0d, 0x28, 0x8b, 0x5b, 0xb5, 0xc2,
+	0x50, 0xce, 0x40, 0x81, 0xfa, 0xf9, 0x8e, 0x69, 0xc8, 0x82, 0xff, 0x9c, 0x63, 0x1b, 0x5b, 0xa6,
+	0xe1, 0x2a, 0xcf, 0xa0, 0xac, 0xf2, 0x71, 0x04, 0xee, 0x43, 0x58, 0x10, 0x77, 0x70, 0xb5, 0xd0,
+	0x25, 0xf8, 0xa4, 0x8f, 0xac, 0x82, 0x83, 0xa8, 0x65, 0x2b, 0xd8, 0x54, 0x0c, 0xa8, 0xf1, 0x40,
+	0x26, 0xc4, 0x5e, 0x4e, 0xf6, 0x21, 0xc8, 0xfb, 0xf0, 0x63, 0x47, 0x09, 0xd3, 0x97, 0xdd, 0x60,
+	0x53, 0x39, 0x07, 0x67, 0x13, 0x47, 0x11, 0x9a, 0x70, 0xa0, 0x32, 0xec, 0x30, 0x4c, 0x79, 0xf3,
+	0x81, 0xdd, 0x68, 0xc8, 0x04, 0x6e, 0x34, 0xac, 0xfa, 0x11, 0x77, 0x56, 0x6e, 0xad, 0xb4, 0x15,
+	0x38, 0x79, 0xe6, 0xd2, 0x4e, 0x9e, 0xf9, 0xd0, 0xc9, 0x53, 0x69, 0xfb, 0xfa, 0x14, 0x19, 0x81,
+	0x07, 0x2c, 0x73, 0xc1, 0xc7, 0x96, 0x0e, 0x51, 0x19, 0x35, 0x4b, 0x8e, 0xaa, 0x06, 0xa8, 0x94,
+	0xeb, 0x50, 0x0e, 0xbb, 0xc6, 0x80, 0x9f, 0xcb, 0xc4, 0xfc, 0xdc, 0x42, 0xc4, 0xc5, 0xbd, 0x13,
+	0x39, 0x4e, 0xa4, 0xeb, 0x38, 0x72, 0x98, 0xb8, 0x1f, 0x72, 0x76, 0x37, 0xe2, 0x64, 0xdf, 0x96,
+	0x9f, 0x5b, 0x11, 0xfb, 0xc1, 0x43, 0x42, 0xe9, 0xc5, 0xa4, 0x95, 0x4b, 0x50, 0xda, 0x4f, 0xfb,
+	0x82, 0x2f, 0x2f, 0xc8, 0x95, 0x3b, 0xb0, 0xf2, 0xd0, 0xec, 0x61, 0x72, 0x4c, 0x3c, 0xdc, 0x6f,
+	0x30, 0xa7, 0x74, 0x68, 0x62, 0x97, 0xde, 0xe9, 0x60, 0xa7, 0x69, 0xc7, 0x36, 0xfd, 0x0f, 0xbb,
+	0x02, 0x10, 0xe5, 0x3f, 0x33, 0xb0, 0x38, 0x24, 0xdc, 0x67, 0x59, 0x84, 0x37, 0xa0, 0x48, 0xe7,
+	0x4b, 0x3c, 0xbd, 0xef, 0xc8, 0xc2, 0xac, 0x0f, 0xa0, 0xa9, 0xe3, 0x43, 0x22, 0xb3, 0x97, 0x89,
+	0x95, 0xa0, 0x24, 0x41, 0xd4, 0xfc, 0x21, 0x69, 0xd0, 0x3b, 0xc3, 0x30, 0x20, 0xd8, 0x10, 0xc5,
+	0xd8, 0x5c, 0x5a, 0x0c, 0xb3, 0x1f, 0xbc, 0xa8, 0x41, 0x09, 0xf8, 0x45, 0xc1, 0xfb, 0x50, 0x32,
+	0x2d, 0xdb, 0xc0, 0xac, 0x78, 0x6e, 0x54, 0xf3, 0x93, 0x90, 0x03, 0xa7, 0xd8, 0x27, 0xd8, 0x50,
+	0x30, 0x2c, 0x87, 0xf4, 0x2b, 0x0c, 0xa5, 0x09, 0x4b, 0xdc, 0x69, 0x1d, 0xfa, 0x82, 0x4b, 0x8b,
+	0xbd, 0x38, 0x6a, 0x76, 0x4c, 0x5b, 0x6a, 0xc5, 0x14, 0x01, 0x97, 0x24, 0xa5, 0xb5, 0x8e, 0xd0,
+	0x71, 0x73, 0x8a, 0xf3, 0x9f, 0xd2, 0x8a, 0xe4, 0xec, 0x86, 0xe6, 0x2c, 0x32, 0x62, 0xd2, 0x9a,
+	0xc7, 0x65, 0xc4, 0x08, 0xcf, 0x88, 0x11, 0xe5, 0x53, 0x38, 0x13, 0x4a, 0x2e, 0x86, 0x24, 0xba,
+	0x1f, 0x89, 0x27, 0xaf, 0x8c, 0xe3, 0x1a, 0x09, 0x2c, 0xff, 0x3b, 0x03, 0x2b, 0x49, 0x08, 0x27,
+	0x4c, 0x7e, 0xff, 0x3c, 0xe5, 0x52, 0xf8, 0xdd, 0xc9, 0xc4, 0xfa, 0x9d, 0x14, 0x0e, 0xf6, 0xa0,
+	0x96, 0xa4, 0xcf, 0xf8, 0x2a, 0xe5, 0xa6, 0x59, 0xa5, 0x5f, 0xe4, 0x02, 0x45, 0xa0, 0xba, 0xe7,
+	0xb9, 0xe6, 0xc1, 0x80, 0x9a, 0xfc, 0x6b, 0x4f, 0xac, 0x36, 0xfc, 0x14, 0x21, 0x57, 0xed, 0xed,
+	0x11, 0xe4, 0x43, 0x39, 0x12, 0xd3, 0x84, 0x1f, 0x87, 0xd3, 0x84, 0xbc, 0xbc, 0x73, 0x67, 0x32,
+	0x7e, 0xdf, 0xdb, 0x5c, 0xfc, 0x2f, 0xb2, 0xb0, 0x10, 0x5e, 0x22, 0xb4, 0x03, 0xa0, 0xfb, 0x92,
+	0x57, 0x33, 0x63, 0x2b, 0x66, 0xc3, 0x69, 0xaa, 0x01, 0x42, 0xf4, 0x16, 0xe4, 0x3a, 0xce, 0x40,
+	0xac, 0x5a, 0x42, 0x12, 0x70, 0xcb, 0x19, 0x70, 0x8f, 0x42, 0xd1, 0xe8, 0x49, 0x4f, 0xdc, 0x3f,
+	0x4d, 0xf5, 0x92, 0xfc, 0x2e, 0x2a, 0xa7, 0x11, 0xc8, 0xe8, 0x31, 0x2c, 0xd0, 0x9b, 0xb0, 0xfa,
+	0x41, 0x0f, 0x6b, 0x3d, 0xfd, 0x18, 0xbb, 0xc2, 0x4b, 0x4e, 0xe0, 0xc8, 0xca, 0x92, 0xf0, 0x09,
+	0xa5, 0x53, 0xfe, 0x00, 0x0a, 0x52, 0xa2, 0x31, 0x3b, 0xc2, 0x1e, 0xac, 0x0d, 0x28, 0x9a, 0xc6,
+	0x2e, 0x70, 0x5b, 0xba, 0x65, 0x6b, 0x04, 0xd3, 0x6d, 0x5c, 0x7e, 0x5a, 0x36, 0xc6, 0x45, 0xaf,
+	0x30, 0xea, 0x2d, 0xdb, 0xc5, 0x4d, 0xdd, 0xb2, 0xdb, 0x9c, 0x54, 0x79, 0x01, 0xa5, 0xc0, 0x04,
+	0xc7, 0x88, 0xd0, 0x80, 0x25, 0x79, 0xa7, 0x84, 0x5e, 0x01, 0xe7, 0xdb, 0xcb, 0x44, 0x83, 0x2f,
+	0x0a, 0xba, 0x36, 0xf6, 0xf8, 0x3d, 0xa0, 0xfb, 0x70, 0x46, 0xc5, 0xb6, 0x83, 0x2d, 0x7f, 0x3d,
+	0x9f, 0xd8, 0xdd, 0x29, 0x3c, 0xf8, 0x1b, 0x50, 0x4b, 0xa2, 0x17, 0x91, 0xd9, 0x3d, 0x38, 0xdd,
+	0xd2, 0x07, 0x04, 0x9f, 0xb0, 0x0e, 0x1e, 0xa5, 0x15, 0x5c, 0xdf, 0x87, 0xb5, 0x7d, 0xcb, 0x39,
+	0x29, 0xdf, 0x1a, 0x54, 0xe3, 0xd4, 0x82, 0xf3, 0x1d, 0x19, 0x6a, 0x8b, 0x43, 0xb0, 0xe0, 0x7a,
+	0x01, 0x4a, 0xfc, 0x84, 0xad, 0x05, 0x4e, 0x61, 0xc0, 0x41, 0xf4, 0xe2, 0xa7, 0xb2, 0x0a, 0x2b,
+	0x61, 0x3a, 0xc1, 0xef, 0xbe, 0xa8, 0xe5, 0x9f, 0xf4, 0x2b, 0xcb, 0x33, 0xb0, 0x16, 0xa3, 0xe7,
+	0xac, 0x6f, 0x5c, 0x81, 0x82, 0xfc, 0xbf, 0x0c, 0x34, 0x07, 0xb9, 0xbd, 0xad, 0x56, 0xe5, 0x14,
+	0x7d, 0xd8, 0xdf, 0x6e, 0x55, 0x32, 0xa8, 0x00, 0xf9, 0xf6, 0xd6, 0x5e, 0xab, 0x92, 0xbd, 0xd1,
+	0x87, 0x4a, 0xf4, 0xcf, 0x22, 0xd0, 0x1a, 0x2c, 0xb7, 0xd4, 0xdd, 0x56, 0xfd, 0x51, 0x7d, 0xaf,
+	0xb1, 0xdb, 0xd4, 0x5a, 0x6a, 0xe3, 0xa3, 0xfa, 0xde, 0x4e, 0xe5, 0x14, 0xba, 0x08, 0xe7, 0x82,
+	0x1d, 0x8f, 0x77, 0xdb, 0x7b, 0xda, 0xde, 0xae, 0xb6, 0xb5, 0xdb, 0xdc, 0xab, 0x37, 0x9a, 0x3b,
+	0x6a, 0x25, 0x83, 0xce, 0xc1, 0x99, 0x20, 0xca, 0x83, 0xc6, 0x76, 0x43, 0xdd, 0xd9, 0xa2, 0xcf,
+	0xf5, 0x27, 0x95, 0xec, 0x8d, 0xdb, 0x50, 0x0e, 0xfd, 0x2b, 0x03, 0x15, 0xa9, 0xb5, 0xbb, 0x5d,
+	0x39, 0x85, 0xca, 0x50, 0x0c, 0xf2, 0x29, 0x40, 0xbe, 0xb9, 0xbb, 0xbd, 0x53, 0xc9, 0xde, 0x68,
+	0xc1, 0x62, 0xe4, 0x33, 0x1d, 0xb4, 0x04, 0xe5, 0x76, 0xbd, 0xb9, 0xfd, 0x60, 0xf7, 0x63, 0x4d,
+	0xdd, 0xa9, 0x6f, 0x7f, 0x52, 0x39, 0x85, 0x56, 0xa0, 0x22, 0x41, 0xcd, 0xdd, 0x3d, 0x0e, 0xcd,
+	0x44, 0xa0, 0x0f, 0x77, 0xf7, 0x9b, 0xdb, 0x15, 0xe3, 0xc6, 0x97, 0x99, 0x88, 0x57, 0xc3, 0xe8,
+	0x34, 0x2c, 0xf9, 0xa3, 0x6b, 0x5b, 0xea, 0x4e, 0x7d, 0x6f, 0x87, 0x0a, 0x15, 0x02, 0xab, 0xfb,
+	0xcd, 0x66, 0xa3, 0xf9, 0x88, 0xb3, 0x1d, 0x82, 0x77, 0x3e, 0x6e, 0x50, 0xe4, 0x6c, 0x18, 0x79,
+	0xbf, 0xf9, 0xd3, 0xe6, 0xee, 0xb3, 0x66, 0x25, 0x87, 0x96, 0x61, 0x71, 0x08, 0x6e, 0xd5, 0xf7,
+	0xdb, 0x3b, 0x95, 0xfc, 0xe6, 0xff, 0x2c, 0xc3, 0x82, 0x8c, 0xb7, 0xb1, 0xcb, 0x6e, 0xc9, 0xb5,
+	0x60, 0x4e, 0xfe, 0x57, 0x4b, 0xc2, 0x46, 0x19, 0xfe, 0x87, 0x99, 0xda, 0xc5, 0x11, 0x18, 0xc2,
+	0xb8, 0x4e, 0xa1, 0x03, 0x76, 0x0c, 0x19, 0x2a, 0x0f, 0x5d, 0x49, 0x0c, 0xfa, 0x63, 0xd6, 0x57,
+	0xbb, 0x3a, 0x16, 0xcf, 0x1f, 0x03, 0xc3, 0x42, 0xf8, 0x1b, 0x62, 0x74, 0x35, 0xe9, 0x88, 0x90,
+	0xf0, 0x91, 0x72, 0xed, 0xda, 0x78, 0x44, 0x7f, 0x98, 0xe7, 0x50, 0x89, 0x7e, 0x4f, 0x8c, 0x12,
+	0xca, 0x01, 0x29, 0x1f, 0x2d, 0xd7, 0x6e, 0x4c, 0x82, 0x1a, 0x1c, 0x2c, 0xf6, 0xe5, 0xed, 0xf5,
+	0x49, 0x3e, 0x65, 0x4c, 0x1d, 0x2c, 0xed, 0xab, 0x47, 0xae, 0xc0, 0xf0, 0xe7, 0x53, 0x28, 0xf1,
+	0x33, 0x57, 0xe2, 0x4d, 0xa4, 0xc0, 0xe4, 0x2f, 0xb1, 0x94, 0x53, 0xe8, 0x08, 0x16, 0x23, 0x17,
+	0x96, 0x50, 0x02, 0x79, 0xf2, 0xcd, 0xac, 0xda, 0xf5, 0x09, 0x30, 0xc3, 0x16, 0x11, 0xbc, 0xa0,
+	0x94, 0x6c, 0x11, 0x09, 0xd7, 0x9f, 0x6a, 0xd7, 0xc6, 0x23, 0x06, 0x8d, 0x3b, 0x74, 0x31, 0x29,
+	0xc9, 0xb8, 0x93, 0xae, 0x43, 0xd5, 0xae, 0x8e, 0xc5, 0x0b, 0x2a, 0x2d, 0x72, 0x4d, 0x29, 0x49,
+	0x69, 0xc9, 0xd7, 0xa0, 0x6a, 0xd7, 0x27, 0xc0, 0x8c, 0x5a, 0x81, 0xdf, 0x45, 0xd2, 0xac, 0x20,
+	0x76, 0x45, 0xa7, 0x76, 0x6d, 0x3c, 0x62, 0xc8, 0x0a, 0x22, 0x97, 0x15, 0xae, 0x4d, 0x50, 0x1e,
+	0x4c, 0xb7, 0x82, 0xe4, 0x42, 0xa2, 0x72, 0x0a, 0xfd, 0x61, 0x06, 0xaa, 0x69, 0x35, 0x2c, 0x74,
+	0x7b, 0xea, 0xe2, 0x5b, 0x6d, 0x73, 0x1a, 0x12, 0x5f, 0x8a, 0x2f, 0x00, 0xc5, 0xc3, 0x0f, 0xf4,
+	0x83, 0xa4, 0x95, 0x49, 0x09, 0x72, 0x6a, 0x6f, 0x4d, 0x86, 0x1c, 0x5c, 0xc9, 0x70, 0x5c, 0x92,
+	0xb4, 0x92, 0x89, 0x51, 0x4f, 0xed, 0xda, 0x78, 0xc4, 0xa0, 0x8f, 0x8a, 0x86, 0x29, 0x49, 0x3e,
+	0x2a, 0x25, 0x10, 0xaa, 0xdd, 0x98, 0x04, 0xd5, 0x1f, 0xac, 0x0d, 0x05, 0x59, 0x09, 0x44, 0x09,
+	0x3b, 0x4f, 0xa4, 0x0e, 0x59, 0x53, 0x46, 0xa1, 0xf8, 0x4c, 0x1f, 0x41, 0x9e, 0x42, 0xd1, 0xb9,
+	0x64, 0x6c, 0xc9, 0xec, 0x7c, 0x5a, 0xb7, 0xcf, 0xe8, 0x29, 0xcc, 0xf2, 0xd2, 0x17, 0x4a, 0x48,
+	0x6a, 0x85, 0x0a, 0x74, 0xb5, 0xf5, 0x74, 0x04, 0x9f, 0xdd, 0x67, 0x50, 0x0a, 0x54, 0xb5, 0xd0,
+	0xe5, 0xe4, 0x7f, 0x69, 0x09, 0x17, 0xd1, 0x6a, 0x6f, 0x8e, 0xc1, 0x0a, 0x9a, 0x47, 0xe4, 0x40,
+	0x75, 0x75, 0xec, 0xa9, 0x38, 0xdd, 0x3c, 0x92, 0xcf, 0xdd, 0xdc, 0xf0, 0xe3, 0xe7, 0xf2, 0x24,
+	0xc3, 0x4f, 0xcd, 0x86, 0xd4, 0xde, 0x9a, 0x0c, 0xd9, 0x1f, 0xd2, 0x83, 0xe5, 0x84, 0x2c, 0x2c,
+	0x7a, 0x2b, 0xed, 0xc5, 0x4d, 0x4a, 0x09, 0xd7, 0x6e, 0x4e, 0x88, 0x1d, 0x5c, 0x7c, 0xe1, 0xc8,
+	0x2e, 0xa4, 0xa7, 0x26, 0x53, 0x17, 0x3f, 0xe6, 0xb6, 0x8e, 0x60, 0x31, 0x12, 0x51, 0xa3, 0xb4,
+	0x4d, 0x29, 0xbe, 0x1f, 0x5f, 0x9f, 0x00, 0x53, 0x8e, 0xb4, 0xf9, 0xaf, 0x39, 0x98, 0xe7, 0xb9,
+	0x7c, 0x11, 0xff, 0x7d, 0x02, 0x30, 0x2c, 0xa3, 0xa1, 0x4b, 0xc9, 0xda, 0x0f, 0x15, 0x40, 0x6b,
+	0x97, 0x47, 0x23, 0x05, 0x4d, 0x3a, 0x50, 0x92, 0x42, 0x97, 0xc7, 0x54, 0xac, 0x52, 0x4d, 0x3a,
+	0xa1, 0xae, 0xa5, 0x9c, 0x42, 0x1f, 0x41, 0xd1, 0xaf, 0x7d, 0xa0, 0xa4, 0xda, 0x49, 0xa4, 0xb8,
+	0x53, 0xbb, 0x34, 0x12, 0x27, 0x28, 0x75, 0xa0, 0xb0, 0x91, 0x24, 0x75, 0xbc, 0x80, 0x52, 0x7b,
+	0x73, 0x0c, 0x56, 0x4c, 0x27, 0x3c, 0xfd, 0x99, 0xaa, 0x93, 0x50, 0xf6, 0xb9, 0xf6, 0xe6, 0x18,
+	0x2c, 0x7f, 0x75, 0x1d, 0x28, 0xf3, 0xb3, 0x9e, 0x5c, 0x5d, 0x0d, 0xe6, 0x83, 0x47, 0x40, 0x94,
+	0x2a, 0x67, 0xe8, 0x68, 0x59, 0xbb, 0x32, 0x0e, 0x4d, 0x8e, 0xf8, 0xe0, 0xca, 0x6f, 0xbe, 0x3a,
+	0x9f, 0xf9, 0xa7, 0xaf, 0xce, 0x9f, 0xfa, 0xf2, 0xeb, 0xf3, 0x99, 0xdf, 0x7c, 0x7d, 0x3e, 0xf3,
+	0x0f, 0x5f, 0x9f, 0xcf, 0xfc, 0xdb, 0xd7, 0xe7, 0x33, 0x7f, 0xfc, 0xef, 0xe7, 0x4f, 0xfd, 0xac,
+	0x20, 0xc9, 0x0f, 0x66, 0xd9, 0x9f, 0x27, 0xbe, 0xfd, 0xbf, 0x03, 0x00, 0x67, 0x35, 0x11, 0xc8,
+	0x02, 0x53, 0x00, 0x00,
 }
@@ -1,6 +1,7 @@
 package ocicni
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -11,7 +12,9 @@ import (
 	"github.com/alibaba/pouch/cri/config"
 	"github.com/alibaba/pouch/pkg/log"
 
+	"github.com/containernetworking/cni/libcni"
 	cnicurrent "github.com/containernetworking/cni/pkg/types/current"
+	cniversion "github.com/containernetworking/cni/pkg/version"
 	"github.com/cri-o/ocicni/pkg/ocicni"
 	"github.com/pkg/errors"
 )
@@ -25,6 +28,10 @@ type CniManager struct {
 	runtimeConfigFile string
 	// defaultRuntimeConfig is configuration specific to the default pod network interface.
 	defaultRuntimeConfig ocicni.RuntimeConfig
+	// networkPluginBinDir is the directory in which the binaries for the plugin is kept.
+	networkPluginBinDir string
+	// networkPluginConfDir is the directory in which the admin places a CNI conf.
+	networkPluginConfDir string
 }
 
 // NewCniManager initializes a brand new cni manager.
@@ -62,6 +69,8 @@ func NewCniManager(cfg *config.Config) (CniMgr, error) {
 		plugin:               plugin,
 		defaultRuntimeConfig: runtimeConfig,
 		runtimeConfigFile:    cfg.RuntimeConfigFile,
+		networkPluginBinDir:  networkPluginBinDir,
+		networkPluginConfDir: networkPluginConfDir,
 	}, nil
 }
 
@@ -151,6 +160,49 @@ func (c *CniManager) TearDownPodNetwork(podNetwork *ocicni.PodNetwork) error {
 	return errors.Wrapf(err, "failed to destroy network for sandbox %q", podNetwork.ID)
 }
 
+// CheckPodNetwork is the method called to check whether the network of
+// the pod sandbox is still as expected, via the CNI CHECK command.
+func (c *CniManager) CheckPodNetwork(podNetwork *ocicni.PodNetwork) error {
+	networks := podNetwork.Networks
+	if len(networks) == 0 {
+		networks = []string{c.GetDefaultNetworkName()}
+	}
+
+	cniConfig := libcni.NewCNIConfig([]string{c.networkPluginBinDir}, nil)
+	for i, name := range networks {
+		confList, err := libcni.LoadConfList(c.networkPluginConfDir, name)
+		if err != nil {
+			return fmt.Errorf("failed to load cni network %q: %v", name, err)
+		}
+
+		// CHECK was added in CNI spec version 0.4.0, nothing to do with the older ones.
+		if supported, err := cniversion.GreaterThanOrEqualTo(confList.CNIVersion, "0.4.0"); err != nil || !supported {
+			log.With(nil).Debugf("skip checking cni network %q of version %q", name, confList.CNIVersion)
+			continue
+		}
+
+		// keep the same runtime conf as the one used by ADD, so that the
+		// cached result of ADD can be found.
+		rt := &libcni.RuntimeConf{
+			ContainerID: podNetwork.ID,
+			NetNS:       podNetwork.NetNS,
+			IfName:      fmt.Sprintf("eth%d", i),
+			Args: [][2]string{
+				{"IgnoreUnknown", "1"},
+				{"K8S_POD_NAMESPACE", podNetwork.Namespace},
+				{"K8S_POD_NAME", podNetwork.Name},
+				{"K8S_POD_INFRA_CONTAINER_ID", podNetwork.ID},
+			},
+		}
+
+		if err := cniConfig.CheckNetworkList(context.Background(), confList, rt); err != nil {
+			return errors.Wrapf(err, "failed to check network %q for sandbox %q", name, podNetwork.ID)
+		}
+	}
+
+	return nil
+}
+
 // GetPodNetworkStatus is the method called to obtain the ipv4 or ipv6 addresses of the pod sandbox.
 func (c *CniManager) GetPodNetworkStatus(netnsPath string) (string, error) {
 	// TODO: we need more validation tests.
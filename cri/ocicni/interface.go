@@ -20,6 +20,10 @@ type CniMgr interface {
 	// TearDownPodNetwork is the method called before a pod's sandbox container will be deleted.
 	TearDownPodNetwork(podNetwork *ocicni.PodNetwork) error
 
+	// CheckPodNetwork is the method called to check whether the network of
+	// the pod sandbox is still as expected, via the CNI CHECK command.
+	CheckPodNetwork(podNetwork *ocicni.PodNetwork) error
+
 	// GetPodNetworkStatus is the method called to obtain the ipv4 or ipv6 addresses of the pod sandbox.
 	GetPodNetworkStatus(netnsPath string) (string, error)
 
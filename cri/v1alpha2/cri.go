@@ -1415,7 +1415,7 @@ func (c *CriManager) PullImage(ctx context.Context, r *runtime.PullImageRequest)
 		authConfig.RegistryToken = auth.GetRegistryToken()
 	}
 
-	if err := c.ImageMgr.PullImage(ctx, imageRef, authConfig, bytes.NewBuffer([]byte{})); err != nil {
+	if err := c.ImageMgr.PullImage(ctx, imageRef, "", authConfig, bytes.NewBuffer([]byte{})); err != nil {
 		return nil, err
 	}
 
@@ -191,6 +191,12 @@ func generateMountBindings(mounts []*runtime.Mount) []string {
 		case runtime.MountPropagation_PROPAGATION_HOST_TO_CONTAINER:
 			attrs = append(attrs, "rslave")
 		}
+		if m.Nocopy {
+			attrs = append(attrs, "nocopy")
+		}
+		if m.Subpath != "" {
+			attrs = append(attrs, "subpath="+m.Subpath)
+		}
 		if len(attrs) > 0 {
 			bind = fmt.Sprintf("%s:%s", bind, strings.Join(attrs, ","))
 		}
@@ -967,7 +973,7 @@ func (c *CriManager) ensureSandboxImageExists(ctx context.Context, imageRef stri
 		return nil
 	}
 	if errtypes.IsNotfound(err) {
-		err = c.ImageMgr.PullImage(ctx, imageRef, nil, bytes.NewBuffer([]byte{}))
+		err = c.ImageMgr.PullImage(ctx, imageRef, "", nil, bytes.NewBuffer([]byte{}))
 		if err != nil {
 			return fmt.Errorf("failed to pull sandbox image %q: %v", imageRef, err)
 		}
@@ -1727,6 +1727,21 @@ func Test_generateMountBindings(t *testing.T) {
 			},
 			want: []string{"host_path:container_path:Z,rslave"},
 		},
+		{
+			name: "nocopy_subpath test",
+			args: args{
+				mounts: []*runtime.Mount{
+					{
+						ContainerPath: "container_path",
+						HostPath:      "volume_name",
+						Readonly:      true,
+						Nocopy:        true,
+						Subpath:       "data/logs",
+					},
+				},
+			},
+			want: []string{"volume_name:container_path:ro,nocopy,subpath=data/logs"},
+		},
 		{
 			name: "no_attrs test",
 			args: args{
@@ -47,6 +47,15 @@ type Client struct {
 	// insecureRegistries stores the insecure registries
 	insecureRegistries []string
 
+	// registryConfigDir is the directory of registry hosts config
+	registryConfigDir string
+
+	// downloads limits the concurrent downloads of all pulls
+	downloads downloadLimiter
+
+	// downloadPolicy is the concurrency and retry policy of each pull
+	downloadPolicy downloadPolicy
+
 	// containerd grpc pool
 	pool      []scheduler.Factory
 	scheduler scheduler.Scheduler
@@ -72,6 +81,7 @@ func NewClient(opts ...ClientOpt) (APIClient, error) {
 		grpcClientPoolCapacity: defaultGrpcClientPoolCapacity,
 		maxStreamsClient:       defaultMaxStreamsClient,
 		insecureRegistries:     []string{},
+		maxDownloadAttempts:    defaultMaxDownloadAttempts,
 	}
 
 	for _, opt := range opts {
@@ -88,6 +98,13 @@ func NewClient(opts ...ClientOpt) (APIClient, error) {
 			containers: make(map[string]*containerPack),
 		},
 		insecureRegistries: copts.insecureRegistries,
+		registryConfigDir:  copts.registryConfigDir,
+		downloads:          newDownloadLimiter(copts.maxDownloads),
+		downloadPolicy: downloadPolicy{
+			maxDownloads: copts.maxDownloadsPerPull,
+			attempts:     copts.maxDownloadAttempts,
+			backoff:      defaultDownloadBackoff,
+		},
 	}
 
 	lease, err := client.preparePouchdLease(copts.rpcAddr, copts.defaultns)
@@ -13,6 +13,10 @@ type clientOpts struct {
 	maxStreamsClient       int
 	defaultns              string
 	insecureRegistries     []string
+	registryConfigDir      string
+	maxDownloads           int
+	maxDownloadsPerPull    int
+	maxDownloadAttempts    int
 }
 
 // ClientOpt allows caller to set options for containerd client.
@@ -87,6 +91,44 @@ func WithInsecureRegistries(endpoints []string) ClientOpt {
 	}
 }
 
+// WithRegistryConfigDir sets the directory of registry hosts config, which
+// is loaded on each pull and push.
+func WithRegistryConfigDir(dir string) ClientOpt {
+	return func(c *clientOpts) error {
+		c.registryConfigDir = dir
+		return nil
+	}
+}
+
+// WithMaxConcurrentDownloads sets the max number of layers downloaded at the
+// same time by all pulls and by each pull, zero means no limit.
+func WithMaxConcurrentDownloads(total, perPull int) ClientOpt {
+	return func(c *clientOpts) error {
+		if total < 0 || perPull < 0 {
+			return fmt.Errorf("max concurrent downloads should not be negative")
+		}
+
+		c.maxDownloads = total
+		c.maxDownloadsPerPull = perPull
+		return nil
+	}
+}
+
+// WithMaxDownloadAttempts sets the max number of attempts to download a
+// layer, zero means the default attempts.
+func WithMaxDownloadAttempts(attempts int) ClientOpt {
+	return func(c *clientOpts) error {
+		if attempts < 0 {
+			return fmt.Errorf("max download attempts should not be negative")
+		}
+
+		if attempts > 0 {
+			c.maxDownloadAttempts = attempts
+		}
+		return nil
+	}
+}
+
 func validateHostPort(s string) error {
 	_, port, err := net.SplitHostPort(s)
 	if err != nil {
@@ -582,7 +582,7 @@ func (c *Client) createContainer(ctx context.Context, ref, id, checkpointDir str
 	// if creating the container by specify rootfs, we no need use the image
 	if !container.RootFSProvided {
 		// get image
-		img, err := wrapperCli.client.GetImage(ctx, ref)
+		img, err := getPlatformImage(ctx, wrapperCli.client, ref)
 		if err != nil {
 			if errdefs.IsNotFound(err) {
 				return errors.Wrapf(errtypes.ErrNotfound, "image %s", ref)
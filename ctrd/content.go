@@ -0,0 +1,121 @@
+package ctrd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// WriteContent writes the data into the content store, the labels are
+// merged into the existing ones if the content has been stored.
+func (c *Client) WriteContent(ctx context.Context, desc ocispec.Descriptor, data []byte, labels map[string]string) error {
+	if err := c.writeContent(ctx, desc, data, labels); err != nil {
+		return convertCtrdErr(err)
+	}
+	return nil
+}
+
+func (c *Client) writeContent(ctx context.Context, desc ocispec.Descriptor, data []byte, labels map[string]string) error {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	cs := wrapperCli.client.ContentStore()
+	if _, err := cs.Info(ctx, desc.Digest); err == nil {
+		return c.updateContentLabels(ctx, desc.Digest, labels)
+	} else if !errdefs.IsNotFound(err) {
+		return err
+	}
+
+	ref := "pouch-content-" + desc.Digest.String()
+	return content.WriteBlob(ctx, cs, ref, bytes.NewReader(data), desc, content.WithLabels(labels))
+}
+
+// ReadContent returns the data and labels of content by digest.
+func (c *Client) ReadContent(ctx context.Context, dgst digest.Digest) ([]byte, map[string]string, error) {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	cs := wrapperCli.client.ContentStore()
+	info, err := cs.Info(ctx, dgst)
+	if err != nil {
+		return nil, nil, convertCtrdErr(err)
+	}
+
+	data, err := content.ReadBlob(ctx, cs, ocispec.Descriptor{Digest: dgst, Size: info.Size})
+	if err != nil {
+		return nil, nil, convertCtrdErr(err)
+	}
+	return data, info.Labels, nil
+}
+
+// ListContent returns the info of contents filtered by the given conditions.
+func (c *Client) ListContent(ctx context.Context, filters ...string) ([]content.Info, error) {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	var infos []content.Info
+	if err := wrapperCli.client.ContentStore().Walk(ctx, func(info content.Info) error {
+		infos = append(infos, info)
+		return nil
+	}, filters...); err != nil {
+		return nil, convertCtrdErr(err)
+	}
+	return infos, nil
+}
+
+// UpdateContentLabels sets the labels of content, the label is removed if
+// its value is empty.
+func (c *Client) UpdateContentLabels(ctx context.Context, dgst digest.Digest, labels map[string]string) error {
+	if err := c.updateContentLabels(ctx, dgst, labels); err != nil {
+		return convertCtrdErr(err)
+	}
+	return nil
+}
+
+func (c *Client) updateContentLabels(ctx context.Context, dgst digest.Digest, labels map[string]string) error {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	info := content.Info{
+		Digest: dgst,
+		Labels: labels,
+	}
+	fieldpaths := make([]string, 0, len(labels))
+	for k := range labels {
+		fieldpaths = append(fieldpaths, "labels."+k)
+	}
+
+	_, err = wrapperCli.client.ContentStore().Update(ctx, info, fieldpaths...)
+	return err
+}
+
+// PushManifestList pushes the manifest list stored in the content store and
+// the manifests it refers to registry.
+func (c *Client) PushManifestList(ctx context.Context, ref string, desc ocispec.Descriptor, authConfig *types.AuthConfig, out io.Writer) error {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	return c.push(ctx, wrapperCli, ref, desc, authConfig, out)
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/jsonstream"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/reference"
@@ -16,6 +17,7 @@ import (
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/errdefs"
 	ctrdmetaimages "github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/platforms"
 	"github.com/containerd/containerd/remotes"
 	"github.com/containerd/containerd/remotes/docker"
 	"github.com/opencontainers/go-digest"
@@ -42,6 +44,40 @@ func (c *Client) createImageReference(ctx context.Context, img ctrdmetaimages.Im
 	return wrapperCli.client.ImageService().Create(ctx, img)
 }
 
+// UpdateImageLabels sets the labels of image in the meta data in the containerd.
+func (c *Client) UpdateImageLabels(ctx context.Context, ref string, labels map[string]string) error {
+	if err := c.updateImageLabels(ctx, ref, labels); err != nil {
+		return convertCtrdErr(err)
+	}
+	return nil
+}
+
+// updateImageLabels sets the labels of image in the meta data in the containerd.
+func (c *Client) updateImageLabels(ctx context.Context, ref string, labels map[string]string) error {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	img, err := wrapperCli.client.ImageService().Get(ctx, ref)
+	if err != nil {
+		return err
+	}
+
+	if img.Labels == nil {
+		img.Labels = make(map[string]string)
+	}
+
+	fieldpaths := make([]string, 0, len(labels))
+	for k, v := range labels {
+		img.Labels[k] = v
+		fieldpaths = append(fieldpaths, "labels."+k)
+	}
+
+	_, err = wrapperCli.client.ImageService().Update(ctx, img, fieldpaths...)
+	return err
+}
+
 // GetImage returns the containerd's Image.
 func (c *Client) GetImage(ctx context.Context, ref string) (containerd.Image, error) {
 	img, err := c.getImage(ctx, ref)
@@ -58,7 +94,7 @@ func (c *Client) getImage(ctx context.Context, ref string) (containerd.Image, er
 		return nil, fmt.Errorf("failed to get a containerd grpc client: %v", err)
 	}
 
-	return wrapperCli.client.GetImage(ctx, ref)
+	return getPlatformImage(ctx, wrapperCli.client, ref)
 }
 
 // ListImages lists all images.
@@ -77,7 +113,7 @@ func (c *Client) listImages(ctx context.Context, filter ...string) ([]containerd
 		return nil, fmt.Errorf("failed to get a containerd grpc client: %v", err)
 	}
 
-	return wrapperCli.client.ListImages(ctx, filter...)
+	return listPlatformImages(ctx, wrapperCli.client, filter...)
 }
 
 // RemoveImage deletes an image.
@@ -174,7 +210,7 @@ func (c *Client) importImage(ctx context.Context, reader io.Reader, opts ...cont
 	)
 
 	for _, img := range imgs {
-		image := containerd.NewImage(wrapperCli.client, img)
+		image := newImage(wrapperCli.client, img)
 
 		err = image.Unpack(ctx, snaphotter)
 		if err != nil {
@@ -193,11 +229,17 @@ func (c *Client) PushImage(ctx context.Context, ref string, authConfig *types.Au
 		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
 	}
 
-	img, err := wrapperCli.client.GetImage(ctx, ref)
+	img, err := getPlatformImage(ctx, wrapperCli.client, ref)
 	if err != nil {
 		return convertCtrdErr(err)
 	}
 
+	return c.push(ctx, wrapperCli, ref, img.Target(), authConfig, out)
+}
+
+// push pushes the content of descriptor and its children to registry, the
+// progress is sent to client via out.
+func (c *Client) push(ctx context.Context, wrapperCli *WrapperClient, ref string, desc ocispec.Descriptor, authConfig *types.AuthConfig, out io.Writer) error {
 	pushTracker := docker.NewInMemoryTracker()
 
 	resolver, err := c.preparePushResolver(authConfig, ref, docker.ResolverOptions{
@@ -207,12 +249,17 @@ func (c *Client) PushImage(ctx context.Context, ref string, authConfig *types.Au
 		return err
 	}
 
-	ongoing := jsonstream.NewPushJobs(pushTracker)
+	ongoing := jsonstream.NewPushJobs(ref, pushTracker)
 	handler := ctrdmetaimages.HandlerFunc(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
-		ongoing.Add(remotes.MakeRefKey(ctx, desc))
+		ongoing.Add(remotes.MakeRefKey(ctx, desc), desc.Size)
 		return nil, nil
 	})
 
+	mounter, err := c.newMountResolver(resolver, wrapperCli.client.ContentStore(), ref, authConfig, pushTracker, ongoing)
+	if err != nil {
+		return err
+	}
+
 	// fetch progress status, then send to client via out channel.
 	stream := jsonstream.New(out, nil)
 	pctx, cancelProgress := context.WithCancel(ctx)
@@ -222,8 +269,9 @@ func (c *Client) PushImage(ctx context.Context, ref string, authConfig *types.Au
 		close(wait)
 	}()
 
-	err = wrapperCli.client.Push(ctx, ref, img.Target(),
-		containerd.WithResolver(resolver),
+	err = wrapperCli.client.Push(ctx, ref, desc,
+		containerd.WithResolver(mounter),
+		containerd.WithImageHandler(mounter.handler()),
 		containerd.WithImageHandler(handler))
 
 	cancelProgress()
@@ -246,7 +294,7 @@ func (c *Client) PushImage(ctx context.Context, ref string, authConfig *types.Au
 		return err
 	}
 
-	log.With(nil).Infof("push image %s successfully", ref)
+	log.With(nil).Infof("push %s successfully", ref)
 
 	return nil
 }
@@ -261,8 +309,21 @@ func (c *Client) ResolveImage(ctx context.Context, nameRef string, refs []string
 	return resolver, availableRef, nil
 }
 
+// FetchOptions contains the options of fetching image.
+type FetchOptions struct {
+	// Platform is the platform of image content to fetch, the content of
+	// host platform is fetched if it is empty.
+	Platform string
+}
+
 // FetchImage fetches image content from the remote repository.
 func (c *Client) FetchImage(ctx context.Context, resolver remotes.Resolver, availableRef string, authConfig *types.AuthConfig, stream *jsonstream.JSONStream) (containerd.Image, error) {
+	return c.FetchImageWithOptions(ctx, resolver, availableRef, authConfig, stream, FetchOptions{})
+}
+
+// FetchImageWithOptions fetches image content from the remote repository
+// with the options.
+func (c *Client) FetchImageWithOptions(ctx context.Context, resolver remotes.Resolver, availableRef string, authConfig *types.AuthConfig, stream *jsonstream.JSONStream, opts FetchOptions) (containerd.Image, error) {
 	wrapperCli, err := c.Get(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get a containerd grpc client: %v", err)
@@ -272,7 +333,21 @@ func (c *Client) FetchImage(ctx context.Context, resolver remotes.Resolver, avai
 
 	options := []containerd.RemoteOpt{
 		containerd.WithSchema1Conversion,
-		containerd.WithResolver(resolver),
+		containerd.WithResolver(c.newDownloadResolver(resolver, ongoing)),
+	}
+
+	// record the platform so that the content of it can be found later.
+	if opts.Platform != "" {
+		p, err := ParsePlatform(opts.Platform)
+		if err != nil {
+			return nil, errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+		}
+
+		specifier := platforms.Format(p)
+		options = append(options,
+			containerd.WithPlatform(specifier),
+			containerd.WithPullLabel(ImagePlatformLabel, specifier),
+		)
 	}
 
 	handle := func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
@@ -309,6 +384,12 @@ func (c *Client) FetchImage(ctx context.Context, resolver remotes.Resolver, avai
 		return nil, err
 	}
 
+	// record the source of content, so that the blobs can be mounted
+	// instead of uploaded when they are pushed into the same registry.
+	if err := c.labelDistributionSource(ctx, wrapperCli, availableRef, img.Target()); err != nil {
+		log.With(ctx).Warnf("failed to record the source of image %s: %v", availableRef, err)
+	}
+
 	log.With(nil).Infof("success to fetch image: %s", img.Name())
 	return img, nil
 }
@@ -357,9 +438,14 @@ outer:
 				}
 				// update status of active entries!
 				for _, active := range actives {
+					status := jsonstream.PullStatusDownloading
+					if s := ongoing.status(active.Ref); s != "" {
+						status = s
+					}
+
 					progresses[active.Ref] = jsonstream.JSONMessage{
 						ID:     active.Ref,
-						Status: jsonstream.PullStatusDownloading,
+						Status: status,
 						Detail: &jsonstream.ProgressDetail{
 							Current: active.Offset,
 							Total:   active.Total,
@@ -444,12 +530,17 @@ type jobs struct {
 	descs    []ocispec.Descriptor
 	mu       sync.Mutex
 	resolved bool
+
+	// statuses overrides the downloading status of active refs, such as
+	// retrying and resuming.
+	statuses map[string]string
 }
 
 func newJobs(name string) *jobs {
 	return &jobs{
-		name:  name,
-		added: map[digest.Digest]struct{}{},
+		name:     name,
+		added:    map[digest.Digest]struct{}{},
+		statuses: map[string]string{},
 	}
 }
 
@@ -473,6 +564,23 @@ func (j *jobs) jobs() []ocispec.Descriptor {
 	return append(descs, j.descs...)
 }
 
+func (j *jobs) setStatus(ref, status string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if status == "" {
+		delete(j.statuses, ref)
+		return
+	}
+	j.statuses[ref] = status
+}
+
+func (j *jobs) status(ref string) string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.statuses[ref]
+}
+
 func (j *jobs) isResolved() bool {
 	j.mu.Lock()
 	defer j.mu.Unlock()
@@ -20,7 +20,6 @@ import (
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/images"
 	"github.com/containerd/containerd/mount"
-	"github.com/containerd/containerd/platforms"
 	"github.com/containerd/containerd/snapshots"
 	digest "github.com/opencontainers/go-digest"
 	"github.com/opencontainers/image-spec/identity"
@@ -129,7 +128,7 @@ func (c *Client) Commit(ctx context.Context, config *CommitConfig) (_ digest.Dig
 	}
 
 	// get parent image layer descriptor
-	pmfst, err := images.Manifest(ctx, cs, config.CImage.Target(), platforms.Default())
+	pmfst, err := images.Manifest(ctx, cs, config.CImage.Target(), ImagePlatformMatcher(config.CImage.Labels()))
 	if err != nil {
 		return "", err
 	}
@@ -182,6 +181,11 @@ func (c *Client) Commit(ctx context.Context, config *CommitConfig) (_ digest.Dig
 		CreatedAt: time.Now(),
 	}
 
+	// the new image is built for the platform of parent image.
+	if platform, ok := config.CImage.Labels()[ImagePlatformLabel]; ok {
+		img.Labels = map[string]string{ImagePlatformLabel: platform}
+	}
+
 	// register containerd image metadata.
 	if _, err := client.ImageService().Update(ctx, img); err != nil {
 		if !errdefs.IsNotFound(err) {
@@ -307,11 +311,16 @@ func newChildImage(ctx context.Context, config *CommitConfig, diffID digest.Dige
 		EmptyLayer: emptyLayer,
 	}
 
-	// new child image
+	// new child image, which has the same platform as parent image
 	pImg := config.Image
+	arch, os := pImg.Architecture, pImg.OS
+	if arch == "" || os == "" {
+		arch, os = runtime.GOARCH, runtime.GOOS
+	}
+
 	return ocispec.Image{
-		Architecture: runtime.GOARCH,
-		OS:           runtime.GOOS,
+		Architecture: arch,
+		OS:           os,
 		Created:      &createdTime,
 		Author:       config.Author,
 		Config:       newImageConfig(config.ContainerConfig),
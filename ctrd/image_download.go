@@ -0,0 +1,328 @@
+package ctrd
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/jsonstream"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/remotes"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultMaxDownloadAttempts is the default max number of attempts to
+	// download a layer.
+	defaultMaxDownloadAttempts = 5
+
+	// defaultDownloadBackoff is the delay before the first retry, which is
+	// doubled on each retry.
+	defaultDownloadBackoff = time.Second
+
+	// maxDownloadBackoff is the max delay between retries.
+	maxDownloadBackoff = 30 * time.Second
+)
+
+// downloadLimiter limits the number of concurrent downloads, nil means no
+// limit.
+type downloadLimiter chan struct{}
+
+func newDownloadLimiter(n int) downloadLimiter {
+	if n <= 0 {
+		return nil
+	}
+	return make(downloadLimiter, n)
+}
+
+func (l downloadLimiter) acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	select {
+	case l <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l downloadLimiter) release() {
+	if l != nil {
+		<-l
+	}
+}
+
+// downloadPolicy is the concurrency and retry policy of one pull.
+type downloadPolicy struct {
+	// maxDownloads is the max number of concurrent downloads, zero means
+	// no limit.
+	maxDownloads int
+
+	// attempts is the max number of attempts to download a layer.
+	attempts int
+
+	// backoff is the delay before the first retry.
+	backoff time.Duration
+}
+
+// delay returns the exponential backoff before the nth retry.
+func (p downloadPolicy) delay(n int) time.Duration {
+	d := p.backoff
+	for i := 1; i < n && d < maxDownloadBackoff; i++ {
+		d *= 2
+	}
+	if d > maxDownloadBackoff {
+		d = maxDownloadBackoff
+	}
+	return d
+}
+
+// downloadResolver wraps the fetcher of resolver with the concurrency
+// limits and retry policy.
+type downloadResolver struct {
+	remotes.Resolver
+
+	limiters []downloadLimiter
+	policy   downloadPolicy
+	ongoing  *jobs
+}
+
+// newDownloadResolver returns the resolver used by one pull. The limiter of
+// the pull is acquired before the global one of client.
+func (c *Client) newDownloadResolver(resolver remotes.Resolver, ongoing *jobs) remotes.Resolver {
+	return &downloadResolver{
+		Resolver: resolver,
+		limiters: []downloadLimiter{newDownloadLimiter(c.downloadPolicy.maxDownloads), c.downloads},
+		policy:   c.downloadPolicy,
+		ongoing:  ongoing,
+	}
+}
+
+// Fetcher returns the fetcher with the concurrency limits and retry policy.
+func (r *downloadResolver) Fetcher(ctx context.Context, ref string) (remotes.Fetcher, error) {
+	f, err := r.Resolver.Fetcher(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return &downloadFetcher{
+		fetcher:  f,
+		limiters: r.limiters,
+		policy:   r.policy,
+		ongoing:  r.ongoing,
+	}, nil
+}
+
+type downloadFetcher struct {
+	fetcher  remotes.Fetcher
+	limiters []downloadLimiter
+	policy   downloadPolicy
+	ongoing  *jobs
+}
+
+// Fetch waits for the free download slots, and returns the reader which
+// retries and resumes from the read offset if the download fails. The slots
+// are released when the reader is closed.
+func (f *downloadFetcher) Fetch(ctx context.Context, desc ocispec.Descriptor) (io.ReadCloser, error) {
+	acquired := 0
+	release := func() {
+		for i := acquired - 1; i >= 0; i-- {
+			f.limiters[i].release()
+		}
+	}
+
+	for _, l := range f.limiters {
+		if err := l.acquire(ctx); err != nil {
+			release()
+			return nil, err
+		}
+		acquired++
+	}
+
+	r := &retryReader{
+		ctx:     ctx,
+		desc:    desc,
+		ref:     remotes.MakeRefKey(ctx, desc),
+		fetcher: f.fetcher,
+		policy:  f.policy,
+		ongoing: f.ongoing,
+		release: release,
+	}
+
+	if err := r.open(); err != nil {
+		r.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// retryReader reads the content of descriptor, and reopens it from the
+// read offset with exponential backoff if the read fails.
+type retryReader struct {
+	ctx     context.Context
+	desc    ocispec.Descriptor
+	ref     string
+	fetcher remotes.Fetcher
+	policy  downloadPolicy
+	ongoing *jobs
+
+	rc       io.ReadCloser
+	offset   int64
+	failures int
+
+	once    sync.Once
+	release func()
+}
+
+// open fetches the content and seeks to the read offset, the failures are
+// retried in the same way as Read.
+func (r *retryReader) open() error {
+	for {
+		err := r.reopen()
+		if err == nil {
+			return nil
+		}
+
+		if rerr := r.retry(err); rerr != nil {
+			return rerr
+		}
+	}
+}
+
+func (r *retryReader) reopen() error {
+	if r.offset > 0 {
+		r.ongoing.setStatus(r.ref, jsonstream.PullStatusResuming)
+	}
+
+	rc, err := r.fetcher.Fetch(r.ctx, r.desc)
+	if err != nil {
+		return err
+	}
+
+	if r.offset > 0 {
+		if err := seekTo(rc, r.offset); err != nil {
+			rc.Close()
+			return err
+		}
+	}
+	r.rc = rc
+	return nil
+}
+
+// retry waits for the backoff if the error can be retried, or returns the
+// error if the attempts are used up.
+func (r *retryReader) retry(err error) error {
+	r.failures++
+	if r.failures >= r.policy.attempts || errdefs.IsNotFound(err) || r.ctx.Err() != nil {
+		return err
+	}
+
+	delay := r.policy.delay(r.failures)
+	log.With(r.ctx).Warnf("failed to download %s at offset %d, retry in %s (%d/%d): %v",
+		r.desc.Digest, r.offset, delay, r.failures, r.policy.attempts-1, err)
+	r.ongoing.setStatus(r.ref, jsonstream.PullStatusRetrying)
+
+	select {
+	case <-time.After(delay):
+		return nil
+	case <-r.ctx.Done():
+		return r.ctx.Err()
+	}
+}
+
+// Read reads the content, the failures are reset once there is progress.
+func (r *retryReader) Read(p []byte) (int, error) {
+	for {
+		if r.rc == nil {
+			if err := r.open(); err != nil {
+				return 0, err
+			}
+		}
+
+		n, err := r.rc.Read(p)
+		r.offset += int64(n)
+		if n > 0 {
+			r.failures = 0
+			r.ongoing.setStatus(r.ref, "")
+		}
+
+		if err == nil || err == io.EOF {
+			return n, err
+		}
+
+		r.rc.Close()
+		r.rc = nil
+
+		if rerr := r.retry(err); rerr != nil {
+			return n, rerr
+		}
+
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+// Seek sets the read offset, which is used to resume the partial content in
+// the content store.
+func (r *retryReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	default:
+		return 0, errors.Wrap(errdefs.ErrInvalidArgument, "only seek from start or current offset")
+	}
+
+	if offset < 0 {
+		return 0, errors.Wrap(errdefs.ErrInvalidArgument, "negative offset")
+	}
+
+	if offset != r.offset {
+		if r.rc != nil {
+			r.rc.Close()
+			r.rc = nil
+		}
+		r.offset = offset
+		log.With(r.ctx).Infof("resume to download %s from offset %d", r.desc.Digest, offset)
+	}
+	return r.offset, nil
+}
+
+// Close closes the reader and releases the download slots.
+func (r *retryReader) Close() error {
+	var err error
+	if r.rc != nil {
+		err = r.rc.Close()
+		r.rc = nil
+	}
+
+	r.ongoing.setStatus(r.ref, "")
+	r.once.Do(r.release)
+	return err
+}
+
+// seekTo seeks the reader to offset, or discards the content before offset
+// if the reader is not a seeker.
+func seekTo(rc io.Reader, offset int64) error {
+	if seeker, ok := rc.(io.Seeker); ok {
+		_, err := seeker.Seek(offset, io.SeekStart)
+		return err
+	}
+
+	n, err := io.CopyN(ioutil.Discard, rc, offset)
+	if err != nil {
+		return errors.Wrap(err, "failed to discard to offset")
+	}
+	if n != offset {
+		return errors.Errorf("unable to discard to offset %d", offset)
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+package ctrd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+// flakyRegistry is a registry stand-in which serves one blob, and breaks
+// the connection in the middle of the first failures responses, or before
+// any content is sent if noProgress is set.
+type flakyRegistry struct {
+	sync.Mutex
+
+	blob       []byte
+	failures   int
+	noProgress bool
+	ranges     []string
+}
+
+func (f *flakyRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/v2/library/busybox/blobs/"+digest.FromBytes(f.blob).String() {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	f.Lock()
+	fail := f.failures > 0
+	if fail {
+		f.failures--
+	}
+	f.ranges = append(f.ranges, r.Header.Get("Range"))
+	f.Unlock()
+
+	offset := 0
+	if rg := r.Header.Get("Range"); rg != "" {
+		offset, _ = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(rg, "bytes="), "-"))
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, len(f.blob)-1, len(f.blob)))
+	}
+
+	data := f.blob[offset:]
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	if offset > 0 {
+		w.WriteHeader(http.StatusPartialContent)
+	}
+
+	if fail {
+		if f.noProgress {
+			data = data[:0]
+		}
+		w.Write(data[:len(data)/2])
+		w.(http.Flusher).Flush()
+		panic(http.ErrAbortHandler)
+	}
+	w.Write(data)
+}
+
+func newTestDownloadFetcher(t *testing.T, srv *httptest.Server, c *Client) *downloadFetcher {
+	host := strings.TrimPrefix(srv.URL, "http://")
+	resolver := docker.NewResolver(docker.ResolverOptions{
+		Host: func(string) (string, error) {
+			return host, nil
+		},
+		PlainHTTP: true,
+	})
+
+	ongoing := newJobs("reg.example.com/library/busybox:latest")
+	f, err := c.newDownloadResolver(resolver, ongoing).Fetcher(context.Background(), "reg.example.com/library/busybox:latest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f.(*downloadFetcher)
+}
+
+func TestDownloadRetryAndResume(t *testing.T) {
+	reg := &flakyRegistry{
+		blob:     []byte(strings.Repeat("pouch", 10000)),
+		failures: 2,
+	}
+	srv := httptest.NewServer(reg)
+	defer srv.Close()
+
+	c := &Client{
+		downloadPolicy: downloadPolicy{
+			attempts: 3,
+			backoff:  time.Millisecond,
+		},
+	}
+	f := newTestDownloadFetcher(t, srv, c)
+
+	desc := ocispec.Descriptor{
+		MediaType: ocispec.MediaTypeImageLayer,
+		Digest:    digest.FromBytes(reg.blob),
+		Size:      int64(len(reg.blob)),
+	}
+
+	rc, err := f.Fetch(context.Background(), desc)
+	assert.NoError(t, err)
+	data, err := ioutil.ReadAll(rc)
+	assert.NoError(t, err)
+	assert.NoError(t, rc.Close())
+	assert.Equal(t, reg.blob, data)
+
+	// the retries resume from the read offset.
+	assert.Len(t, reg.ranges, 3)
+	assert.Equal(t, "", reg.ranges[0])
+	assert.NotEqual(t, "", reg.ranges[1])
+	assert.NotEqual(t, "", reg.ranges[2])
+
+	// the attempts are used up if there is no progress.
+	reg.failures, reg.noProgress, reg.ranges = 3, true, nil
+	rc, err = f.Fetch(context.Background(), desc)
+	assert.NoError(t, err)
+	_, err = ioutil.ReadAll(rc)
+	assert.Error(t, err)
+	assert.NoError(t, rc.Close())
+	assert.Len(t, reg.ranges, 3)
+}
+
+func TestDownloadResumeFromOffset(t *testing.T) {
+	reg := &flakyRegistry{
+		blob: []byte(strings.Repeat("pouch", 100)),
+	}
+	srv := httptest.NewServer(reg)
+	defer srv.Close()
+
+	f := newTestDownloadFetcher(t, srv, &Client{})
+
+	desc := ocispec.Descriptor{
+		MediaType: ocispec.MediaTypeImageLayer,
+		Digest:    digest.FromBytes(reg.blob),
+		Size:      int64(len(reg.blob)),
+	}
+
+	rc, err := f.Fetch(context.Background(), desc)
+	assert.NoError(t, err)
+
+	// the partial content in content store is resumed by seek.
+	offset, err := rc.(*retryReader).Seek(100, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), offset)
+
+	data, err := ioutil.ReadAll(rc)
+	assert.NoError(t, err)
+	assert.NoError(t, rc.Close())
+	assert.Equal(t, reg.blob[100:], data)
+	assert.Equal(t, []string{"bytes=100-"}, reg.ranges)
+}
+
+func TestDownloadConcurrencyLimit(t *testing.T) {
+	c := &Client{
+		downloads: newDownloadLimiter(2),
+		downloadPolicy: downloadPolicy{
+			maxDownloads: 1,
+		},
+	}
+
+	reg := &flakyRegistry{
+		blob: []byte("pouch"),
+	}
+	srv := httptest.NewServer(reg)
+	defer srv.Close()
+
+	desc := ocispec.Descriptor{
+		MediaType: ocispec.MediaTypeImageLayer,
+		Digest:    digest.FromBytes(reg.blob),
+		Size:      int64(len(reg.blob)),
+	}
+
+	// one download of each pull.
+	f1 := newTestDownloadFetcher(t, srv, c)
+	rc1, err := f1.Fetch(context.Background(), desc)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = f1.Fetch(ctx, desc)
+	assert.Error(t, err)
+
+	// two downloads of all pulls.
+	f2 := newTestDownloadFetcher(t, srv, c)
+	rc2, err := f2.Fetch(context.Background(), desc)
+	assert.NoError(t, err)
+
+	f3 := newTestDownloadFetcher(t, srv, c)
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = f3.Fetch(ctx, desc)
+	assert.Error(t, err)
+
+	// the slots are released on close.
+	assert.NoError(t, rc1.Close())
+	rc3, err := f3.Fetch(context.Background(), desc)
+	assert.NoError(t, err)
+	assert.NoError(t, rc2.Close())
+	assert.NoError(t, rc3.Close())
+}
+
+func TestDownloadPolicyDelay(t *testing.T) {
+	p := downloadPolicy{backoff: time.Second}
+	assert.Equal(t, time.Second, p.delay(1))
+	assert.Equal(t, 2*time.Second, p.delay(2))
+	assert.Equal(t, 8*time.Second, p.delay(4))
+	assert.Equal(t, maxDownloadBackoff, p.delay(10))
+}
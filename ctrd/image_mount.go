@@ -0,0 +1,211 @@
+package ctrd
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/jsonstream"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/reference"
+	"github.com/alibaba/pouch/registry"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	ctrdmetaimages "github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// LabelDistributionSource is the prefix of the content label which records
+// the repository the content comes from, the suffix is the registry.
+const LabelDistributionSource = "containerd.io/distribution.source."
+
+// mountResolver wraps the pusher of resolver to mount the blobs, which exist
+// in other repositories of the same registry, instead of uploading them.
+type mountResolver struct {
+	remotes.Resolver
+
+	store    content.Store
+	registry *registry.Client
+	auth     *types.AuthConfig
+	tracker  docker.StatusTracker
+	ongoing  *jsonstream.PushJobs
+
+	// host and repo are the target of push.
+	host string
+	repo string
+
+	mu sync.Mutex
+	// sources are the repositories of blobs on the target registry.
+	sources map[digest.Digest]string
+}
+
+// newMountResolver returns the resolver used by one push toward ref.
+func (c *Client) newMountResolver(resolver remotes.Resolver, store content.Store, ref string, authConfig *types.AuthConfig, tracker docker.StatusTracker, ongoing *jsonstream.PushJobs) (*mountResolver, error) {
+	named, err := reference.Parse(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	host, repo, err := registry.SplitName(named.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	return &mountResolver{
+		Resolver: resolver,
+		store:    store,
+		registry: registry.NewClient(c.registryConfigDir, c.insecureRegistries),
+		auth:     authConfig,
+		tracker:  tracker,
+		ongoing:  ongoing,
+		host:     host,
+		repo:     repo,
+		sources:  make(map[digest.Digest]string),
+	}, nil
+}
+
+// handler records the sources of blobs before they are pushed. The source
+// is read from the labels of content, or inherited from the manifest list or
+// manifest which refers to the content, since the children may not be stored
+// locally or labeled.
+func (r *mountResolver) handler() ctrdmetaimages.HandlerFunc {
+	return func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		source, ok := r.source(desc.Digest)
+		if !ok {
+			info, err := r.store.Info(ctx, desc.Digest)
+			if err != nil {
+				return nil, nil
+			}
+			if source, ok = info.Labels[LabelDistributionSource+r.host]; !ok {
+				return nil, nil
+			}
+			r.setSource(desc.Digest, source)
+		}
+
+		switch desc.MediaType {
+		case ctrdmetaimages.MediaTypeDockerSchema2Manifest, ocispec.MediaTypeImageManifest:
+			data, err := content.ReadBlob(ctx, r.store, desc)
+			if err != nil {
+				return nil, nil
+			}
+
+			var manifest ocispec.Manifest
+			if err := json.Unmarshal(data, &manifest); err != nil {
+				return nil, errors.Wrapf(err, "failed to decode manifest %s", desc.Digest)
+			}
+
+			r.setSource(manifest.Config.Digest, source)
+			for _, l := range manifest.Layers {
+				r.setSource(l.Digest, source)
+			}
+		case ctrdmetaimages.MediaTypeDockerSchema2ManifestList, ocispec.MediaTypeImageIndex:
+			data, err := content.ReadBlob(ctx, r.store, desc)
+			if err != nil {
+				return nil, nil
+			}
+
+			var index ocispec.Index
+			if err := json.Unmarshal(data, &index); err != nil {
+				return nil, errors.Wrapf(err, "failed to decode manifest list %s", desc.Digest)
+			}
+
+			for _, m := range index.Manifests {
+				r.setSource(m.Digest, source)
+			}
+		}
+		return nil, nil
+	}
+}
+
+func (r *mountResolver) source(dgst digest.Digest) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	source, ok := r.sources[dgst]
+	return source, ok
+}
+
+func (r *mountResolver) setSource(dgst digest.Digest, source string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.sources[dgst]; !ok {
+		r.sources[dgst] = source
+	}
+}
+
+// Pusher returns the pusher which mounts the blobs from their sources.
+func (r *mountResolver) Pusher(ctx context.Context, ref string) (remotes.Pusher, error) {
+	p, err := r.Resolver.Pusher(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return &mountPusher{Pusher: p, resolver: r}, nil
+}
+
+type mountPusher struct {
+	remotes.Pusher
+	resolver *mountResolver
+}
+
+// Push mounts the blob if it exists in another repository of the target
+// registry, otherwise it falls back to upload.
+func (p *mountPusher) Push(ctx context.Context, desc ocispec.Descriptor) (content.Writer, error) {
+	r := p.resolver
+
+	switch desc.MediaType {
+	case ctrdmetaimages.MediaTypeDockerSchema2Manifest, ctrdmetaimages.MediaTypeDockerSchema2ManifestList,
+		ocispec.MediaTypeImageManifest, ocispec.MediaTypeImageIndex:
+		return p.Pusher.Push(ctx, desc)
+	}
+
+	source, ok := r.source(desc.Digest)
+	if !ok || source == r.repo {
+		return p.Pusher.Push(ctx, desc)
+	}
+
+	mounted, err := r.registry.MountBlob(ctx, r.host, r.repo, source, desc.Digest, r.auth)
+	if err != nil {
+		log.With(ctx).Warnf("failed to mount blob %s from %s: %v", desc.Digest, source, err)
+	}
+	if !mounted {
+		return p.Pusher.Push(ctx, desc)
+	}
+
+	ref := remotes.MakeRefKey(ctx, desc)
+	r.ongoing.Mounted(ref, source)
+	r.tracker.SetStatus(ref, docker.Status{
+		Status: content.Status{
+			Ref: ref,
+		},
+	})
+	return nil, errors.Wrapf(errdefs.ErrAlreadyExists, "blob %s mounted from %s", desc.Digest, source)
+}
+
+// labelDistributionSource labels the content pulled from ref with its
+// repository. Only the target is labeled, and the source of children is
+// inherited when the image is pushed.
+func (c *Client) labelDistributionSource(ctx context.Context, wrapperCli *WrapperClient, ref string, desc ocispec.Descriptor) error {
+	named, err := reference.Parse(ref)
+	if err != nil {
+		return err
+	}
+
+	host, repo, err := registry.SplitName(named.Name())
+	if err != nil {
+		return err
+	}
+
+	key := LabelDistributionSource + host
+	_, err = wrapperCli.client.ContentStore().Update(ctx, content.Info{
+		Digest: desc.Digest,
+		Labels: map[string]string{key: repo},
+	}, "labels."+key)
+	return err
+}
@@ -0,0 +1,99 @@
+package ctrd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/pkg/jsonstream"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	ctrdmetaimages "github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordPusher records the descriptors uploaded by it.
+type recordPusher struct {
+	pushed []digest.Digest
+}
+
+func (p *recordPusher) Push(ctx context.Context, desc ocispec.Descriptor) (content.Writer, error) {
+	p.pushed = append(p.pushed, desc.Digest)
+	return nil, errdefs.ErrAlreadyExists
+}
+
+type recordResolver struct {
+	remotes.Resolver
+	pusher *recordPusher
+}
+
+func (r *recordResolver) Pusher(ctx context.Context, ref string) (remotes.Pusher, error) {
+	return r.pusher, nil
+}
+
+func TestMountPusher(t *testing.T) {
+	mountable := digest.FromString("mountable")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPost && req.URL.Path == "/v2/app/blobs/uploads/" &&
+			req.URL.Query().Get("mount") == mountable.String() && req.URL.Query().Get("from") == "base" {
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	host := strings.TrimPrefix(srv.URL, "http://")
+	c := &Client{insecureRegistries: []string{host}}
+
+	var (
+		pusher  = &recordPusher{}
+		tracker = docker.NewInMemoryTracker()
+		ongoing = jsonstream.NewPushJobs(host+"/app:latest", tracker)
+		ctx     = context.Background()
+	)
+	r, err := c.newMountResolver(&recordResolver{pusher: pusher}, nil, host+"/app:latest", nil, tracker, ongoing)
+	assert.NoError(t, err)
+
+	var (
+		missing  = digest.FromString("missing")
+		local    = digest.FromString("local")
+		manifest = digest.FromString("manifest")
+	)
+	r.setSource(mountable, "base")
+	r.setSource(missing, "base")
+	r.setSource(local, "app")
+	r.setSource(manifest, "base")
+
+	p, err := r.Pusher(ctx, host+"/app:latest")
+	assert.NoError(t, err)
+
+	// the mounted blob is done without upload.
+	desc := ocispec.Descriptor{MediaType: ocispec.MediaTypeImageLayerGzip, Digest: mountable}
+	_, err = p.Push(ctx, desc)
+	assert.True(t, errdefs.IsAlreadyExists(err))
+
+	ongoing.Add(remotes.MakeRefKey(ctx, desc), desc.Size)
+	statuses := ongoing.Status()
+	assert.Len(t, statuses, 2)
+	assert.Equal(t, jsonstream.PushStatusMounted, statuses[1].Status)
+
+	// the others fall back to upload.
+	for _, desc := range []ocispec.Descriptor{
+		{MediaType: ocispec.MediaTypeImageLayerGzip, Digest: missing},
+		{MediaType: ocispec.MediaTypeImageLayerGzip, Digest: local},
+		{MediaType: ocispec.MediaTypeImageLayerGzip, Digest: digest.FromString("unknown")},
+		{MediaType: ctrdmetaimages.MediaTypeDockerSchema2Manifest, Digest: manifest},
+	} {
+		p.Push(ctx, desc)
+	}
+	assert.Equal(t, []digest.Digest{missing, local, digest.FromString("unknown"), manifest}, pusher.pushed)
+}
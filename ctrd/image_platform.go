@@ -0,0 +1,79 @@
+package ctrd
+
+import (
+	"context"
+
+	"github.com/containerd/containerd"
+	ctrdmetaimages "github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/platforms"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// ImagePlatformLabel records the platform of image which is pulled with the
+// specified platform. It is used to select the manifest of image from the
+// manifest list, which only contains the content of that platform locally.
+const ImagePlatformLabel = "io.alibaba.pouch.image.platform"
+
+// ParsePlatform parses the platform specifier, such as linux/arm64, and
+// normalizes it. The empty specifier means the platform of host.
+func ParsePlatform(specifier string) (ocispec.Platform, error) {
+	if specifier == "" {
+		return platforms.Normalize(platforms.DefaultSpec()), nil
+	}
+
+	p, err := platforms.Parse(specifier)
+	if err != nil {
+		return ocispec.Platform{}, errors.Wrapf(err, "invalid platform %s", specifier)
+	}
+	return platforms.Normalize(p), nil
+}
+
+// ImagePlatform returns the platform recorded in the labels of image, the
+// platform of host is returned if there is no platform recorded.
+func ImagePlatform(labels map[string]string) (ocispec.Platform, bool) {
+	if specifier, ok := labels[ImagePlatformLabel]; ok {
+		if p, err := ParsePlatform(specifier); err == nil {
+			return p, true
+		}
+	}
+	return platforms.Normalize(platforms.DefaultSpec()), false
+}
+
+// ImagePlatformMatcher returns the matcher used to select the manifest of
+// image with the labels.
+func ImagePlatformMatcher(labels map[string]string) platforms.MatchComparer {
+	if p, ok := ImagePlatform(labels); ok {
+		return platforms.Only(p)
+	}
+	return platforms.Default()
+}
+
+// newImage wraps the image meta data into containerd.Image, which uses the
+// platform recorded in labels to resolve the content.
+func newImage(client *containerd.Client, img ctrdmetaimages.Image) containerd.Image {
+	return containerd.NewImageWithPlatform(client, img, ImagePlatformMatcher(img.Labels))
+}
+
+// getPlatformImage returns the image with the platform recorded in labels.
+func getPlatformImage(ctx context.Context, client *containerd.Client, ref string) (containerd.Image, error) {
+	img, err := client.ImageService().Get(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return newImage(client, img), nil
+}
+
+// listPlatformImages returns the images with the platforms recorded in labels.
+func listPlatformImages(ctx context.Context, client *containerd.Client, filter ...string) ([]containerd.Image, error) {
+	imgs, err := client.ImageService().List(ctx, filter...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]containerd.Image, 0, len(imgs))
+	for _, img := range imgs {
+		res = append(res, newImage(client, img))
+	}
+	return res, nil
+}
@@ -11,12 +11,14 @@ import (
 
 	"github.com/containerd/containerd"
 	containerdtypes "github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/content"
 	ctrdmetaimages "github.com/containerd/containerd/images"
 	"github.com/containerd/containerd/mount"
 	"github.com/containerd/containerd/remotes"
 	"github.com/containerd/containerd/remotes/docker"
 	"github.com/containerd/containerd/snapshots"
 	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 )
 
 // APIClient defines common methods of containerd api client
@@ -75,18 +77,32 @@ type ContainerAPIClient interface {
 type ImageAPIClient interface {
 	// CreateImageReference creates the image data into meta data in the containerd.
 	CreateImageReference(ctx context.Context, img ctrdmetaimages.Image) (ctrdmetaimages.Image, error)
+	// UpdateImageLabels sets the labels of image by the given reference.
+	UpdateImageLabels(ctx context.Context, ref string, labels map[string]string) error
 	// GetImage returns containerd.Image by the given reference.
 	GetImage(ctx context.Context, ref string) (containerd.Image, error)
 	// ListImages returns the list of containerd.Image filtered by the given conditions.
 	ListImages(ctx context.Context, filter ...string) ([]containerd.Image, error)
 	// FetchImage fetches image content by the given reference.
 	FetchImage(ctx context.Context, resolver remotes.Resolver, ref string, authConfig *types.AuthConfig, stream *jsonstream.JSONStream) (containerd.Image, error)
+	// FetchImageWithOptions fetches image content by the given reference and options.
+	FetchImageWithOptions(ctx context.Context, resolver remotes.Resolver, ref string, authConfig *types.AuthConfig, stream *jsonstream.JSONStream, opts FetchOptions) (containerd.Image, error)
 	// ResolveImage attempts to resolve the image reference into a available reference and resolver.
 	ResolveImage(ctx context.Context, nameRef string, refs []string, authConfig *types.AuthConfig, opts docker.ResolverOptions) (remotes.Resolver, string, error)
 	// RemoveImage removes the image by the given reference.
 	RemoveImage(ctx context.Context, ref string) error
 	// ImportImage creates a set of images by tarstream.
 	ImportImage(ctx context.Context, reader io.Reader, opts ...containerd.ImportOpt) ([]containerd.Image, error)
+	// WriteContent writes the data with labels into the content store.
+	WriteContent(ctx context.Context, desc ocispec.Descriptor, data []byte, labels map[string]string) error
+	// ReadContent returns the data and labels of content by digest.
+	ReadContent(ctx context.Context, dgst digest.Digest) ([]byte, map[string]string, error)
+	// ListContent returns the info of contents filtered by the given conditions.
+	ListContent(ctx context.Context, filters ...string) ([]content.Info, error)
+	// UpdateContentLabels sets the labels of content, the empty ones are removed.
+	UpdateContentLabels(ctx context.Context, dgst digest.Digest, labels map[string]string) error
+	// PushManifestList pushes the manifest list in the content store to registry.
+	PushManifestList(ctx context.Context, ref string, desc ocispec.Descriptor, authConfig *types.AuthConfig, out io.Writer) error
 	// SaveImage saves image to tarstream
 	SaveImage(ctx context.Context, exporter ctrdmetaimages.Exporter, ref string) (io.ReadCloser, error)
 	// Commit commits an image from a container.
@@ -112,6 +128,13 @@ type SnapshotAPIClient interface {
 	// WalkSnapshot walk all snapshots in specific snapshotter. If not set specific snapshotter,
 	// it will be set to current snapshotter. For each snapshot, the function will be called.
 	WalkSnapshot(ctx context.Context, snapshotter string, fn func(context.Context, snapshots.Info) error) error
+	// ListSnapshotters returns the snapshotter plugins of containerd.
+	ListSnapshotters(ctx context.Context) ([]SnapshotterInfo, error)
+	// GetSnapshotterUsage returns the usage of all snapshots in the snapshotter.
+	GetSnapshotterUsage(ctx context.Context, snapshotter string) (SnapshotterUsage, error)
+	// MigrateSnapshot moves the active snapshot identified by id from snapshotter
+	// to another one.
+	MigrateSnapshot(ctx context.Context, id, from, to string) error
 	// CreateCheckpoint creates a checkpoint from a running container
 	CreateCheckpoint(ctx context.Context, id string, checkpointDir string, exit bool) error
 }
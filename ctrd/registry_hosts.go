@@ -0,0 +1,190 @@
+package ctrd
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/registry/hosts"
+
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// registryHosts returns the hosts to access the registry of reference. The
+// hosts config is loaded each time, so that it can be changed without
+// restarting daemon. The registry itself is the only host if there is no
+// config for it, and it is accessed by http if it is insecure.
+func (c *Client) registryHosts(ref string, authConfig *types.AuthConfig) ([]hosts.Host, error) {
+	u, err := url.Parse("dummy://" + ref)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse reference %s", ref)
+	}
+
+	hs, err := hosts.Lookup(c.registryConfigDir, u.Host)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load hosts config of registry %s", u.Host)
+	}
+
+	if len(hs) == 0 {
+		insecure := c.isInsecureDomain(ref)
+
+		host, _ := docker.DefaultHost(u.Host)
+		h := hosts.Host{
+			Host:         host,
+			Scheme:       "https",
+			Capabilities: hosts.CapabilityPull | hosts.CapabilityResolve | hosts.CapabilityPush,
+			TLSConfig: &tls.Config{
+				InsecureSkipVerify: insecure,
+			},
+		}
+		if insecure {
+			h.Scheme = "http"
+		}
+		hs = []hosts.Host{h}
+	}
+
+	// the credentials of user are only sent to the registry, not mirrors.
+	if authConfig != nil && (authConfig.Username != "" || authConfig.Password != "") {
+		for i := range hs {
+			if !hs[i].Mirror {
+				hs[i].Username, hs[i].Secret = authConfig.Username, authConfig.Password
+			}
+		}
+	}
+	return hs, nil
+}
+
+// newHostResolver returns the resolver which only accesses the host.
+func newHostResolver(h hosts.Host, tracker docker.StatusTracker) remotes.Resolver {
+	var (
+		host             = h.Host
+		username, secret = h.Username, h.Secret
+	)
+
+	tr := &http.Transport{
+		Proxy: proxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			DualStack: true,
+		}).DialContext,
+		MaxIdleConns:          10,
+		IdleConnTimeout:       30 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		TLSClientConfig:       h.TLSConfig,
+		ExpectContinueTimeout: 5 * time.Second,
+	}
+
+	return docker.NewResolver(docker.ResolverOptions{
+		Tracker: tracker,
+		Host: func(string) (string, error) {
+			return host, nil
+		},
+		PlainHTTP: h.Scheme == "http",
+		Credentials: func(string) (string, string, error) {
+			// Only one host
+			return username, secret, nil
+		},
+		Client: &http.Client{
+			Transport: tr,
+		},
+	})
+}
+
+// hostsResolver resolves the reference by the hosts with resolve capability
+// in order, fetches the content from the hosts with pull capability in order
+// and pushes the content to the first host with push capability.
+type hostsResolver struct {
+	resolvers []remotes.Resolver
+	fetchers  []remotes.Resolver
+	pusher    remotes.Resolver
+}
+
+func newHostsResolver(hs []hosts.Host, tracker docker.StatusTracker) *hostsResolver {
+	r := &hostsResolver{}
+	for _, h := range hs {
+		resolver := newHostResolver(h, tracker)
+
+		if h.Capabilities.Has(hosts.CapabilityResolve) {
+			r.resolvers = append(r.resolvers, resolver)
+		}
+		if h.Capabilities.Has(hosts.CapabilityPull) {
+			r.fetchers = append(r.fetchers, resolver)
+		}
+		if h.Capabilities.Has(hosts.CapabilityPush) && r.pusher == nil {
+			r.pusher = resolver
+		}
+	}
+	return r
+}
+
+// Resolve attempts to resolve the reference by the hosts in order.
+func (r *hostsResolver) Resolve(ctx context.Context, ref string) (string, ocispec.Descriptor, error) {
+	if len(r.resolvers) == 0 {
+		return "", ocispec.Descriptor{}, errors.Errorf("no host with resolve capability for %s", ref)
+	}
+
+	var lastErr error
+	for _, resolver := range r.resolvers {
+		name, desc, err := resolver.Resolve(ctx, ref)
+		if err == nil {
+			return name, desc, nil
+		}
+
+		log.With(ctx).Warnf("failed to resolve %s, try next host: %v", ref, err)
+		lastErr = err
+	}
+	return "", ocispec.Descriptor{}, lastErr
+}
+
+// Fetcher returns the fetcher which fetches the content by the hosts in order.
+func (r *hostsResolver) Fetcher(ctx context.Context, ref string) (remotes.Fetcher, error) {
+	if len(r.fetchers) == 0 {
+		return nil, errors.Errorf("no host with pull capability for %s", ref)
+	}
+
+	fetchers := make(hostsFetcher, 0, len(r.fetchers))
+	for _, resolver := range r.fetchers {
+		f, err := resolver.Fetcher(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		fetchers = append(fetchers, f)
+	}
+	return fetchers, nil
+}
+
+// Pusher returns the pusher of the first host with push capability.
+func (r *hostsResolver) Pusher(ctx context.Context, ref string) (remotes.Pusher, error) {
+	if r.pusher == nil {
+		return nil, errors.Errorf("no host with push capability for %s", ref)
+	}
+	return r.pusher.Pusher(ctx, ref)
+}
+
+// hostsFetcher fetches the content by the fetchers in order.
+type hostsFetcher []remotes.Fetcher
+
+// Fetch returns the content from the first fetcher which has it.
+func (fetchers hostsFetcher) Fetch(ctx context.Context, desc ocispec.Descriptor) (io.ReadCloser, error) {
+	var lastErr error
+	for _, f := range fetchers {
+		rc, err := f.Fetch(ctx, desc)
+		if err == nil {
+			return rc, nil
+		}
+
+		log.With(ctx).Warnf("failed to fetch %s, try next host: %v", desc.Digest, err)
+		lastErr = err
+	}
+	return nil, lastErr
+}
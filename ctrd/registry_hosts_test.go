@@ -0,0 +1,98 @@
+package ctrd
+
+import (
+	"context"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/stretchr/testify/assert"
+)
+
+const testManifestDigest = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+
+// newTestRegistry returns a tls registry stand-in which only serves the
+// manifest of reg.example.com/library/busybox:latest.
+func newTestRegistry(t *testing.T) *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/library/busybox/manifests/latest" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/vnd.docker.distribution.manifest.v2+json")
+		w.Header().Set("Docker-Content-Digest", testManifestDigest)
+		w.Header().Set("Content-Length", "10")
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func TestRegistryHostsResolveByMirror(t *testing.T) {
+	srv := newTestRegistry(t)
+	defer srv.Close()
+
+	dir, err := ioutil.TempDir("", "certs.d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	hostDir := filepath.Join(dir, "reg.example.com")
+	assert.NoError(t, os.MkdirAll(hostDir, 0755))
+
+	mirror := strings.TrimPrefix(srv.URL, "https://")
+	config := `server = "https://127.0.0.1:1"
+
+[host."https://` + mirror + `"]
+  capabilities = ["pull", "resolve"]
+  ca = "ca.crt"
+`
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(hostDir, "hosts.toml"), []byte(config), 0644))
+
+	c := &Client{registryConfigDir: dir}
+	ref := "reg.example.com/library/busybox:latest"
+
+	// the ca of mirror is missing.
+	hs, err := c.registryHosts(ref, nil)
+	assert.Error(t, err)
+	assert.Nil(t, hs)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(hostDir, "ca.crt"),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}), 0644))
+
+	// the config is reloaded, and the mirror is tried before the server.
+	hs, err = c.registryHosts(ref, nil)
+	assert.NoError(t, err)
+	assert.Len(t, hs, 2)
+	assert.Equal(t, mirror, hs[0].Host)
+	assert.True(t, hs[0].Mirror)
+
+	resolver := newHostsResolver(hs, docker.NewInMemoryTracker())
+	_, desc, err := resolver.Resolve(context.Background(), ref)
+	assert.NoError(t, err)
+	assert.Equal(t, testManifestDigest, desc.Digest.String())
+
+	// the mirror has no push capability, the server is used.
+	assert.NotNil(t, resolver.pusher)
+}
+
+func TestRegistryHostsWithoutConfig(t *testing.T) {
+	c := &Client{insecureRegistries: []string{"reg.example.com"}}
+
+	hs, err := c.registryHosts("reg.example.com/busybox:latest", nil)
+	assert.NoError(t, err)
+	assert.Len(t, hs, 1)
+	assert.Equal(t, "http", hs[0].Scheme)
+	assert.False(t, hs[0].Mirror)
+
+	hs, err = c.registryHosts("docker.io/library/busybox:latest", nil)
+	assert.NoError(t, err)
+	assert.Len(t, hs, 1)
+	assert.Equal(t, "registry-1.docker.io", hs[0].Host)
+	assert.Equal(t, "https", hs[0].Scheme)
+}
@@ -8,8 +8,10 @@ import (
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/leases"
 	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/plugin"
 	"github.com/containerd/containerd/snapshots"
 	"github.com/opencontainers/image-spec/identity"
+	"github.com/pkg/errors"
 )
 
 const (
@@ -48,7 +50,7 @@ func (c *Client) CreateSnapshot(ctx context.Context, id, ref string) error {
 		snSrv  = wrapperCli.client.SnapshotService(snName)
 	)
 
-	image, err := wrapperCli.client.GetImage(ctx, ref)
+	image, err := getPlatformImage(ctx, wrapperCli.client, ref)
 	if err != nil {
 		return err
 	}
@@ -167,3 +169,136 @@ func (c *Client) WalkSnapshot(ctx context.Context, snapshotter string, fn func(c
 
 	return service.Walk(ctx, fn)
 }
+
+// SnapshotterInfo is the name and status of a snapshotter plugin.
+type SnapshotterInfo struct {
+	Name   string
+	Status string
+}
+
+// SnapshotterUsage is the usage of a snapshotter.
+type SnapshotterUsage struct {
+	Snapshots int64
+	Size      int64
+	Inodes    int64
+}
+
+// ListSnapshotters returns the snapshotter plugins of containerd.
+func (c *Client) ListSnapshotters(ctx context.Context) ([]SnapshotterInfo, error) {
+	plugins, err := c.Plugins(ctx, []string{fmt.Sprintf("type==%s", plugin.SnapshotPlugin)})
+	if err != nil {
+		return nil, err
+	}
+
+	var res []SnapshotterInfo
+	for _, p := range plugins {
+		res = append(res, SnapshotterInfo{
+			Name:   p.ID,
+			Status: p.Status,
+		})
+	}
+	return res, nil
+}
+
+// GetSnapshotterUsage returns the usage of all snapshots in the snapshotter.
+// It walks every snapshot, so it may be slow with lots of snapshots.
+func (c *Client) GetSnapshotterUsage(ctx context.Context, snapshotter string) (SnapshotterUsage, error) {
+	var usage SnapshotterUsage
+	err := c.WalkSnapshot(ctx, snapshotter, func(ctx context.Context, info snapshots.Info) error {
+		usage.Snapshots++
+
+		u, err := c.snapshotUsage(ctx, snapshotter, info.Name)
+		if err != nil {
+			log.With(ctx).Warnf("failed to get usage of snapshot %s on %s snapshotter: %v", info.Name, snapshotter, err)
+			return nil
+		}
+		usage.Size += u.Size
+		usage.Inodes += u.Inodes
+		return nil
+	})
+	if err != nil {
+		return SnapshotterUsage{}, errors.Wrapf(err, "failed to walk snapshots of %s snapshotter", snapshotter)
+	}
+	return usage, nil
+}
+
+func (c *Client) snapshotUsage(ctx context.Context, snapshotter, key string) (snapshots.Usage, error) {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return snapshots.Usage{}, fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+
+	service := wrapperCli.client.SnapshotService(snapshotter)
+	defer service.Close()
+
+	return service.Usage(ctx, key)
+}
+
+// MigrateSnapshot moves the active snapshot identified by id from snapshotter
+// to another one. The changes of snapshot are diffed against its parent, then
+// applied on a new snapshot with the same key and parent in the target
+// snapshotter, so the parent must have been unpacked in it. The old snapshot
+// is removed at last.
+func (c *Client) MigrateSnapshot(ctx context.Context, id, from, to string) (err0 error) {
+	wrapperCli, err := c.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get a containerd grpc client: %v", err)
+	}
+	client := wrapperCli.client
+
+	var (
+		fromSrv = client.SnapshotService(from)
+		toSrv   = client.SnapshotService(to)
+		differ  = client.DiffService()
+	)
+	defer fromSrv.Close()
+	defer toSrv.Close()
+
+	// NOTE: the diff content is held by a temporary lease, it will be
+	// removed by gc scheduler after migration.
+	lctx, done, err := client.WithLease(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to create lease for migration")
+	}
+	defer done(lctx)
+
+	info, err := fromSrv.Stat(lctx, id)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get snapshot %s on %s snapshotter", id, from)
+	}
+	if info.Kind != snapshots.KindActive {
+		return fmt.Errorf("snapshot %s on %s snapshotter is not active", id, from)
+	}
+
+	desc, err := createDiff(lctx, id, fromSrv, differ)
+	if err != nil {
+		return errors.Wrapf(err, "failed to diff snapshot %s on %s snapshotter", id, from)
+	}
+
+	// the new snapshot is held by the lease of pouchd like the ones
+	// created by CreateSnapshot.
+	pctx := leases.WithLease(ctx, wrapperCli.lease.ID)
+	mounts, err := toSrv.Prepare(pctx, id, info.Parent, snapshots.WithLabels(info.Labels))
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return errors.Wrapf(err, "parent %s of snapshot %s is not unpacked on %s snapshotter", info.Parent, id, to)
+		}
+		return errors.Wrapf(err, "failed to prepare snapshot %s on %s snapshotter", id, to)
+	}
+	defer func() {
+		if err0 != nil {
+			if err := toSrv.Remove(context.TODO(), id); err != nil {
+				log.With(ctx).Warnf("failed to cleanup snapshot %s on %s snapshotter: %v", id, to, err)
+			}
+		}
+	}()
+
+	if _, err := differ.Apply(lctx, desc, mounts); err != nil {
+		return errors.Wrapf(err, "failed to apply diff of snapshot %s on %s snapshotter", id, to)
+	}
+
+	if err := fromSrv.Remove(ctx, id); err != nil {
+		return errors.Wrapf(err, "failed to remove snapshot %s on %s snapshotter", id, from)
+	}
+	return nil
+}
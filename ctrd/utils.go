@@ -2,12 +2,8 @@ package ctrd
 
 import (
 	"context"
-	"crypto/tls"
-	"net"
-	"net/http"
 	"net/url"
 	"syscall"
-	"time"
 
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/pkg/errtypes"
@@ -99,24 +95,18 @@ func (r *resolverWrapper) Pusher(ctx context.Context, name string) (remotes.Push
 	return r.resolver.Pusher(ctx, ref)
 }
 
-func newImageResolver(refToName map[string]string, resolverOpt docker.ResolverOptions) remotes.Resolver {
+func newImageResolver(refToName map[string]string, resolver remotes.Resolver) remotes.Resolver {
 	return &resolverWrapper{
 		refToName: refToName,
-		resolver:  docker.NewResolver(resolverOpt),
+		resolver:  resolver,
 	}
 }
 
 // getResolver try to resolve ref in the reference list, return the resolver and the first available ref.
 func (c *Client) getResolver(ctx context.Context, authConfig *types.AuthConfig, name string, refs []string, resolverOpt docker.ResolverOptions) (remotes.Resolver, string, error) {
-	username, secret := "", ""
-	if authConfig != nil {
-		username = authConfig.Username
-		secret = authConfig.Password
-	}
-
 	var (
 		availableRef string
-		opt          docker.ResolverOptions
+		resolver     remotes.Resolver
 		resolveErr   error
 	)
 
@@ -128,36 +118,12 @@ func (c *Client) getResolver(ctx context.Context, authConfig *types.AuthConfig,
 		}
 		namedRef = reference.TrimTagForDigest(reference.WithDefaultTagIfMissing(namedRef))
 
-		insecure := c.isInsecureDomain(ref)
-		tr := &http.Transport{
-			Proxy: proxyFromEnvironment,
-			DialContext: (&net.Dialer{
-				Timeout:   30 * time.Second,
-				KeepAlive: 30 * time.Second,
-				DualStack: true,
-			}).DialContext,
-			MaxIdleConns:        10,
-			IdleConnTimeout:     30 * time.Second,
-			TLSHandshakeTimeout: 10 * time.Second,
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: insecure,
-			},
-			ExpectContinueTimeout: 5 * time.Second,
-		}
-
-		opt = docker.ResolverOptions{
-			Tracker:   resolverOpt.Tracker,
-			PlainHTTP: insecure,
-			Credentials: func(host string) (string, string, error) {
-				// Only one host
-				return username, secret, nil
-			},
-			Client: &http.Client{
-				Transport: tr,
-			},
+		hs, err := c.registryHosts(namedRef.String(), authConfig)
+		if err != nil {
+			return nil, "", err
 		}
 
-		resolver := docker.NewResolver(opt)
+		resolver = newHostsResolver(hs, resolverOpt.Tracker)
 
 		_, _, err = resolver.Resolve(ctx, namedRef.String())
 		if err == nil {
@@ -181,49 +147,15 @@ func (c *Client) getResolver(ctx context.Context, authConfig *types.AuthConfig,
 		availableRef: name,
 	}
 
-	return newImageResolver(refToName, opt), availableRef, nil
+	return newImageResolver(refToName, resolver), availableRef, nil
 }
 
 func (c *Client) preparePushResolver(authConfig *types.AuthConfig, ref string, resolverOpt docker.ResolverOptions) (remotes.Resolver, error) {
-	var (
-		username = ""
-		secret   = ""
-		insecure = c.isInsecureDomain(ref)
-	)
-
-	if authConfig != nil {
-		username = authConfig.Username
-		secret = authConfig.Password
-	}
-
-	tr := &http.Transport{
-		Proxy: proxyFromEnvironment,
-		DialContext: (&net.Dialer{
-			Timeout:   30 * time.Second,
-			KeepAlive: 30 * time.Second,
-			DualStack: true,
-		}).DialContext,
-		MaxIdleConns:        10,
-		IdleConnTimeout:     30 * time.Second,
-		TLSHandshakeTimeout: 10 * time.Second,
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: insecure,
-		},
-		ExpectContinueTimeout: 5 * time.Second,
-	}
-
-	options := docker.ResolverOptions{
-		Tracker:   resolverOpt.Tracker,
-		PlainHTTP: insecure,
-		Credentials: func(host string) (string, string, error) {
-			// Only one host
-			return username, secret, nil
-		},
-		Client: &http.Client{
-			Transport: tr,
-		},
+	hs, err := c.registryHosts(ref, authConfig)
+	if err != nil {
+		return nil, err
 	}
-	return docker.NewResolver(options), nil
+	return newHostsResolver(hs, resolverOpt.Tracker), nil
 }
 
 // GetWeightDevice Convert weight device from []*types.WeightDevice to []specs.LinuxWeightDevice
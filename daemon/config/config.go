@@ -138,6 +138,36 @@ type Config struct {
 	// insecure registries.
 	InsecureRegistries []string `json:"insecure-registries,omitempty"`
 
+	// RegistryConfigDir is the directory of registry hosts config, which
+	// sets the mirrors, certificates and auth for each registry.
+	RegistryConfigDir string `json:"registry-config-dir,omitempty"`
+
+	// MaxConcurrentDownloads is the max number of layers downloaded at the
+	// same time by all pulls, zero means no limit.
+	MaxConcurrentDownloads int `json:"max-concurrent-downloads,omitempty"`
+
+	// MaxConcurrentDownloadsPerPull is the max number of layers downloaded
+	// at the same time by one pull, zero means no limit.
+	MaxConcurrentDownloadsPerPull int `json:"max-concurrent-downloads-per-pull,omitempty"`
+
+	// MaxDownloadAttempts is the max number of attempts to download a layer.
+	MaxDownloadAttempts int `json:"max-download-attempts,omitempty"`
+
+	// ImagePolicy is the policy file which decides the images allowed to
+	// pull and run.
+	ImagePolicy string `json:"image-policy,omitempty"`
+
+	// ImageGCHighThreshold is the percent of disk usage which triggers
+	// image gc, zero means image gc is disabled.
+	ImageGCHighThreshold int `json:"image-gc-high-threshold,omitempty"`
+
+	// ImageGCLowThreshold is the percent of disk usage which image gc
+	// frees to.
+	ImageGCLowThreshold int `json:"image-gc-low-threshold,omitempty"`
+
+	// ImageGCPeriod is the period in seconds to check the disk usage.
+	ImageGCPeriod int `json:"image-gc-period,omitempty"`
+
 	// EnableBuilder enable builder functionality
 	EnableBuilder bool `json:"enable-builder,omitempty"`
 
@@ -177,6 +207,25 @@ func (cfg *Config) Validate() error {
 
 	// TODO: add config validation
 
+	if cfg.MaxConcurrentDownloads < 0 || cfg.MaxConcurrentDownloadsPerPull < 0 {
+		return fmt.Errorf("max concurrent downloads cannot be negative")
+	}
+	if cfg.MaxDownloadAttempts < 0 {
+		return fmt.Errorf("max download attempts cannot be negative")
+	}
+
+	if cfg.ImageGCHighThreshold != 0 {
+		if cfg.ImageGCHighThreshold < 0 || cfg.ImageGCHighThreshold > 100 {
+			return fmt.Errorf("image gc high threshold %d should be in range [0, 100]", cfg.ImageGCHighThreshold)
+		}
+		if cfg.ImageGCLowThreshold < 0 || cfg.ImageGCLowThreshold >= cfg.ImageGCHighThreshold {
+			return fmt.Errorf("image gc low threshold %d should be in range [0, %d)", cfg.ImageGCLowThreshold, cfg.ImageGCHighThreshold)
+		}
+		if cfg.ImageGCPeriod <= 0 {
+			return fmt.Errorf("image gc period should be positive")
+		}
+	}
+
 	// validates runtimes config
 	if len(cfg.Runtimes) == 0 {
 		cfg.Runtimes = make(map[string]types.Runtime)
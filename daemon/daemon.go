@@ -100,6 +100,9 @@ func NewDaemon(cfg *config.Config) *Daemon {
 		ctrd.WithRPCAddr(cfg.ContainerdAddr),
 		ctrd.WithDefaultNamespace(cfg.DefaultNamespace),
 		ctrd.WithInsecureRegistries(cfg.InsecureRegistries),
+		ctrd.WithRegistryConfigDir(cfg.RegistryConfigDir),
+		ctrd.WithMaxConcurrentDownloads(cfg.MaxConcurrentDownloads, cfg.MaxConcurrentDownloadsPerPull),
+		ctrd.WithMaxDownloadAttempts(cfg.MaxDownloadAttempts),
 	)
 	if err != nil {
 		log.With(nil).Errorf("failed to new containerd's client: %v", err)
@@ -227,6 +230,10 @@ func (d *Daemon) Run() error {
 		return err
 	}
 
+	// start image gc after containers are loaded, so that the images used
+	// by them are kept.
+	imageMgr.(*mgr.ImageManager).StartImageGC(context.Background(), containerMgr)
+
 	if err := d.addSystemLabels(); err != nil {
 		return err
 	}
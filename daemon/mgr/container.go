@@ -37,6 +37,7 @@ import (
 	"github.com/containerd/containerd/mount"
 	"github.com/docker/go-units"
 	"github.com/go-openapi/strfmt"
+	"github.com/opencontainers/go-digest"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
 )
@@ -90,6 +91,18 @@ type ContainerMgr interface {
 	// Stats of a container.
 	Stats(ctx context.Context, name string) (*containerdtypes.Metric, *cgroups.Metrics, error)
 
+	// DiskQuotaUsage returns the usage of disk quotas set on container.
+	DiskQuotaUsage(ctx context.Context, name string) ([]*types.DiskQuotaUsage, error)
+
+	// ListDiskQuotaUsage returns the usage of disk quotas set on the running containers.
+	ListDiskQuotaUsage(ctx context.Context) (map[string][]*types.DiskQuotaUsage, error)
+
+	// QuotaCheck checks the quota ids of containers and volumes, and repairs them if fix is true.
+	QuotaCheck(ctx context.Context, fix bool) ([]*types.QuotaCheckIssue, error)
+
+	// MigrateSnapshot moves the writable layer of a stopped container to the snapshotter.
+	MigrateSnapshot(ctx context.Context, name, snapshotter string) error
+
 	// AttachContainerIO attach stream to container IO.
 	AttachContainerIO(ctx context.Context, name string, cfg *streams.AttachConfig) error
 
@@ -253,6 +266,9 @@ func (mgr *ContainerManager) Load(ctx context.Context) error {
 		// put container into cache.
 		mgr.cache.Put(id, container)
 
+		// count the container for the image.
+		mgr.ImageMgr.UpdateImageContainers(digest.Digest(container.Image), 1)
+
 		return nil
 	}
 
@@ -334,6 +350,10 @@ func (mgr *ContainerManager) Restore(ctx context.Context) error {
 		}
 	}
 
+	// check the quota ids in background, since it needs to walk the
+	// quota report and the directories of all containers and volumes.
+	go mgr.reconcileQuota(ctx)
+
 	return nil
 }
 
@@ -378,8 +398,22 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 	if err != nil {
 		return nil, err
 	}
+
+	if err := mgr.ImageMgr.CheckImagePolicy(ctx, config.Image); err != nil {
+		return nil, err
+	}
+	mgr.ImageMgr.MarkImageUsed(ctx, imgID)
+	mgr.ImageMgr.UpdateImageContainers(imgID, 1)
+	cleanups = append(cleanups, func() error {
+		mgr.ImageMgr.UpdateImageContainers(imgID, -1)
+		return nil
+	})
 	config.Image = primaryRef.String()
 
+	if err := mgr.validatePlatform(ctx, config); err != nil {
+		return nil, err
+	}
+
 	// TODO: check request validate.
 	if config.HostConfig == nil {
 		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "HostConfig cannot be empty")
@@ -513,6 +547,11 @@ func (mgr *ContainerManager) Create(ctx context.Context, name string, config *ty
 	}
 	container.NetworkSettings.Ports = config.HostConfig.PortBindings
 
+	// apply the addresses reserved for the container name.
+	if err := mgr.applyNetworkReservations(ctx, container); err != nil {
+		return nil, err
+	}
+
 	if err := parseSecurityOpts(container, config.HostConfig.SecurityOpt); err != nil {
 		return nil, err
 	}
@@ -677,10 +716,17 @@ func (mgr *ContainerManager) start(ctx context.Context, c *Container, options *t
 		if mp.Name == "" {
 			continue
 		}
-		if _, err = mgr.VolumeMgr.Attach(ctx, mp.Name, map[string]string{volumetypes.OptionRef: c.ID}); err != nil {
+		var v *volumetypes.Volume
+		if v, err = mgr.VolumeMgr.Attach(ctx, mp.Name, map[string]string{volumetypes.OptionRef: c.ID}); err != nil {
 			return errors.Wrapf(err, "failed to attach volume(%s)", mp.Name)
 		}
 		attachedVolumes[mp.Name] = struct{}{}
+
+		// the volume plugin may mount volume at another path after it
+		// restarts, use the path mounted for this start.
+		if p := v.Path(); p != "" && p != mp.Source {
+			mp.Source = p
+		}
 	}
 
 	if err = mgr.prepareContainerNetwork(ctx, c); err != nil {
@@ -694,6 +740,36 @@ func (mgr *ContainerManager) start(ctx context.Context, c *Container, options *t
 	return nil
 }
 
+// applyNetworkReservations sets the addresses reserved on networks for the
+// container into its endpoint settings.
+func (mgr *ContainerManager) applyNetworkReservations(ctx context.Context, c *Container) error {
+	if mgr.NetworkMgr == nil || c.NetworkSettings == nil {
+		return nil
+	}
+
+	for name, epConfig := range c.NetworkSettings.Networks {
+		if epConfig == nil {
+			continue
+		}
+
+		reservations, err := mgr.NetworkMgr.Reservations(ctx, name)
+		if err != nil {
+			if errtypes.IsNotfound(err) {
+				continue
+			}
+			return errors.Wrapf(err, "failed to get reservations of network %s", name)
+		}
+
+		for _, r := range reservations {
+			if r.Name == c.Name {
+				applyReservation(r, epConfig)
+				break
+			}
+		}
+	}
+	return nil
+}
+
 func (mgr *ContainerManager) prepareContainerNetwork(ctx context.Context, c *Container) error {
 	networkMode := c.HostConfig.NetworkMode
 
@@ -746,6 +822,11 @@ func (mgr *ContainerManager) prepareContainerNetwork(ctx context.Context, c *Con
 		}
 	}
 
+	// network is set up by CNI plugins, there is no sandbox in libnetwork.
+	if IsCNI(networkMode) {
+		return nil
+	}
+
 	sb, err := mgr.NetworkMgr.Controller().SandboxByID(c.NetworkSettings.SandboxID)
 	if err != nil {
 		// sandbox not found, maybe caused by disconnect network or no endpoint
@@ -853,6 +934,11 @@ func (mgr *ContainerManager) createContainerdContainer(ctx context.Context, c *C
 			return err
 		}
 	}
+	if err := mgr.setupSubpathMounts(ctx, c, sw.s); err != nil {
+		_ = mgr.cleanupSubpathMounts(ctx, c)
+		return err
+	}
+
 	if err := mgr.Client.CreateContainer(ctx, ctrdContainer, checkpointDir); err != nil {
 		log.With(ctx).Errorf("failed to create new containerd container: %v", err)
 
@@ -1331,6 +1417,13 @@ func (mgr *ContainerManager) Remove(ctx context.Context, name string, options *t
 		}
 	}
 
+	// the subpaths of volumes are bind mounted in the meta directory of
+	// container, which is removed with the meta, so abort removing if they
+	// can't be unmounted, otherwise the data of volumes is removed too.
+	if err := mgr.cleanupSubpathMounts(ctx, c); err != nil {
+		return errors.Wrapf(err, "failed to unmount subpaths of container %s", c.ID)
+	}
+
 	if err := mgr.detachVolumes(ctx, c, options.Volumes); err != nil {
 		log.With(ctx).Errorf("failed to detach volume: %v", err)
 	}
@@ -1364,6 +1457,8 @@ func (mgr *ContainerManager) Remove(ctx context.Context, name string, options *t
 	mgr.NameToID.Remove(c.Name)
 	// remove container cache
 	mgr.cache.Remove(c.ID)
+	// uncount the container for the image
+	mgr.ImageMgr.UpdateImageContainers(digest.Digest(c.Image), -1)
 	// remove the container IO
 	mgr.IOs.Remove(c.ID)
 	c.State.Dead = true
@@ -1576,6 +1671,10 @@ func (mgr *ContainerManager) Connect(ctx context.Context, name string, networkID
 		epConfig = &types.EndpointSettings{}
 	}
 
+	if err := opts.ValidateNetworkPolicy(epConfig.Policy); err != nil {
+		return errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+
 	c.Lock()
 	defer c.Unlock()
 
@@ -1671,6 +1770,10 @@ func (mgr *ContainerManager) updateNetworkConfig(container *Container, networkID
 		return fmt.Errorf("container sharing network namespace with another container or host cannot be connected to any other network")
 	}
 
+	if IsCNI(container.HostConfig.NetworkMode) {
+		return fmt.Errorf("container whose network is set up by cni plugins cannot be connected to any other network")
+	}
+
 	// TODO check bridge-mode conflict
 
 	if IsUserDefined(container.HostConfig.NetworkMode) {
@@ -1713,6 +1816,10 @@ func (mgr *ContainerManager) connectToNetwork(ctx context.Context, container *Co
 		return fmt.Errorf("container sharing network namespace with another container or host cannot be connected to any other network")
 	}
 
+	if IsCNI(container.HostConfig.NetworkMode) {
+		return fmt.Errorf("container whose network is set up by cni plugins cannot be connected to any other network")
+	}
+
 	// TODO check bridge mode conflict
 
 	network, err := mgr.NetworkMgr.Get(context.Background(), networkIDOrName)
@@ -1959,6 +2066,9 @@ func (mgr *ContainerManager) execExitedAndRelease(id string, m *ctrd.Message) er
 
 func (mgr *ContainerManager) releaseContainerResources(ctx context.Context, c *Container) error {
 	mgr.resetContainerIOs(c.ID)
+	if err := mgr.cleanupSubpathMounts(ctx, c); err != nil {
+		log.With(ctx).Warnf("failed to clean subpath mounts: %v", err)
+	}
 	return mgr.releaseContainerNetwork(ctx, c)
 }
 
@@ -274,6 +274,11 @@ func (c *Container) mountVolumes(ctx context.Context, running bool) (err0 error)
 	}()
 
 	for _, m := range c.Mounts {
+		// tmpfs can't be mounted from host.
+		if isTmpfsMount(m) {
+			continue
+		}
+
 		dest, _ := c.getResolvedPath(m.Destination, running)
 
 		log.With(ctx).Debugf("try to mount volume(source %s -> dest %s", m.Source, dest)
@@ -319,6 +324,10 @@ func (c *Container) mountVolumes(ctx context.Context, running bool) (err0 error)
 
 func (c *Container) unmountVolumes(ctx context.Context, running bool) error {
 	for _, m := range c.Mounts {
+		if isTmpfsMount(m) {
+			continue
+		}
+
 		dest, _ := c.getResolvedPath(m.Destination, running)
 
 		if err := mount.Unmount(dest); err != nil {
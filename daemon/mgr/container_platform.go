@@ -0,0 +1,224 @@
+package mgr
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/containerd/containerd/platforms"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+var (
+	// binfmtMiscDir is the mount point of binfmt_misc filesystem.
+	binfmtMiscDir = "/proc/sys/fs/binfmt_misc"
+
+	// compatibleArchs are the architectures executed by host natively,
+	// except the architecture of host.
+	compatibleArchs = map[string][]string{
+		"amd64": {"386"},
+	}
+
+	// elfHeaders are the ELF headers of executables for the architectures,
+	// which are used to find the binfmt_misc handler to execute them.
+	elfHeaders = map[string][]byte{
+		"386":      elfHeader(1, 1, 0x03),
+		"amd64":    elfHeader(2, 1, 0x3e),
+		"arm":      elfHeader(1, 1, 0x28),
+		"arm64":    elfHeader(2, 1, 0xb7),
+		"mips64le": elfHeader(2, 1, 0x08),
+		"ppc64le":  elfHeader(2, 1, 0x15),
+		"riscv64":  elfHeader(2, 1, 0xf3),
+		"s390x":    elfHeader(2, 2, 0x16),
+	}
+)
+
+// elfHeader returns the first 20 bytes of ELF executable, which contain
+// the class, byte order and machine.
+func elfHeader(class, data byte, machine uint16) []byte {
+	header := make([]byte, 20)
+	copy(header, "\x7fELF")
+	header[4], header[5], header[6] = class, data, 1
+
+	var order binary.ByteOrder = binary.LittleEndian
+	if data == 2 {
+		order = binary.BigEndian
+	}
+	// the type of file is ET_EXEC.
+	order.PutUint16(header[16:], 2)
+	order.PutUint16(header[18:], machine)
+	return header
+}
+
+// validatePlatform checks that the image of container is built for the
+// platform specified by config, and the platform of image can be executed
+// by host. If the platform is not specified, the failure of executable check
+// only logs a warning, since the platform in image config may be wrong.
+func (mgr *ContainerManager) validatePlatform(ctx context.Context, config *types.ContainerCreateConfig) error {
+	img, err := mgr.ImageMgr.GetImage(ctx, config.Image)
+	if err != nil {
+		return err
+	}
+
+	platform := platforms.Normalize(ocispec.Platform{
+		OS:           img.Os,
+		Architecture: img.Architecture,
+		Variant:      img.Variant,
+	})
+
+	if config.Platform == "" {
+		if err := checkPlatformExecutable(platform); err != nil {
+			log.With(ctx).Warnf("image %s may not be executed: %v", config.Image, err)
+		}
+		return nil
+	}
+
+	p, err := ctrd.ParsePlatform(config.Platform)
+	if err != nil {
+		return errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+
+	if !platforms.NewMatcher(p).Match(platform) {
+		return errors.Wrapf(errtypes.ErrInvalidParam, "image %s is built for platform %s, not %s",
+			config.Image, platforms.Format(platform), platforms.Format(p))
+	}
+
+	return checkPlatformExecutable(platform)
+}
+
+// checkPlatformExecutable checks that the platform is the one of host, or
+// there is a binfmt_misc handler registered to execute it, such as qemu.
+func checkPlatformExecutable(platform ocispec.Platform) error {
+	// the image doesn't tell its platform, take it as the one of host.
+	if platform.Architecture == "" {
+		return nil
+	}
+
+	host := platforms.DefaultSpec()
+	if platforms.Default().Match(platform) {
+		return nil
+	}
+	if platform.OS == host.OS {
+		for _, arch := range compatibleArchs[host.Architecture] {
+			if arch == platform.Architecture {
+				return nil
+			}
+		}
+
+		if header, ok := elfHeaders[platform.Architecture]; ok {
+			registered, err := binfmtHandlerRegistered(header)
+			if err != nil {
+				return errors.Wrap(err, "failed to check binfmt_misc handlers")
+			}
+			if registered {
+				return nil
+			}
+		}
+	}
+
+	return errors.Wrapf(errtypes.ErrInvalidParam,
+		"platform %s can't be executed on host %s without binfmt_misc handler registered, such as qemu-user-static",
+		platforms.Format(platform), platforms.Format(platforms.Normalize(host)))
+}
+
+// binfmtHandlerRegistered returns true if there is an enabled binfmt_misc
+// handler which matches the header of executable.
+func binfmtHandlerRegistered(header []byte) (bool, error) {
+	status, err := ioutil.ReadFile(filepath.Join(binfmtMiscDir, "status"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if strings.TrimSpace(string(status)) != "enabled" {
+		return false, nil
+	}
+
+	entries, err := ioutil.ReadDir(binfmtMiscDir)
+	if err != nil {
+		return false, err
+	}
+
+	for _, entry := range entries {
+		if entry.Name() == "status" || entry.Name() == "register" {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(binfmtMiscDir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		if matchBinfmtEntry(string(data), header) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// matchBinfmtEntry returns true if the binfmt_misc entry is enabled and the
+// masked magic of it matches the header. The content of entry looks like:
+//
+//	enabled
+//	interpreter /usr/bin/qemu-aarch64-static
+//	flags: F
+//	offset 0
+//	magic 7f454c460201010000000000000000000200b700
+//	mask ffffffffffffff00fffffffffffffffffeffffff
+func matchBinfmtEntry(entry string, header []byte) bool {
+	var (
+		enabled     bool
+		offset      int
+		magic, mask []byte
+	)
+
+	for _, line := range strings.Split(entry, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "enabled":
+			enabled = true
+		case "offset":
+			if len(fields) == 2 {
+				offset, _ = strconv.Atoi(fields[1])
+			}
+		case "magic":
+			if len(fields) == 2 {
+				magic, _ = hex.DecodeString(fields[1])
+			}
+		case "mask":
+			if len(fields) == 2 {
+				mask, _ = hex.DecodeString(fields[1])
+			}
+		}
+	}
+
+	if !enabled || len(magic) == 0 || offset < 0 || offset+len(magic) > len(header) {
+		return false
+	}
+
+	for i, b := range magic {
+		m := byte(0xff)
+		if i < len(mask) {
+			m = mask[i]
+		}
+		if header[offset+i]&m != b&m {
+			return false
+		}
+	}
+	return true
+}
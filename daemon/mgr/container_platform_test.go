@@ -0,0 +1,78 @@
+package mgr
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/containerd/containerd/platforms"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+const qemuAarch64Entry = `enabled
+interpreter /usr/bin/qemu-aarch64-static
+flags: F
+offset 0
+magic 7f454c460201010000000000000000000200b700
+mask ffffffffffffff00fffffffffffffffffeffffff
+`
+
+func Test_matchBinfmtEntry(t *testing.T) {
+	assert.True(t, matchBinfmtEntry(qemuAarch64Entry, elfHeaders["arm64"]))
+	assert.False(t, matchBinfmtEntry(qemuAarch64Entry, elfHeaders["arm"]))
+	assert.False(t, matchBinfmtEntry(qemuAarch64Entry, elfHeaders["amd64"]))
+
+	disabled := "disabled" + qemuAarch64Entry[len("enabled"):]
+	assert.False(t, matchBinfmtEntry(disabled, elfHeaders["arm64"]))
+
+	// the entry matching extension of file is never matched.
+	assert.False(t, matchBinfmtEntry("enabled\ninterpreter /usr/bin/mono\nflags: \nextension .exe\n", elfHeaders["arm64"]))
+}
+
+func Test_checkPlatformExecutable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "binfmt_misc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	origin := binfmtMiscDir
+	binfmtMiscDir = dir
+	defer func() { binfmtMiscDir = origin }()
+
+	host := platforms.DefaultSpec()
+	foreign := ocispec.Platform{OS: "linux", Architecture: "s390x"}
+	if host.Architecture == "s390x" {
+		foreign.Architecture = "arm64"
+	}
+
+	assert.NoError(t, checkPlatformExecutable(host))
+	assert.NoError(t, checkPlatformExecutable(ocispec.Platform{}))
+
+	// binfmt_misc is not mounted.
+	err = checkPlatformExecutable(foreign)
+	assert.True(t, errtypes.IsInvalidParam(err), "%v", err)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "status"), []byte("enabled\n"), 0644))
+	err = checkPlatformExecutable(foreign)
+	assert.True(t, errtypes.IsInvalidParam(err), "%v", err)
+
+	header := elfHeaders[foreign.Architecture]
+	entry := "enabled\ninterpreter /usr/bin/qemu-static\nflags: F\noffset 0\nmagic " + hex.EncodeToString(header) + "\n"
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "qemu-foreign"), []byte(entry), 0644))
+	assert.NoError(t, checkPlatformExecutable(foreign))
+
+	// binfmt_misc is disabled.
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "status"), []byte("disabled\n"), 0644))
+	err = checkPlatformExecutable(foreign)
+	assert.True(t, errtypes.IsInvalidParam(err), "%v", err)
+
+	// the platform of other os can't be executed.
+	err = checkPlatformExecutable(ocispec.Platform{OS: "windows", Architecture: host.Architecture})
+	assert.True(t, errtypes.IsInvalidParam(err), "%v", err)
+}
@@ -0,0 +1,385 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/storage/quota"
+	volumetypes "github.com/alibaba/pouch/storage/volume/types"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// quotaIssueOrphaned means the quota id has limits, but no directory uses it.
+	quotaIssueOrphaned = "orphaned"
+
+	// quotaIssueLeaked means the quota id is charged by files, but no
+	// container or volume uses it.
+	quotaIssueLeaked = "leaked"
+
+	// quotaIssueDuplicated means the quota id is shared by different
+	// containers or volumes.
+	quotaIssueDuplicated = "duplicated"
+
+	// quotaIssueMismatched means the quota id set on directories differs
+	// from the QuotaID of container.
+	quotaIssueMismatched = "mismatched"
+
+	// quotaFixGracePeriod is the period after a quota id is allocated, in
+	// which it is never cleared even if no owner uses it.
+	quotaFixGracePeriod = 10 * time.Minute
+)
+
+// quotaOwner is a container or volume whose directories have quota ids.
+type quotaOwner struct {
+	name string
+
+	// quotaID is the QuotaID specified in config of container, it's 0 if
+	// the quota id is allocated by pouchd.
+	quotaID uint32
+
+	dirs []string
+}
+
+// quotaIDUse records the owners and directories of quota id.
+type quotaIDUse struct {
+	owners []*quotaOwner
+	dirs   map[*quotaOwner][]string
+}
+
+func (u *quotaIDUse) add(owner *quotaOwner, dir string) {
+	if _, ok := u.dirs[owner]; !ok {
+		u.owners = append(u.owners, owner)
+	}
+	if dir != "" {
+		u.dirs[owner] = append(u.dirs[owner], dir)
+	} else if _, ok := u.dirs[owner]; !ok {
+		u.dirs[owner] = nil
+	}
+}
+
+func (u *quotaIDUse) allDirs() []string {
+	var dirs []string
+	for _, o := range u.owners {
+		dirs = append(dirs, u.dirs[o]...)
+	}
+	return dirs
+}
+
+// QuotaCheck scans the quota ids set on the rootfs and volume directories of
+// containers and the directories of volumes, then cross-references them with
+// the QuotaID of containers and the quota report of filesystems. The orphaned,
+// leaked, duplicated and mismatched quota ids are reported, and repaired if
+// fix is true.
+func (mgr *ContainerManager) QuotaCheck(ctx context.Context, fix bool) ([]*types.QuotaCheckIssue, error) {
+	owners, err := mgr.quotaOwners(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		issues []*types.QuotaCheckIssue
+		uses   = map[uint32]*quotaIDUse{}
+	)
+
+	use := func(id uint32) *quotaIDUse {
+		if _, ok := uses[id]; !ok {
+			uses[id] = &quotaIDUse{dirs: map[*quotaOwner][]string{}}
+		}
+		return uses[id]
+	}
+
+	for _, o := range owners {
+		// the quota id specified by container is always in use.
+		if o.quotaID != 0 {
+			use(o.quotaID).add(o, "")
+		}
+
+		for _, dir := range o.dirs {
+			id := quota.GetQuotaIDInFileAttr(dir)
+			if id == 0 {
+				continue
+			}
+
+			if o.quotaID != 0 && id != o.quotaID {
+				issue := &types.QuotaCheckIssue{
+					Type:        quotaIssueMismatched,
+					QuotaID:     strconv.FormatUint(uint64(id), 10),
+					Owners:      []string{o.name},
+					Dirs:        []string{dir},
+					Description: fmt.Sprintf("quota id %d of %s is expected", o.quotaID, o.name),
+				}
+				if fix {
+					if err := quota.ReassignQuotaID(dir, o.quotaID); err != nil {
+						issue.Description = fmt.Sprintf("failed to set quota id %d: %v", o.quotaID, err)
+					} else {
+						issue.Fixed = true
+						id = o.quotaID
+					}
+				}
+				issues = append(issues, issue)
+			}
+
+			use(id).add(o, dir)
+		}
+	}
+
+	for _, id := range sortedQuotaIDs(uses) {
+		if issue := checkDuplicatedQuotaID(id, uses[id], fix); issue != nil {
+			issues = append(issues, issue)
+		}
+	}
+
+	// only the filesystems used by pouchd are checked, and only the quota
+	// ids allocated by pouchd are taken into account, since the others may
+	// be used by other tools on the host.
+	for _, mountPoint := range quotaMountpoints(owners) {
+		report, err := quota.GetQuotaReport(mountPoint)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get quota report of %s", mountPoint)
+		}
+		sort.SliceStable(report, func(i, j int) bool { return report[i].QuotaID < report[j].QuotaID })
+
+		for _, usage := range report {
+			if _, ok := uses[usage.QuotaID]; ok || usage.QuotaID <= quota.QuotaMinID {
+				continue
+			}
+			allocatedAt, ok := quota.AllocatedQuotaIDTime(usage.QuotaID)
+			if !ok {
+				continue
+			}
+			if issue := checkUnusedQuotaID(usage, mountPoint, allocatedAt, fix); issue != nil {
+				issues = append(issues, issue)
+			}
+		}
+	}
+
+	return issues, nil
+}
+
+// reconcileQuota checks the quota ids and reports the issues, it is called at
+// the start of daemon. Nothing is repaired here, since it runs concurrently
+// with the creation of containers, the issues are repaired by quota check
+// with fix explicitly.
+func (mgr *ContainerManager) reconcileQuota(ctx context.Context) {
+	issues, err := mgr.QuotaCheck(ctx, false)
+	if err != nil {
+		log.With(ctx).Warnf("failed to check quota ids: %v", err)
+		return
+	}
+
+	for _, issue := range issues {
+		log.With(ctx).Warnf("found %s quota id %s, owners(%v), dirs(%v): %s, run quota check with fix to repair it",
+			issue.Type, issue.QuotaID, issue.Owners, issue.Dirs, issue.Description)
+	}
+}
+
+// quotaMountpoints returns the mountpoints of the filesystems which hold the
+// directories of owners.
+func quotaMountpoints(owners []*quotaOwner) []string {
+	set := map[string]struct{}{}
+	for _, o := range owners {
+		for _, dir := range o.dirs {
+			if mountPoint, err := quota.GetQuotaMountpoint(dir); err == nil {
+				set[mountPoint] = struct{}{}
+			}
+		}
+	}
+
+	mountPoints := make([]string, 0, len(set))
+	for mountPoint := range set {
+		mountPoints = append(mountPoints, mountPoint)
+	}
+	sort.Strings(mountPoints)
+	return mountPoints
+}
+
+// quotaOwners returns the containers which have disk quota and the local
+// volumes which have size.
+func (mgr *ContainerManager) quotaOwners(ctx context.Context) ([]*quotaOwner, error) {
+	var owners []*quotaOwner
+
+	containers, err := mgr.List(ctx, &ContainerListOption{All: true})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range containers {
+		c.Lock()
+		if c.Config == nil || len(c.Config.DiskQuota) == 0 {
+			c.Unlock()
+			continue
+		}
+
+		owner := &quotaOwner{name: "container/" + c.ID}
+		if quota.IsSetQuotaID(c.Config.QuotaID) {
+			if id, err := strconv.ParseUint(c.Config.QuotaID, 10, 32); err == nil {
+				owner.quotaID = uint32(id)
+			}
+		}
+
+		// the quota id of rootfs is set on upper dir and work dir.
+		if c.Snapshotter != nil {
+			for _, key := range []string{"UpperDir", "WorkDir"} {
+				if dir := c.Snapshotter.Data[key]; dir != "" {
+					owner.dirs = append(owner.dirs, dir)
+				}
+			}
+		}
+		for _, mp := range mgr.getDiskQuotaVolumeMounts(ctx, c) {
+			owner.dirs = append(owner.dirs, mp.Source)
+		}
+		c.Unlock()
+
+		owners = append(owners, owner)
+	}
+
+	volumes, err := mgr.VolumeMgr.List(ctx, filters.NewArgs())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range volumes {
+		if v.Driver() != volumetypes.DefaultBackend || v.Size() == "" || v.Size() == "0" {
+			continue
+		}
+		owners = append(owners, &quotaOwner{name: "volume/" + v.Name, dirs: []string{v.Path()}})
+	}
+
+	// the directory removed is not checked.
+	for _, o := range owners {
+		var dirs []string
+		for _, dir := range o.dirs {
+			if _, err := os.Stat(dir); err == nil {
+				dirs = append(dirs, dir)
+			}
+		}
+		o.dirs = dirs
+	}
+
+	sort.Slice(owners, func(i, j int) bool { return owners[i].name < owners[j].name })
+
+	return owners, nil
+}
+
+// checkDuplicatedQuotaID checks the quota id shared by different owners. The
+// owner which specifies the quota id, or the first one, keeps it, the others
+// are moved to new quota ids if fix is true.
+func checkDuplicatedQuotaID(id uint32, use *quotaIDUse, fix bool) *types.QuotaCheckIssue {
+	if len(use.owners) <= 1 {
+		return nil
+	}
+
+	var (
+		keeper *quotaOwner
+		moved  []*quotaOwner
+		names  []string
+	)
+	for _, o := range use.owners {
+		names = append(names, o.name)
+		if o.quotaID == id && keeper == nil {
+			keeper = o
+		}
+	}
+	if keeper == nil {
+		keeper = use.owners[0]
+	}
+	for _, o := range use.owners {
+		// the containers specifying same quota id share it on purpose.
+		if o == keeper || o.quotaID == id {
+			continue
+		}
+		moved = append(moved, o)
+	}
+	if len(moved) == 0 {
+		return nil
+	}
+
+	issue := &types.QuotaCheckIssue{
+		Type:        quotaIssueDuplicated,
+		QuotaID:     strconv.FormatUint(uint64(id), 10),
+		Owners:      names,
+		Dirs:        use.allDirs(),
+		Description: fmt.Sprintf("quota id is kept by %s", keeper.name),
+	}
+	if !fix {
+		return issue
+	}
+
+	issue.Fixed = true
+	for _, o := range moved {
+		newID, err := quota.GetNextQuotaID()
+		if err != nil {
+			issue.Fixed = false
+			issue.Description += fmt.Sprintf(", failed to get new quota id for %s: %v", o.name, err)
+			continue
+		}
+
+		for _, dir := range use.dirs[o] {
+			if err := quota.ReassignQuotaID(dir, newID); err != nil {
+				issue.Fixed = false
+				issue.Description += fmt.Sprintf(", failed to set quota id %d on %s: %v", newID, dir, err)
+			}
+		}
+		issue.Description += fmt.Sprintf(", %s is moved to quota id %d", o.name, newID)
+	}
+
+	return issue
+}
+
+// checkUnusedQuotaID checks the quota id allocated by pouchd in the report of
+// mountpoint, which is not used by any owner. The limits of it are removed if
+// fix is true, unless it is allocated recently, since the container or volume
+// using it may be still in creation.
+func checkUnusedQuotaID(usage *quota.QuotaUsage, mountPoint string, allocatedAt time.Time, fix bool) *types.QuotaCheckIssue {
+	hasLimits := usage.SoftLimit > 0 || usage.HardLimit > 0 ||
+		usage.InodesSoftLimit > 0 || usage.InodesHardLimit > 0
+
+	issue := &types.QuotaCheckIssue{
+		Type:    quotaIssueOrphaned,
+		QuotaID: strconv.FormatUint(uint64(usage.QuotaID), 10),
+		Dirs:    []string{mountPoint},
+	}
+	if usage.Size > 0 || usage.Inodes > 0 {
+		issue.Type = quotaIssueLeaked
+		issue.Description = fmt.Sprintf("%d bytes and %d inodes are charged, the files should be removed manually",
+			usage.Size, usage.Inodes)
+	}
+
+	if !hasLimits {
+		return issue
+	}
+	if issue.Description == "" {
+		issue.Description = "the limits are not used"
+	}
+
+	if fix {
+		if time.Since(allocatedAt) < quotaFixGracePeriod {
+			issue.Description += ", it is allocated recently and may be in use by the creating container or volume"
+		} else if err := quota.ClearQuota(usage.QuotaID, mountPoint); err != nil {
+			issue.Description += fmt.Sprintf(", failed to clear limits: %v", err)
+		} else {
+			issue.Fixed = true
+			issue.Description += ", the limits are cleared"
+		}
+	}
+
+	return issue
+}
+
+func sortedQuotaIDs(uses map[uint32]*quotaIDUse) []uint32 {
+	ids := make([]uint32, 0, len(uses))
+	for id := range uses {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
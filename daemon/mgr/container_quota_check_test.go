@@ -0,0 +1,74 @@
+package mgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/storage/quota"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckUnusedQuotaID(t *testing.T) {
+	for _, tc := range []struct {
+		usage     *quota.QuotaUsage
+		issueType string
+	}{
+		{
+			usage:     &quota.QuotaUsage{QuotaID: 16777217, HardLimit: 1048576},
+			issueType: quotaIssueOrphaned,
+		},
+		{
+			usage:     &quota.QuotaUsage{QuotaID: 16777218, Size: 4096, Inodes: 2, HardLimit: 1048576},
+			issueType: quotaIssueLeaked,
+		},
+		{
+			usage:     &quota.QuotaUsage{QuotaID: 16777219, Size: 4096, Inodes: 2},
+			issueType: quotaIssueLeaked,
+		},
+	} {
+		issue := checkUnusedQuotaID(tc.usage, "/", time.Now().Add(-time.Hour), false)
+		if !assert.NotNil(t, issue) {
+			continue
+		}
+		assert.Equal(t, tc.issueType, issue.Type)
+		assert.Equal(t, []string{"/"}, issue.Dirs)
+		assert.False(t, issue.Fixed)
+	}
+
+	// the quota id allocated recently is never cleared, since the container
+	// using it may be in creation.
+	issue := checkUnusedQuotaID(&quota.QuotaUsage{QuotaID: 16777217, HardLimit: 1048576}, "/", time.Now(), true)
+	if assert.NotNil(t, issue) {
+		assert.False(t, issue.Fixed)
+		assert.Contains(t, issue.Description, "allocated recently")
+	}
+}
+
+func TestCheckDuplicatedQuotaID(t *testing.T) {
+	c1 := &quotaOwner{name: "container/c1"}
+	c2 := &quotaOwner{name: "container/c2", quotaID: 16777220}
+	c3 := &quotaOwner{name: "container/c3", quotaID: 16777220}
+
+	// the quota id used by only one owner is not duplicated.
+	use := &quotaIDUse{dirs: map[*quotaOwner][]string{}}
+	use.add(c1, "/c1/upper")
+	use.add(c1, "/c1/work")
+	assert.Nil(t, checkDuplicatedQuotaID(16777220, use, false))
+
+	// the owner specifying the quota id keeps it.
+	use.add(c2, "/c2/upper")
+	issue := checkDuplicatedQuotaID(16777220, use, false)
+	if assert.NotNil(t, issue) {
+		assert.Equal(t, quotaIssueDuplicated, issue.Type)
+		assert.Equal(t, []string{"container/c1", "container/c2"}, issue.Owners)
+		assert.Equal(t, []string{"/c1/upper", "/c1/work", "/c2/upper"}, issue.Dirs)
+		assert.Equal(t, "quota id is kept by container/c2", issue.Description)
+	}
+
+	// the owners specifying the same quota id share it on purpose.
+	use = &quotaIDUse{dirs: map[*quotaOwner][]string{}}
+	use.add(c2, "")
+	use.add(c3, "/c3/upper")
+	assert.Nil(t, checkDuplicatedQuotaID(16777220, use, false))
+}
@@ -0,0 +1,140 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/storage/quota"
+
+	"github.com/pkg/errors"
+)
+
+// MigrateSnapshot moves the writable layer of a stopped container to the
+// snapshotter, such as from overlayfs to native or devmapper. The image of
+// container is unpacked into the snapshotter if needed, then the changes of
+// writable layer are diffed and reapplied on a new snapshot.
+func (mgr *ContainerManager) MigrateSnapshot(ctx context.Context, name, snapshotter string) error {
+	if snapshotter == "" {
+		return errors.Wrap(errtypes.ErrInvalidParam, "snapshotter cannot be empty")
+	}
+
+	c, err := mgr.container(name)
+	if err != nil {
+		return err
+	}
+
+	ctx = log.AddFields(ctx, map[string]interface{}{"ContainerID": c.ID})
+
+	c.Lock()
+	defer c.Unlock()
+
+	if c.IsRunningOrPaused() {
+		return errors.Wrapf(errtypes.ErrConflict, "failed to migrate snapshot of container %s which is %s, stop it first", c.ID, c.State.Status)
+	}
+	if c.RootFSProvided {
+		return errors.Wrapf(errtypes.ErrInvalidParam, "container %s is created with rootfs, it has no snapshot", c.ID)
+	}
+
+	from := ctrd.CurrentSnapshotterName(ctrd.WithSnapshotter(ctx, c.Config.Snapshotter))
+	if from == snapshotter {
+		return errors.Wrapf(errtypes.ErrNotModified, "snapshot of container %s is already on %s snapshotter", c.ID, snapshotter)
+	}
+
+	if err := mgr.ImageMgr.UnpackImage(ctx, c.Config.Image, snapshotter); err != nil {
+		return err
+	}
+
+	// keep the quota id of rootfs, it may be shared with volumes.
+	var quotaID uint32
+	if c.Snapshotter != nil && c.Snapshotter.Data["UpperDir"] != "" {
+		quotaID = quota.GetQuotaIDInFileAttr(c.Snapshotter.Data["UpperDir"])
+	}
+
+	if err := mgr.Client.MigrateSnapshot(ctx, c.SnapshotKey(), from, snapshotter); err != nil {
+		return err
+	}
+
+	// NOTE: the snapshotter of daemon is recorded as empty, see Create.
+	c.Config.Snapshotter = snapshotter
+	if snapshotter == ctrd.CurrentSnapshotterName(context.TODO()) {
+		c.Config.Snapshotter = ""
+	}
+	ctx = ctrd.WithSnapshotter(ctx, c.Config.Snapshotter)
+
+	// the snapshot on the old snapshotter has been removed, persist the new
+	// snapshotter at once, the following steps are best-effort.
+	c.Snapshotter = &types.SnapshotterData{
+		Name: ctrd.CurrentSnapshotterName(ctx),
+	}
+	if err := c.Write(mgr.Store); err != nil {
+		log.With(ctx).Errorf("failed to update meta: %v", err)
+		return err
+	}
+
+	if err := mgr.updateSnapshotterMeta(ctx, c, quotaID); err != nil {
+		log.With(ctx).Warnf("failed to update snapshot meta after migrating snapshot: %v", err)
+	}
+
+	mgr.LogContainerEventWithAttributes(ctx, c, "migrate-snapshot", map[string]string{
+		"from": from,
+		"to":   snapshotter,
+	})
+	return nil
+}
+
+// updateSnapshotterMeta updates the snapshot meta of container on the new
+// snapshotter, and sets the disk quota of rootfs on it.
+func (mgr *ContainerManager) updateSnapshotterMeta(ctx context.Context, c *Container, quotaID uint32) error {
+	mounts, err := mgr.Client.GetMounts(ctx, c.SnapshotKey())
+	if err != nil {
+		return err
+	}
+	if len(mounts) != 1 {
+		return fmt.Errorf("failed to get snapshot %s mounts: not equals one", c.SnapshotKey())
+	}
+	c.SetSnapshotterMeta(mounts)
+
+	if err := mgr.setRootfsQuota(ctx, c, quotaID); err != nil {
+		log.With(ctx).Warnf("failed to set rootfs quota after migrating snapshot: %v", err)
+	}
+
+	return c.Write(mgr.Store)
+}
+
+// setRootfsQuota sets the disk quota of rootfs on the snapshot of container,
+// the quota id is kept if it's not 0.
+func (mgr *ContainerManager) setRootfsQuota(ctx context.Context, c *Container, quotaID uint32) (err error) {
+	if len(c.Config.DiskQuota) == 0 {
+		return nil
+	}
+
+	if err = mgr.Mount(ctx, c); err != nil {
+		return errors.Wrapf(err, "failed to mount mountfs(%s)", c.MountFS)
+	}
+	defer func() {
+		if umountErr := mgr.Unmount(ctx, c); umountErr != nil && err == nil {
+			err = umountErr
+		}
+	}()
+
+	qms, err := mgr.prepareQuotaMap(ctx, c, true)
+	if err != nil {
+		return errors.Wrap(err, "failed to prepare quota maps")
+	}
+
+	for _, qm := range qms {
+		if qm.Destination != "/" {
+			continue
+		}
+		if quotaID != 0 {
+			qm.QuotaID = quotaID
+		}
+		_, err = quota.SetRootfsDiskQuota(qm.Source, qm.Size, qm.Inodes, qm.QuotaID, false)
+		return err
+	}
+	return nil
+}
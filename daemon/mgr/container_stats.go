@@ -59,6 +59,12 @@ func (mgr *ContainerManager) StreamStats(ctx context.Context, name string, confi
 			log.With(nil).Debugf("failed to get network stats from container %s: %v", name, err)
 		}
 		stats.Networks = networkStat
+
+		diskQuotaStats, err := mgr.DiskQuotaUsage(ctx, c.ID)
+		if err != nil {
+			log.With(ctx).Debugf("failed to get disk quota stats from container %s: %v", name, err)
+		}
+		stats.DiskQuotaStats = diskQuotaStats
 		return stats, nil
 	}
 
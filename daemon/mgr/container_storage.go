@@ -24,23 +24,28 @@ import (
 	volumetypes "github.com/alibaba/pouch/storage/volume/types"
 
 	"github.com/containerd/containerd/mount"
+	"github.com/containerd/continuity/fs"
 	"github.com/pkg/errors"
 )
 
 func (mgr *ContainerManager) attachVolume(ctx context.Context, name string, c *Container) (string, string, error) {
-	driver := volumetypes.DefaultBackend
+	opts := map[string]string{
+		"backend": volumetypes.DefaultBackend,
+	}
+	return mgr.attachVolumeWithConfig(ctx, name, c.HostConfig.VolumeDriver, opts, nil, c)
+}
+
+// attachVolumeWithConfig attaches volume to container, the volume is created
+// with driver, options and labels if it doesn't exist.
+func (mgr *ContainerManager) attachVolumeWithConfig(ctx context.Context, name, driver string, options, labels map[string]string, c *Container) (string, string, error) {
 	v, err := mgr.VolumeMgr.Get(ctx, name)
 	if err != nil || v == nil {
-		opts := map[string]string{
-			"backend": driver,
-		}
-		if _, err := mgr.VolumeMgr.Create(ctx, name, c.HostConfig.VolumeDriver, opts, nil); err != nil {
+		if v, err = mgr.VolumeMgr.Create(ctx, name, driver, options, labels); err != nil {
 			log.With(ctx).Errorf("failed to create volume(%s), err(%v)", name, err)
 			return "", "", errors.Wrap(err, "failed to create volume")
 		}
-	} else {
-		driver = v.Driver()
 	}
+	driver = v.Driver()
 
 	if _, err := mgr.VolumeMgr.Attach(ctx, name, map[string]string{volumetypes.OptionRef: c.ID}); err != nil {
 		log.With(ctx).Errorf("failed to attach volume(%s), err(%v)", name, err)
@@ -90,13 +95,19 @@ func (mgr *ContainerManager) generateMountPoints(ctx context.Context, c *Contain
 		return errors.Wrap(err, "failed to get mount point from binds")
 	}
 
-	// 3. read MountPoints from image
+	// 3. read MountPoints from mounts
+	err = mgr.getMountPointFromMounts(ctx, c, volumeSet)
+	if err != nil {
+		return errors.Wrap(err, "failed to get mount point from mounts")
+	}
+
+	// 4. read MountPoints from image
 	err = mgr.getMountPointFromImage(ctx, c, volumeSet)
 	if err != nil {
 		return errors.Wrap(err, "failed to get mount point from image")
 	}
 
-	// 4. read MountPoints from Config.Volumes
+	// 5. read MountPoints from Config.Volumes
 	err = mgr.getMountPointFromVolumes(ctx, c, volumeSet)
 	if err != nil {
 		return errors.Wrap(err, "failed to get mount point from volumes")
@@ -186,7 +197,19 @@ func (mgr *ContainerManager) getMountPointFromBinds(ctx context.Context, c *Cont
 				return err
 			}
 
+			// the source is kept as the volume, since the subpath is
+			// resolved again each time the container starts.
+			if mp.Subpath != "" {
+				if _, err := resolveVolumeSubpath(mp.Source, mp.Subpath); err != nil {
+					return err
+				}
+			}
+
 			if mp.Replace != "" {
+				if mp.Subpath != "" {
+					return errors.Wrapf(errtypes.ErrInvalidParam, "subpath can't be used with replace mode(%s)", mp.Replace)
+				}
+
 				switch mp.Replace {
 				case "dr":
 					mp.Source = path.Join(mp.Source, mp.Destination)
@@ -199,6 +222,9 @@ func (mgr *ContainerManager) getMountPointFromBinds(ctx context.Context, c *Cont
 				mp.Driver = ""
 			}
 		} else {
+			if mp.Subpath != "" {
+				return errors.Wrapf(errtypes.ErrInvalidParam, "subpath can't be used with host path(%s)", mp.Source)
+			}
 			mp.CopyData = false
 		}
 
@@ -208,6 +234,108 @@ func (mgr *ContainerManager) getMountPointFromBinds(ctx context.Context, c *Cont
 	return nil
 }
 
+// getMountPointFromMounts reads the mount points from the structured mounts of
+// container, the conflicts between mounts and binds are checked in validation.
+func (mgr *ContainerManager) getMountPointFromMounts(ctx context.Context, c *Container, volumeSet map[string]struct{}) error {
+	var err error
+
+	for _, m := range c.HostConfig.Mounts {
+		if opts.CheckDuplicateMountPoint(c.Mounts, m.Target) {
+			log.With(ctx).Warnf("duplicate mountpoint(%s) from mounts", m.Target)
+			continue
+		}
+
+		mp := &types.MountPoint{
+			Type:        m.Type,
+			Destination: m.Target,
+			RW:          !m.ReadOnly,
+		}
+		if m.ReadOnly {
+			mp.Mode = "ro"
+		}
+
+		switch m.Type {
+		case types.MountTypeBind:
+			mp.Source = m.Source
+			if m.BindOptions != nil {
+				mp.Propagation = m.BindOptions.Propagation
+			}
+
+		case types.MountTypeVolume:
+			var (
+				driver          = c.HostConfig.VolumeDriver
+				options, labels map[string]string
+				noCopy          bool
+			)
+			if v := m.VolumeOptions; v != nil {
+				labels = v.Labels
+				noCopy = v.NoCopy
+				mp.Subpath = v.Subpath
+				if v.DriverConfig != nil {
+					if v.DriverConfig.Name != "" {
+						driver = v.DriverConfig.Name
+					}
+					options = v.DriverConfig.Options
+				}
+			}
+
+			mp.Name = m.Source
+			mp.Named = true
+			if mp.Name == "" {
+				mp.Name = randomid.Generate()
+				mp.Named = false
+			}
+
+			if _, exist := volumeSet[mp.Name]; !exist {
+				_, mp.Driver, err = mgr.attachVolumeWithConfig(ctx, mp.Name, driver, options, labels, c)
+				if err != nil {
+					log.With(ctx).Errorf("failed to bind volume(%s), err(%v)", mp.Name, err)
+					return errors.Wrap(err, "failed to bind volume")
+				}
+
+				volumeSet[mp.Name] = struct{}{}
+			}
+
+			volume, err := mgr.VolumeMgr.Get(ctx, mp.Name)
+			if err != nil || volume == nil {
+				log.With(ctx).Errorf("failed to get volume(%s), err(%v)", mp.Name, err)
+				return errors.Wrapf(err, "failed to get volume(%s)", mp.Name)
+			}
+			mp.Driver = volume.Driver()
+
+			mp.Source, err = mgr.VolumeMgr.Path(ctx, mp.Name)
+			if err != nil {
+				return err
+			}
+
+			// the source is kept as the volume, since the subpath is
+			// resolved again each time the container starts.
+			if mp.Subpath != "" {
+				if _, err := resolveVolumeSubpath(mp.Source, mp.Subpath); err != nil {
+					return err
+				}
+			}
+
+			// copy the image data into volume like binds.
+			mp.CopyData = !noCopy
+			if noCopy {
+				mp.Mode = strings.Trim(mp.Mode+",nocopy", ",")
+			}
+
+		case types.MountTypeTmpfs:
+			// tmpfs has no source on host.
+			mp.Source = types.MountTypeTmpfs
+
+		default:
+			return errors.Wrapf(errtypes.ErrInvalidParam, "unknown mount type(%s)", m.Type)
+		}
+
+		c.Mounts = append(c.Mounts, mp)
+	}
+
+	return nil
+}
+
 func (mgr *ContainerManager) getMountPointFromVolumes(ctx context.Context, c *Container, volumeSet map[string]struct{}) error {
 	var err error
 
@@ -321,6 +449,7 @@ func (mgr *ContainerManager) getMountPointFromContainers(ctx context.Context, co
 				Mode:        oldMountPoint.Mode,
 				Replace:     oldMountPoint.Replace,
 				Propagation: oldMountPoint.Propagation,
+				Subpath:     oldMountPoint.Subpath,
 			}
 
 			if _, exist := volumeSet[oldMountPoint.Name]; len(oldMountPoint.Name) > 0 && !exist {
@@ -334,11 +463,16 @@ func (mgr *ContainerManager) getMountPointFromContainers(ctx context.Context, co
 				volumeSet[mp.Name] = struct{}{}
 			}
 
+			// the source of mount point is inherited, so subpath can't be changed.
+			subpath := mp.Subpath
 			err = opts.ParseBindMode(mp, mode)
 			if err != nil {
 				log.With(ctx).Errorf("failed to parse volumes-from mode(%s), err(%v)", mode, err)
 				return err
 			}
+			if mp.Subpath != subpath {
+				return errors.Wrapf(errtypes.ErrInvalidParam, "subpath can't be used with volumes-from(%s)", v)
+			}
 
 			// if mode has no set rw mode, so need to inherit old mountpoint rw mode.
 			if !(strings.Contains(mode, "ro") || strings.Contains(mode, "rw")) {
@@ -369,7 +503,7 @@ func (mgr *ContainerManager) populateVolumes(ctx context.Context, c *Container,
 	c.Mounts = sortMountPoint(c.Mounts)
 
 	for _, mp := range c.Mounts {
-		if mp.Driver == "tmpfs" {
+		if mp.Driver == "tmpfs" || isTmpfsMount(mp) {
 			continue
 		}
 
@@ -377,19 +511,33 @@ func (mgr *ContainerManager) populateVolumes(ctx context.Context, c *Container,
 			continue
 		}
 
+		// the subpath of volume is mounted into container, so the image
+		// data is copied into the subpath instead of volume root.
+		destination := mp.Source
+		if mp.Subpath != "" {
+			var err error
+			if destination, err = resolveVolumeSubpath(mp.Source, mp.Subpath); err != nil {
+				return errors.Wrapf(err, "failed to resolve subpath(%s) of volume(%s)", mp.Subpath, mp.Name)
+			}
+		}
+
 		log.With(ctx).Debugf("copying image data from (%s:%s), to volume(%s) or path(%s)",
-			c.ID, mp.Destination, mp.Name, mp.Source)
+			c.ID, mp.Destination, mp.Name, destination)
 
 		imagePath := path.Join(c.MountFS, mp.Destination)
 
-		err := copyImageContent(ctx, imagePath, mp.Source, qms)
+		err := copyImageContent(ctx, imagePath, mp.Source, destination, qms)
 		if err != nil {
-			log.With(ctx).Errorf("failed to copy image contents, volume[imagepath(%s), source(%s)], err(%v)", imagePath, mp.Source, err)
-			return errors.Wrapf(err, "failed to copy image content, image(%s), host(%s)", imagePath, mp.Source)
+			log.With(ctx).Errorf("failed to copy image contents, volume[imagepath(%s), source(%s)], err(%v)", imagePath, destination, err)
+			return errors.Wrapf(err, "failed to copy image content, image(%s), host(%s)", imagePath, destination)
 		}
 	}
 
 	for _, mp := range c.Mounts {
+		if isTmpfsMount(mp) {
+			continue
+		}
+
 		if _, err := os.Stat(mp.Source); err != nil {
 			// host directory bind into container.
 			if !os.IsNotExist(err) {
@@ -424,7 +572,7 @@ func (mgr *ContainerManager) setMountTab(ctx context.Context, c *Container) erro
 	// set mount point tab
 	i := 1
 	for _, m := range c.Mounts {
-		if m.Source == "" || m.Destination == "" {
+		if m.Source == "" || m.Destination == "" || isTmpfsMount(m) {
 			continue
 		}
 
@@ -467,11 +615,29 @@ func (mgr *ContainerManager) setMountTab(ctx context.Context, c *Container) erro
 }
 
 func (mgr *ContainerManager) getDiskQuotaMountPoints(ctx context.Context, c *Container, mounted bool) ([]*types.MountPoint, error) {
+	mounts := mgr.getDiskQuotaVolumeMounts(ctx, c)
+
+	// add rootfs mountpoint
+	rootfs, err := mgr.getRootfs(ctx, c, mounted)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get rootfs")
+	}
+	mounts = append(mounts, &types.MountPoint{
+		Source:      rootfs,
+		Destination: "/",
+	})
+
+	return mounts, nil
+}
+
+// getDiskQuotaVolumeMounts returns the mount points of container except the
+// rootfs that can set disk quota.
+func (mgr *ContainerManager) getDiskQuotaVolumeMounts(ctx context.Context, c *Container) []*types.MountPoint {
 	var mounts []*types.MountPoint
 
 	for _, mp := range c.Mounts {
 		// skip volume mount or replace mode mount
-		if mp.Replace != "" || mp.Source == "" || mp.Destination == "" {
+		if mp.Replace != "" || mp.Source == "" || mp.Destination == "" || isTmpfsMount(mp) {
 			log.With(ctx).Debugf("skip volume mount or replace mode mount")
 			continue
 		}
@@ -499,17 +665,7 @@ func (mgr *ContainerManager) getDiskQuotaMountPoints(ctx context.Context, c *Con
 		mounts = append(mounts, mp)
 	}
 
-	// add rootfs mountpoint
-	rootfs, err := mgr.getRootfs(ctx, c, mounted)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get rootfs")
-	}
-	mounts = append(mounts, &types.MountPoint{
-		Source:      rootfs,
-		Destination: "/",
-	})
-
-	return mounts, nil
+	return mounts
 }
 
 func (mgr *ContainerManager) prepareQuotaMap(ctx context.Context, c *Container, mounted bool) ([]*quota.QMap, error) {
@@ -517,8 +673,18 @@ func (mgr *ContainerManager) prepareQuotaMap(ctx context.Context, c *Container,
 	var (
 		quotas        = c.Config.DiskQuota
 		globalQuotaID uint32
+		inodes        uint64
 	)
 
+	// the inode limit applies to all the dirs having disk quota.
+	if v, ok := quotas[quota.InodesKey]; ok {
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid argument, inodes(%s)", v)
+		}
+		inodes = n
+	}
+
 	if quota.IsSetQuotaID(c.Config.QuotaID) {
 		id, err := strconv.Atoi(c.Config.QuotaID)
 		if err != nil {
@@ -552,6 +718,10 @@ func (mgr *ContainerManager) prepareQuotaMap(ctx context.Context, c *Container,
 			qm    *quota.QMap
 		)
 		for exp, size := range quotas {
+			if exp == quota.InodesKey {
+				continue
+			}
+
 			if strings.Contains(exp, "&") {
 				for _, p := range strings.Split(exp, "&") {
 					if p == mp.Destination {
@@ -587,6 +757,8 @@ func (mgr *ContainerManager) prepareQuotaMap(ctx context.Context, c *Container,
 		}
 
 		if qm != nil {
+			qm.Inodes = inodes
+
 			// check duplicate quota map
 			prev := checkDupQuotaMap(qms, qm)
 			if prev == nil {
@@ -628,13 +800,13 @@ func (mgr *ContainerManager) setDiskQuota(ctx context.Context, c *Container, upd
 	for _, qm := range qms {
 		if qm.Destination == "/" {
 			// set rootfs quota
-			_, err = quota.SetRootfsDiskQuota(qm.Source, qm.Size, qm.QuotaID, update)
+			_, err = quota.SetRootfsDiskQuota(qm.Source, qm.Size, qm.Inodes, qm.QuotaID, update)
 			if err != nil {
 				log.With(ctx).Warnf("failed to set rootfs quota, mountfs(%s), size(%s), quota id(%d), err(%v)",
 					qm.Source, qm.Size, qm.QuotaID, err)
 			}
 		} else {
-			err := quota.SetDiskQuota(qm.Source, qm.Size, qm.QuotaID)
+			err := quota.SetDiskQuotaWithInodes(qm.Source, qm.Size, qm.Inodes, qm.QuotaID)
 			if err != nil {
 				log.With(ctx).Warnf("failed to set disk quota, directory(%s), size(%s), quota id(%d), err(%v)",
 					qm.Source, qm.Size, qm.QuotaID, err)
@@ -645,6 +817,144 @@ func (mgr *ContainerManager) setDiskQuota(ctx context.Context, c *Container, upd
 	return nil
 }
 
+// DiskQuotaUsage returns the usage of disk quotas set on container, which
+// is read from the quota report of filesystem. The container not running
+// is not reported since its rootfs is not mounted.
+func (mgr *ContainerManager) DiskQuotaUsage(ctx context.Context, name string) ([]*types.DiskQuotaUsage, error) {
+	c, err := mgr.container(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return mgr.diskQuotaUsage(ctx, c, quotaReportCache{})
+}
+
+// ListDiskQuotaUsage returns the usage of disk quotas set on the running
+// containers by container id, the quota report of each filesystem is read
+// once for all the containers.
+func (mgr *ContainerManager) ListDiskQuotaUsage(ctx context.Context) (map[string][]*types.DiskQuotaUsage, error) {
+	containers, err := mgr.List(ctx, &ContainerListOption{})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		usages  = map[string][]*types.DiskQuotaUsage{}
+		reports = quotaReportCache{}
+	)
+	for _, c := range containers {
+		usage, err := mgr.diskQuotaUsage(ctx, c, reports)
+		if err != nil {
+			log.With(ctx).Warnf("failed to get disk quota usage of container %s: %v", c.ID, err)
+			continue
+		}
+		if len(usage) > 0 {
+			usages[c.ID] = usage
+		}
+	}
+
+	return usages, nil
+}
+
+// diskQuotaUsage returns the usage of disk quotas set on container, the
+// quota reports are read by reports.
+func (mgr *ContainerManager) diskQuotaUsage(ctx context.Context, c *Container, reports quotaReportCache) ([]*types.DiskQuotaUsage, error) {
+	c.Lock()
+	if c.Config == nil || len(c.Config.DiskQuota) == 0 || !c.IsRunningOrPaused() {
+		c.Unlock()
+		return nil, nil
+	}
+	mounts, err := mgr.getDiskQuotaMountPoints(ctx, c, true)
+	upperDir := c.Snapshotter.Data["UpperDir"]
+	c.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		usages  []*types.DiskQuotaUsage
+		quotaID = map[string]*types.DiskQuotaUsage{}
+	)
+	for _, mp := range sortMountPoint(mounts) {
+		dir := mp.Source
+		if mp.Destination == "/" {
+			// the quota id of rootfs is set on the upper dir.
+			if upperDir == "" {
+				continue
+			}
+			dir = upperDir
+		}
+
+		id := quota.GetQuotaIDInFileAttr(dir)
+		if id == 0 {
+			continue
+		}
+
+		// the usage is read from the filesystem of directory, the same
+		// quota id on different filesystems is reported separately.
+		qu, err := reports.usage(dir, id)
+		if err != nil {
+			log.With(ctx).Warnf("failed to get usage of quota id(%d), dir(%s): %v", id, dir, err)
+			continue
+		}
+		key := qu.Device + "/" + strconv.FormatUint(uint64(id), 10)
+		if usage, ok := quotaID[key]; ok {
+			usage.Destinations = append(usage.Destinations, mp.Destination)
+			continue
+		}
+
+		usage := &types.DiskQuotaUsage{
+			QuotaID:         strconv.FormatUint(uint64(id), 10),
+			Device:          qu.Device,
+			Destinations:    []string{mp.Destination},
+			Size:            qu.Size,
+			SoftLimit:       qu.SoftLimit,
+			HardLimit:       qu.HardLimit,
+			Inodes:          qu.Inodes,
+			InodesSoftLimit: qu.InodesSoftLimit,
+			InodesHardLimit: qu.InodesHardLimit,
+		}
+		quotaID[key] = usage
+		usages = append(usages, usage)
+	}
+
+	return usages, nil
+}
+
+// quotaReportCache caches the quota reports of filesystems by mountpoint,
+// so that repquota is executed once for each filesystem in a request.
+type quotaReportCache map[string]*quotaReport
+
+type quotaReport struct {
+	usages []*quota.QuotaUsage
+	err    error
+}
+
+// usage returns the usage of quota id set on directory.
+func (rc quotaReportCache) usage(dir string, id uint32) (*quota.QuotaUsage, error) {
+	mountPoint, err := quota.GetQuotaMountpoint(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	report, ok := rc[mountPoint]
+	if !ok {
+		report = &quotaReport{}
+		report.usages, report.err = quota.GetQuotaReport(mountPoint)
+		rc[mountPoint] = report
+	}
+	if report.err != nil {
+		return nil, report.err
+	}
+
+	for _, usage := range report.usages {
+		if usage.QuotaID == id {
+			return usage, nil
+		}
+	}
+	return nil, errors.Errorf("quota id(%d) is not found in quota report of %s", id, mountPoint)
+}
+
 func (mgr *ContainerManager) detachVolumes(ctx context.Context, c *Container, remove bool) error {
 	for _, mount := range c.Mounts {
 		name := mount.Name
@@ -838,6 +1148,59 @@ func (mgr *ContainerManager) getRootfs(ctx context.Context, c *Container, mounte
 	return rootfs, nil
 }
 
+// resolveVolumeSubpath returns the host path of the subpath in volume, the
+// symlinks in subpath are resolved in the scope of volume, so it never escapes
+// from the volume. The missing directories are created with the ownership of
+// volume.
+func resolveVolumeSubpath(root, subpath string) (string, error) {
+	if err := opts.ValidateSubpath(subpath); err != nil {
+		return "", errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+
+	resolved, err := fs.RootPath(root, subpath)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to resolve subpath(%s) in volume", subpath)
+	}
+
+	rel, err := filepath.Rel(root, resolved)
+	if err != nil {
+		return "", err
+	}
+
+	// create the missing directories one by one, since the ownership of
+	// each of them should be same as volume.
+	dir := root
+	for _, name := range strings.Split(rel, string(filepath.Separator)) {
+		dir = filepath.Join(dir, name)
+		if _, err := os.Lstat(dir); err == nil {
+			continue
+		} else if !os.IsNotExist(err) {
+			return "", err
+		}
+
+		if err := os.Mkdir(dir, 0755); err != nil && !os.IsExist(err) {
+			return "", errors.Wrapf(err, "failed to create subpath(%s) in volume", subpath)
+		}
+		if err := copyOwnership(root, dir); err != nil {
+			return "", errors.Wrapf(err, "failed to set ownership of subpath(%s) in volume", subpath)
+		}
+	}
+
+	// resolve subpath again, in case the symlink is created in volume at the
+	// same time.
+	if again, err := fs.RootPath(root, subpath); err != nil || again != resolved {
+		return "", errors.Errorf("subpath(%s) is changed while resolving it in volume", subpath)
+	}
+
+	return resolved, nil
+}
+
+// isTmpfsMount returns whether the mount point is a tmpfs mounted into
+// container, which has no source on host.
+func isTmpfsMount(mp *types.MountPoint) bool {
+	return mp.Type == types.MountTypeTmpfs
+}
+
 func sortMountPoint(mounts []*types.MountPoint) []*types.MountPoint {
 	sort.Slice(mounts, func(i, j int) bool {
 		if len(mounts[i].Destination) < len(mounts[j].Destination) {
@@ -849,7 +1212,9 @@ func sortMountPoint(mounts []*types.MountPoint) []*types.MountPoint {
 	return mounts
 }
 
-func copyImageContent(ctx context.Context, source, destination string, qms []*quota.QMap) error {
+// copyImageContent copies the image content into destination, which is the
+// volume itself or a subpath in it. The quota of volume is set before copying.
+func copyImageContent(ctx context.Context, source, volume, destination string, qms []*quota.QMap) error {
 	fi, err := os.Stat(source)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -878,8 +1243,8 @@ func copyImageContent(ctx context.Context, source, destination string, qms []*qu
 	// first set quota on volume, then copy image content to volume, or file exist in image
 	// can not inherit quota
 	for _, qm := range qms {
-		if qm.Source == destination {
-			if err := quota.SetDiskQuota(qm.Source, qm.Size, qm.QuotaID); err != nil {
+		if qm.Source == volume {
+			if err := quota.SetDiskQuotaWithInodes(qm.Source, qm.Size, qm.Inodes, qm.QuotaID); err != nil {
 				log.With(ctx).Warnf("failed to set disk quota, directory(%s), size(%s), quota id(%d), err(%v)",
 					qm.Source, qm.Size, qm.QuotaID, err)
 			}
@@ -1,6 +1,7 @@
 package mgr
 
 import (
+	"context"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -127,3 +128,145 @@ func TestCopyOwnership(t *testing.T) {
 		t.Fatalf("Gid %d is not equal to %d", sysInfo.Gid, uint32(300))
 	}
 }
+
+func TestResolveVolumeSubpath(t *testing.T) {
+	root, err := ioutil.TempDir("/tmp", "testResolveVolumeSubpath")
+	if err != nil {
+		t.Fatalf("failed to mk tmp dir: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	if err := os.Chown(root, 1000, 1000); err != nil {
+		t.Fatalf("failed to chown tmp dir: %v", err)
+	}
+
+	// the missing directories are created with the ownership of volume.
+	p, err := resolveVolumeSubpath(root, "data/logs")
+	if err != nil {
+		t.Fatalf("failed to resolve subpath: %v", err)
+	}
+	if p != filepath.Join(root, "data/logs") {
+		t.Fatalf("expected subpath %s, but got %s", filepath.Join(root, "data/logs"), p)
+	}
+	for _, dir := range []string{"data", "data/logs"} {
+		fi, err := os.Stat(filepath.Join(root, dir))
+		if err != nil {
+			t.Fatalf("failed to stat %s: %v", dir, err)
+		}
+		if st := fi.Sys().(*syscall.Stat_t); st.Uid != 1000 || st.Gid != 1000 {
+			t.Fatalf("expected ownership of %s is 1000:1000, but got %d:%d", dir, st.Uid, st.Gid)
+		}
+	}
+
+	// the symlink can't escape from volume.
+	if err := os.Symlink("/etc", filepath.Join(root, "escape")); err != nil {
+		t.Fatalf("failed to create symlink: %v", err)
+	}
+	p, err = resolveVolumeSubpath(root, "escape/passwd")
+	if err != nil {
+		t.Fatalf("failed to resolve subpath: %v", err)
+	}
+	if p != filepath.Join(root, "etc/passwd") {
+		t.Fatalf("expected subpath %s, but got %s", filepath.Join(root, "etc/passwd"), p)
+	}
+
+	for _, subpath := range []string{"", "/data", "../data", "data/../.."} {
+		if _, err := resolveVolumeSubpath(root, subpath); err == nil {
+			t.Fatalf("expected error of subpath(%s)", subpath)
+		}
+	}
+}
+
+func TestPopulateVolumesSubpath(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("/tmp", "testPopulateVolumesSubpath")
+	if err != nil {
+		t.Fatalf("failed to mk tmp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	rootfs := filepath.Join(tmpDir, "rootfs")
+	imagePath := filepath.Join(rootfs, "data")
+	if err := os.MkdirAll(imagePath, 0755); err != nil {
+		t.Fatalf("failed to mkdir %s: %v", imagePath, err)
+	}
+	if err := os.Chown(imagePath, 200, 300); err != nil {
+		t.Fatalf("failed to chown %s: %v", imagePath, err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(imagePath, "file"), []byte("image"), 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	volume := filepath.Join(tmpDir, "volume")
+	if err := os.Mkdir(volume, 0755); err != nil {
+		t.Fatalf("failed to mkdir %s: %v", volume, err)
+	}
+	// the subpath is created in volume when container is created.
+	if _, err := resolveVolumeSubpath(volume, "sub"); err != nil {
+		t.Fatalf("failed to resolve subpath: %v", err)
+	}
+
+	c := &Container{
+		MountFS: rootfs,
+		Mounts: []*types.MountPoint{
+			{
+				Name:        "volume",
+				Source:      volume,
+				Destination: "/data",
+				Subpath:     "sub",
+				CopyData:    true,
+			},
+		},
+	}
+	mgr := &ContainerManager{}
+	if err := mgr.populateVolumes(context.Background(), c, nil); err != nil {
+		t.Fatalf("failed to populate volumes: %v", err)
+	}
+
+	// the image data is copied into subpath.
+	data, err := ioutil.ReadFile(filepath.Join(volume, "sub", "file"))
+	if err != nil || string(data) != "image" {
+		t.Fatalf("expected image data in subpath, but got %q, err: %v", data, err)
+	}
+	if _, err := os.Stat(filepath.Join(volume, "file")); !os.IsNotExist(err) {
+		t.Fatalf("expected no image data in volume root, but got err: %v", err)
+	}
+
+	// the ownership is applied to subpath instead of volume root.
+	for dir, owner := range map[string]uint32{filepath.Join(volume, "sub"): 200, volume: 0} {
+		fi, err := os.Stat(dir)
+		if err != nil {
+			t.Fatalf("failed to stat %s: %v", dir, err)
+		}
+		if st := fi.Sys().(*syscall.Stat_t); st.Uid != owner {
+			t.Fatalf("expected owner of %s is %d, but got %d", dir, owner, st.Uid)
+		}
+	}
+}
+
+func TestOpenSubpath(t *testing.T) {
+	root, err := ioutil.TempDir("/tmp", "testOpenSubpath")
+	if err != nil {
+		t.Fatalf("failed to mk tmp dir: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	if err := os.MkdirAll(filepath.Join(root, "data/logs"), 0755); err != nil {
+		t.Fatalf("failed to mk subpath: %v", err)
+	}
+	fd, err := openSubpath(root, "data/logs")
+	if err != nil {
+		t.Fatalf("failed to open subpath: %v", err)
+	}
+	syscall.Close(fd)
+
+	// the subpath replaced with symlink after it's resolved is not followed.
+	if err := os.Symlink("/", filepath.Join(root, "root")); err != nil {
+		t.Fatalf("failed to create symlink: %v", err)
+	}
+	for _, rel := range []string{"root", "root/etc"} {
+		if fd, err := openSubpath(root, rel); err == nil {
+			syscall.Close(fd)
+			t.Fatalf("expected error of opening symlink subpath(%s)", rel)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package mgr
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/pouch/pkg/log"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// subpathMountsDir is the directory in the meta of container, where the
+// subpaths of volumes are bind mounted before the container starts.
+const subpathMountsDir = "subpaths"
+
+// setupSubpathMounts resolves the subpaths of volumes again, and replaces the
+// sources of them in spec with the bind mounts of the resolved paths. The
+// subpath is opened component by component without following symlinks, and
+// it's bind mounted through the opened fd, so the container can't make it
+// escape from the volume by replacing it with a symlink.
+func (mgr *ContainerManager) setupSubpathMounts(ctx context.Context, c *Container, s *specs.Spec) error {
+	// clean the bind mounts left by the last run.
+	if err := mgr.cleanupSubpathMounts(ctx, c); err != nil {
+		return err
+	}
+
+	for i, mp := range c.Mounts {
+		if mp.Subpath == "" {
+			continue
+		}
+
+		resolved, err := resolveVolumeSubpath(mp.Source, mp.Subpath)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(mp.Source, resolved)
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(mgr.Store.Path(c.ID), subpathMountsDir, strconv.Itoa(i))
+		if err := bindMountSubpath(mp.Source, rel, target); err != nil {
+			return errors.Wrapf(err, "failed to mount subpath(%s) of volume", mp.Subpath)
+		}
+
+		for j := range s.Mounts {
+			if s.Mounts[j].Destination == mp.Destination && s.Mounts[j].Type == "bind" {
+				s.Mounts[j].Source = target
+			}
+		}
+	}
+
+	return nil
+}
+
+// cleanupSubpathMounts unmounts the bind mounts of subpaths of container.
+func (mgr *ContainerManager) cleanupSubpathMounts(ctx context.Context, c *Container) error {
+	dir := filepath.Join(mgr.Store.Path(c.ID), subpathMountsDir)
+
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, fi := range fis {
+		target := filepath.Join(dir, fi.Name())
+		if err := unix.Unmount(target, unix.MNT_DETACH); err != nil && err != unix.EINVAL {
+			log.With(ctx).Errorf("failed to unmount subpath %s: %v", target, err)
+			return errors.Wrapf(err, "failed to unmount subpath %s", target)
+		}
+
+		// remove the mount target only, it fails rather than removing the
+		// data of volume if the subpath is still mounted on it.
+		if err := os.Remove(target); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "failed to remove subpath mount target %s", target)
+		}
+	}
+
+	return os.Remove(dir)
+}
+
+// bindMountSubpath opens rel in root without following symlinks, then bind
+// mounts it onto target through the opened fd.
+func bindMountSubpath(root, rel, target string) error {
+	fd, err := openSubpath(root, rel)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(fd)
+
+	var st unix.Stat_t
+	if err := unix.Fstat(fd, &st); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), 0700); err != nil {
+		return err
+	}
+	if st.Mode&unix.S_IFMT == unix.S_IFDIR {
+		err = os.Mkdir(target, 0700)
+	} else {
+		var f *os.File
+		if f, err = os.OpenFile(target, os.O_CREATE|os.O_EXCL, 0600); err == nil {
+			f.Close()
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	source := fmt.Sprintf("/proc/self/fd/%d", fd)
+	return unix.Mount(source, target, "", unix.MS_BIND|unix.MS_REC, "")
+}
+
+// openSubpath opens each component of rel in root with O_NOFOLLOW, and
+// returns the fd of the last one. It fails if any component is a symlink.
+func openSubpath(root, rel string) (int, error) {
+	fd, err := unix.Open(root, unix.O_PATH|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return -1, err
+	}
+
+	for _, name := range strings.Split(filepath.Clean(rel), string(filepath.Separator)) {
+		if name == "" || name == "." {
+			continue
+		}
+
+		next, err := unix.Openat(fd, name, unix.O_PATH|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0)
+		unix.Close(fd)
+		if err != nil {
+			return -1, err
+		}
+		fd = next
+
+		var st unix.Stat_t
+		if err := unix.Fstat(fd, &st); err != nil {
+			unix.Close(fd)
+			return -1, err
+		}
+		if st.Mode&unix.S_IFMT == unix.S_IFLNK {
+			unix.Close(fd)
+			return -1, errors.Errorf("%s in volume is a symlink", name)
+		}
+	}
+
+	return fd, nil
+}
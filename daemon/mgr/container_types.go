@@ -460,8 +460,13 @@ func (c *Container) SetSnapshotterMeta(mounts []mount.Mount) {
 		}
 	}
 
+	ctx := context.TODO()
+	if c.Config != nil {
+		ctx = ctrd.WithSnapshotter(ctx, c.Config.Snapshotter)
+	}
+
 	c.Snapshotter = &types.SnapshotterData{
-		Name: ctrd.CurrentSnapshotterName(context.TODO()),
+		Name: ctrd.CurrentSnapshotterName(ctx),
 		Data: data,
 	}
 }
@@ -532,6 +537,11 @@ func (c *Container) GetResourcePath(baseFS, path string) string {
 	// first check if the dir in volume
 	// the volumes must be sorted before.
 	for _, mp := range c.Mounts {
+		// tmpfs has no host path.
+		if isTmpfsMount(mp) {
+			continue
+		}
+
 		dest := filepath.Clean(mp.Destination)
 		if !strings.HasPrefix(path, dest) {
 			continue
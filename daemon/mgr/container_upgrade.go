@@ -8,6 +8,7 @@ import (
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/utils"
 
+	"github.com/opencontainers/go-digest"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
 )
@@ -31,6 +32,7 @@ func (mgr *ContainerManager) Upgrade(ctx context.Context, name string, config *t
 		oldImage      = c.Image
 		oldSnapID     = c.SnapshotKey()
 		IsRunning     = false
+		imageSwitched = false
 	)
 
 	// use err to determine if we should recover old container configure.
@@ -39,6 +41,11 @@ func (mgr *ContainerManager) Upgrade(ctx context.Context, name string, config *t
 			return
 		}
 
+		// count the container for the old image again.
+		if imageSwitched {
+			mgr.switchImageContainers(c.Image, oldImage)
+		}
+
 		c.Lock()
 		// recover old container config
 		c.Config = &oldConfig
@@ -65,6 +72,8 @@ func (mgr *ContainerManager) Upgrade(ctx context.Context, name string, config *t
 	if err != nil {
 		return errors.Wrap(err, "failed to upgrade container")
 	}
+	mgr.switchImageContainers(oldImage, c.Image)
+	imageSwitched = true
 
 	// if the container is running, we need first stop it.
 	if c.State.Running {
@@ -214,3 +223,13 @@ func (mgr *ContainerManager) mergeImageConfigForUpgrade(ctx context.Context, c *
 
 	return nil
 }
+
+// switchImageContainers moves the container from the count of image from to
+// the count of image to.
+func (mgr *ContainerManager) switchImageContainers(from, to string) {
+	if from == to {
+		return
+	}
+	mgr.ImageMgr.UpdateImageContainers(digest.Digest(from), -1)
+	mgr.ImageMgr.UpdateImageContainers(digest.Digest(to), 1)
+}
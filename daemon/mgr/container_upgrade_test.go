@@ -0,0 +1,44 @@
+package mgr
+
+import (
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeImageContainersMgr struct {
+	ImageMgr
+	containers map[digest.Digest]int64
+}
+
+func (f *fakeImageContainersMgr) UpdateImageContainers(id digest.Digest, delta int64) {
+	f.containers[id] += delta
+}
+
+func TestSwitchImageContainers(t *testing.T) {
+	var (
+		oldImage = digest.FromString("old").String()
+		newImage = digest.FromString("new").String()
+		imageMgr = &fakeImageContainersMgr{
+			containers: map[digest.Digest]int64{
+				digest.Digest(oldImage): 1,
+			},
+		}
+		mgr = &ContainerManager{ImageMgr: imageMgr}
+	)
+
+	// the container is counted for the new image after upgrade.
+	mgr.switchImageContainers(oldImage, newImage)
+	assert.Equal(t, int64(0), imageMgr.containers[digest.Digest(oldImage)])
+	assert.Equal(t, int64(1), imageMgr.containers[digest.Digest(newImage)])
+
+	// and counted for the old image again after rollback.
+	mgr.switchImageContainers(newImage, oldImage)
+	assert.Equal(t, int64(1), imageMgr.containers[digest.Digest(oldImage)])
+	assert.Equal(t, int64(0), imageMgr.containers[digest.Digest(newImage)])
+
+	// nothing changes for the same image.
+	mgr.switchImageContainers(oldImage, oldImage)
+	assert.Equal(t, int64(1), imageMgr.containers[digest.Digest(oldImage)])
+}
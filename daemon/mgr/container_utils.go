@@ -192,6 +192,7 @@ func (mgr *ContainerManager) getContainerSpec(c *Container) (*specs.Spec, error)
 func BuildContainerEndpoint(c *Container) *networktypes.Endpoint {
 	return &networktypes.Endpoint{
 		Owner:           c.ID,
+		OwnerName:       c.Name,
 		Hostname:        c.Config.Hostname,
 		Domainname:      c.Config.Domainname,
 		HostsPath:       c.HostsPath,
@@ -7,10 +7,12 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/alibaba/pouch/apis/opts"
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/daemon/logger"
 	"github.com/alibaba/pouch/daemon/logger/jsonfile"
 	"github.com/alibaba/pouch/daemon/logger/syslog"
+	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/system"
 	"github.com/alibaba/pouch/pkg/utils"
@@ -70,11 +72,28 @@ func (mgr *ContainerManager) validateConfig(c *Container, update bool) ([]string
 		return warnings, fmt.Errorf("shm-size %d should greater than 0", *hostConfig.ShmSize)
 	}
 
+	// validate mounts
+	if err := validateMounts(hostConfig); err != nil {
+		return warnings, err
+	}
+
 	// validate log config
 	if err := mgr.validateLogConfig(c); err != nil {
 		return warnings, err
 	}
 
+	// validate ingress policies of endpoints
+	if c.NetworkSettings != nil {
+		for name, epConfig := range c.NetworkSettings.Networks {
+			if epConfig == nil {
+				continue
+			}
+			if err := opts.ValidateNetworkPolicy(epConfig.Policy); err != nil {
+				return warnings, errors.Wrapf(err, "invalid policy on network %s", name)
+			}
+		}
+	}
+
 	// validate seccomp, apparmor security parameters
 	sysInfo := system.NewInfo()
 	if !sysInfo.Seccomp {
@@ -109,7 +128,20 @@ func (mgr *ContainerManager) validateDiskQuota(config *types.ContainerCreateConf
 	}
 
 	quotaMaps := config.DiskQuota
-	if len(quotaMaps) > 1 && quota.IsSetQuotaID(config.QuotaID) {
+
+	// the inode limit is not a disk quota of dir.
+	sizeQuotas := len(quotaMaps)
+	if v, ok := quotaMaps[quota.InodesKey]; ok {
+		if n, err := strconv.ParseUint(v, 10, 64); err != nil || n == 0 {
+			return errors.Wrapf(errInvalidDiskQuota, "inodes(%s) must be a positive integer", v)
+		}
+		sizeQuotas--
+		if sizeQuotas == 0 {
+			return errors.Wrap(errInvalidDiskQuota, "inodes must be set with disk quota size")
+		}
+	}
+
+	if sizeQuotas > 1 && quota.IsSetQuotaID(config.QuotaID) {
 		return errors.Wrap(errInvalidDiskQuota, `QuotaID only used to set one disk quota, `+
 			`such as: "/=10G" or "/path1=10G" or ".*=10G"`)
 	}
@@ -135,6 +167,110 @@ func (mgr *ContainerManager) validateDiskQuota(config *types.ContainerCreateConf
 	return nil
 }
 
+// validateMounts validates the structured mounts of container, and checks the
+// conflicts between mounts and binds.
+func validateMounts(hostConfig *types.HostConfig) error {
+	if len(hostConfig.Mounts) == 0 {
+		return nil
+	}
+
+	// the destinations of binds
+	targets := map[string]string{}
+	for _, b := range hostConfig.Binds {
+		parts, err := opts.CheckBind(b)
+		if err != nil {
+			return errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+		}
+		dest := parts[0]
+		if len(parts) > 1 {
+			dest = parts[1]
+		}
+		targets[filepath.Clean(dest)] = "Binds"
+	}
+
+	for _, m := range hostConfig.Mounts {
+		if err := validateMount(m); err != nil {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "invalid mount(%s): %v", m.Target, err)
+		}
+
+		target := filepath.Clean(m.Target)
+		if from, ok := targets[target]; ok {
+			if from == "Mounts" {
+				return errors.Wrapf(errtypes.ErrInvalidParam, "duplicate mount point(%s) in Mounts", target)
+			}
+			return errors.Wrapf(errtypes.ErrInvalidParam, "duplicate mount point(%s): specified in both %s and Mounts", target, from)
+		}
+		targets[target] = "Mounts"
+	}
+
+	return nil
+}
+
+// validateMount validates the options of mount by its type.
+func validateMount(m *types.Mount) error {
+	if m.Target == "" {
+		return fmt.Errorf("target is required")
+	}
+	if !filepath.IsAbs(m.Target) {
+		return fmt.Errorf("target must be an absolute path")
+	}
+	if filepath.Clean(m.Target) == "/" {
+		return fmt.Errorf("target can't be /")
+	}
+
+	switch m.Type {
+	case types.MountTypeBind:
+		if m.VolumeOptions != nil || m.TmpfsOptions != nil {
+			return fmt.Errorf("volume or tmpfs options can't be used with bind mount")
+		}
+		if m.Source == "" {
+			return fmt.Errorf("source is required for bind mount")
+		}
+		if !filepath.IsAbs(m.Source) {
+			return fmt.Errorf("source of bind mount must be an absolute path")
+		}
+		if _, err := os.Stat(m.Source); err != nil {
+			if os.IsNotExist(err) {
+				return fmt.Errorf("bind source path(%s) does not exist", m.Source)
+			}
+			return err
+		}
+	case types.MountTypeVolume:
+		if m.BindOptions != nil || m.TmpfsOptions != nil {
+			return fmt.Errorf("bind or tmpfs options can't be used with volume mount")
+		}
+		if m.Source != "" && (filepath.IsAbs(m.Source) || strings.Contains(m.Source, "/")) {
+			return fmt.Errorf("source of volume mount must be a volume name")
+		}
+		if m.VolumeOptions != nil && m.VolumeOptions.Subpath != "" {
+			if err := opts.ValidateSubpath(m.VolumeOptions.Subpath); err != nil {
+				return err
+			}
+		}
+	case types.MountTypeTmpfs:
+		if m.BindOptions != nil || m.VolumeOptions != nil {
+			return fmt.Errorf("bind or volume options can't be used with tmpfs mount")
+		}
+		if m.Source != "" {
+			return fmt.Errorf("source can't be specified for tmpfs mount")
+		}
+		if m.TmpfsOptions != nil {
+			if m.TmpfsOptions.SizeBytes < 0 {
+				return fmt.Errorf("size of tmpfs mount can't be negative")
+			}
+			if m.TmpfsOptions.Mode < 0 || m.TmpfsOptions.Mode > 07777 {
+				return fmt.Errorf("invalid mode %o of tmpfs mount", m.TmpfsOptions.Mode)
+			}
+		}
+	case "":
+		return fmt.Errorf("type is required")
+	default:
+		return fmt.Errorf("unknown type %s", m.Type)
+	}
+
+	return nil
+}
+
 // validateRichMode verifies rich mode parameters
 func validateRichMode(c *Container) error {
 	richModes := []string{
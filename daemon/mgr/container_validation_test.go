@@ -181,3 +181,70 @@ func TestValidateResource(t *testing.T) {
 		assert.Equal(t, tc.errExpected, err)
 	}
 }
+
+func TestValidateMounts(t *testing.T) {
+	for _, tc := range []struct {
+		hostConfig *types.HostConfig
+		errMsg     string
+	}{
+		{
+			hostConfig: &types.HostConfig{
+				Binds: []string{"v1:/data:ro"},
+				Mounts: []*types.Mount{
+					{Type: types.MountTypeBind, Source: "/", Target: "/host"},
+					{Type: types.MountTypeVolume, Source: "v2", Target: "/data2"},
+					{Type: types.MountTypeTmpfs, Target: "/tmp", TmpfsOptions: &types.MountTmpfsOptions{SizeBytes: 1024, Mode: 01777}},
+				},
+			},
+		},
+		{
+			hostConfig: &types.HostConfig{
+				Binds:  []string{"/home:/data:ro"},
+				Mounts: []*types.Mount{{Type: types.MountTypeVolume, Target: "/data/"}},
+			},
+			errMsg: "duplicate mount point(/data): specified in both Binds and Mounts",
+		},
+		{
+			hostConfig: &types.HostConfig{
+				Mounts: []*types.Mount{
+					{Type: types.MountTypeVolume, Target: "/data"},
+					{Type: types.MountTypeTmpfs, Target: "/data"},
+				},
+			},
+			errMsg: "duplicate mount point(/data) in Mounts",
+		},
+		{
+			hostConfig: &types.HostConfig{
+				Mounts: []*types.Mount{{Type: types.MountTypeBind, Source: "/non-existent-path", Target: "/data"}},
+			},
+			errMsg: "bind source path(/non-existent-path) does not exist",
+		},
+		{
+			hostConfig: &types.HostConfig{
+				Mounts: []*types.Mount{{Type: types.MountTypeTmpfs, Source: "tmp", Target: "/tmp"}},
+			},
+			errMsg: "source can't be specified for tmpfs mount",
+		},
+		{
+			hostConfig: &types.HostConfig{
+				Mounts: []*types.Mount{{Type: types.MountTypeVolume, Target: "/data", BindOptions: &types.MountBindOptions{}}},
+			},
+			errMsg: "bind or tmpfs options can't be used with volume mount",
+		},
+		{
+			hostConfig: &types.HostConfig{
+				Mounts: []*types.Mount{{Type: types.MountTypeVolume, Target: "data"}},
+			},
+			errMsg: "target must be an absolute path",
+		},
+	} {
+		err := validateMounts(tc.hostConfig)
+		if tc.errMsg == "" {
+			assert.NoError(t, err)
+			continue
+		}
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), tc.errMsg)
+		}
+	}
+}
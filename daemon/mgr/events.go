@@ -43,7 +43,11 @@ func (vm *VolumeManager) LogVolumeEvent(ctx context.Context, volumeID, action st
 
 // LogNetworkEvent generates an event related to a network with only the default attributes
 func (nm *NetworkManager) LogNetworkEvent(ctx context.Context, nw libnetwork.Network, action string) {
-	attributes := map[string]string{}
+	nm.LogNetworkEventWithAttributes(ctx, nw, action, map[string]string{})
+}
+
+// LogNetworkEventWithAttributes generates an event related to a network with specific given attributes
+func (nm *NetworkManager) LogNetworkEventWithAttributes(ctx context.Context, nw libnetwork.Network, action string, attributes map[string]string) {
 	attributes["name"] = nw.Name()
 	attributes["type"] = nw.Type()
 	actor := &types.EventsActor{
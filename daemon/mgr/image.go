@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/reference"
 	"github.com/alibaba/pouch/pkg/utils"
+	"github.com/alibaba/pouch/registry"
 	searchtypes "github.com/alibaba/pouch/registry/types"
 
 	"github.com/containerd/containerd"
@@ -48,6 +50,10 @@ var (
 		"before":    true,
 		"since":     true,
 		"reference": true,
+		"platform":  true,
+		"dangling":  true,
+		"label":     true,
+		"unused":    true,
 	}
 
 	labelDigestRef = "io.alibaba.pouch.image.digestref"
@@ -58,12 +64,15 @@ type ImageMgr interface {
 	// LookupImageReferences find possible image reference list.
 	LookupImageReferences(ref string) []string
 
-	// PullImage pulls images from specified registry.
-	PullImage(ctx context.Context, ref string, authConfig *types.AuthConfig, out io.Writer) error
+	// PullImage pulls images of the platform from specified registry.
+	PullImage(ctx context.Context, ref, platform string, authConfig *types.AuthConfig, out io.Writer) error
 
 	// PushImage pushes image to specified registry.
 	PushImage(ctx context.Context, name, tag string, authConfig *types.AuthConfig, out io.Writer) error
 
+	// PushRepository pushes all the tags of repository to specified registry.
+	PushRepository(ctx context.Context, name string, authConfig *types.AuthConfig, out io.Writer) error
+
 	// GetImage returns imageInfo by reference or id.
 	GetImage(ctx context.Context, idOrRef string) (*types.ImageInfo, error)
 
@@ -73,6 +82,21 @@ type ImageMgr interface {
 	// Search Images from specified registry.
 	SearchImages(ctx context.Context, name, registry string, authConfig *types.AuthConfig) ([]types.SearchResultItem, error)
 
+	// InspectDistribution returns the image in registry without pulling it.
+	InspectDistribution(ctx context.Context, ref string, authConfig *types.AuthConfig) (*types.DistributionInspect, error)
+
+	// ListDistributionTags lists the tags of repository in registry.
+	ListDistributionTags(ctx context.Context, name string, authConfig *types.AuthConfig) (*types.DistributionTagsResp, error)
+
+	// CreateManifestList creates the manifest list of images in registry.
+	CreateManifestList(ctx context.Context, name string, images []string, amend bool, authConfig *types.AuthConfig) error
+
+	// AnnotateManifestList sets the platform of image in the manifest list.
+	AnnotateManifestList(ctx context.Context, name string, config *types.ManifestAnnotateConfig) error
+
+	// PushManifestList pushes the manifest list to registry.
+	PushManifestList(ctx context.Context, name string, authConfig *types.AuthConfig, out io.Writer) error
+
 	// RemoveImage deletes an image by reference.
 	RemoveImage(ctx context.Context, idOrRef string, force bool) error
 
@@ -99,6 +123,18 @@ type ImageMgr interface {
 
 	// GetOCIImageConfig returns the image config of OCI
 	GetOCIImageConfig(ctx context.Context, image string) (ocispec.ImageConfig, error)
+
+	// UnpackImage unpacks the layers of image into the snapshotter.
+	UnpackImage(ctx context.Context, idOrRef, snapshotter string) error
+
+	// CheckImagePolicy checks the local image against the image policy.
+	CheckImagePolicy(ctx context.Context, idOrRef string) error
+
+	// MarkImageUsed records the current time as the last used time of image.
+	MarkImageUsed(ctx context.Context, id digest.Digest)
+
+	// UpdateImageContainers adds delta to the number of containers using image.
+	UpdateImageContainers(id digest.Digest, delta int64)
 }
 
 // ImageManager is an implementation of interface ImageMgr.
@@ -121,11 +157,25 @@ type ImageManager struct {
 	// localStore is local cache of image reference information.
 	localStore *imageStore
 
+	// registry is used to access the registry API directly.
+	registry *registry.Client
+
 	// eventsService is used to publish events generated by pouchd
 	eventsService *events.Events
 
 	// imagePlugin is a plugin called before image operations
 	imagePlugin hookplugins.ImagePlugin
+
+	// allowMultiSnapshotter allows to unpack images into the snapshotter
+	// other than the one of daemon.
+	allowMultiSnapshotter bool
+
+	// imagePolicy decides which images are allowed to pull and run, nil
+	// means all images are allowed.
+	imagePolicy *imagePolicy
+
+	// gcPolicy decides when and how many images are removed by image gc.
+	gcPolicy imageGCPolicy
 }
 
 // NewImageManager initializes a brand new image manager.
@@ -135,6 +185,11 @@ func NewImageManager(cfg *config.Config, client ctrd.APIClient, eventsService *e
 		return nil, err
 	}
 
+	policy, err := loadImagePolicy(cfg.ImagePolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	mgr := &ImageManager{
 		DefaultRegistry:  cfg.DefaultRegistry,
 		DefaultNamespace: cfg.DefaultRegistryNS,
@@ -142,8 +197,13 @@ func NewImageManager(cfg *config.Config, client ctrd.APIClient, eventsService *e
 
 		client:        client,
 		localStore:    store,
+		registry:      registry.NewClient(cfg.RegistryConfigDir, cfg.InsecureRegistries),
 		eventsService: eventsService,
 		imagePlugin:   imagePlugin,
+
+		allowMultiSnapshotter: cfg.AllowMultiSnapshotter,
+		imagePolicy:           policy,
+		gcPolicy:              newImageGCPolicy(cfg),
 	}
 
 	if err := mgr.updateLocalStore(); err != nil {
@@ -189,13 +249,18 @@ func (mgr *ImageManager) LookupImageReferences(ref string) []string {
 	return fullRefs
 }
 
-// PullImage pulls images from specified registry.
-func (mgr *ImageManager) PullImage(ctx context.Context, ref string, authConfig *types.AuthConfig, out io.Writer) error {
+// PullImage pulls images from specified registry. The image of host
+// platform is pulled if the platform is empty.
+func (mgr *ImageManager) PullImage(ctx context.Context, ref, platform string, authConfig *types.AuthConfig, out io.Writer) error {
 	namedRef, err := reference.Parse(ref)
 	if err != nil {
 		return err
 	}
 
+	if _, err := ctrd.ParsePlatform(platform); err != nil {
+		return pkgerrors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+
 	pctx, cancel := context.WithCancel(ctx)
 	stream := jsonstream.New(out, nil)
 
@@ -228,8 +293,42 @@ func (mgr *ImageManager) PullImage(ctx context.Context, ref string, authConfig *
 	}
 	log.With(nil).Infof("pulling image name %v reference %v", namedRef.String(), availableRef)
 
-	img, err := mgr.client.FetchImage(pctx, resolver, availableRef, authConfig, stream)
+	availableNamed, err := reference.Parse(availableRef)
+	if err != nil {
+		return err
+	}
+
+	verified, err := mgr.enforceImagePolicy(ctx, availableNamed, "", resolver)
+	if err != nil {
+		return err
+	}
+
+	// the tag may be moved after the signature is verified, so only the
+	// verified image is allowed to be fetched.
+	if verified != "" {
+		resolver = &verifiedResolver{Resolver: resolver, verified: verified}
+	}
+
+	img, err := mgr.client.FetchImageWithOptions(pctx, resolver, availableRef, authConfig, stream, ctrd.FetchOptions{Platform: platform})
 	if err != nil {
+		if errtypes.IsPolicyDenied(err) {
+			mgr.LogImageEventWithAttributes(ctx, availableRef, availableRef, "deny", map[string]string{
+				"reason": err.Error(),
+			})
+		}
+		writeStream(err)
+		return err
+	}
+
+	// the image denied should never be left locally.
+	if verified != "" && img.Target().Digest != verified {
+		err = pkgerrors.Wrapf(errtypes.ErrPolicyDenied, "image %s is changed to %s after verified", availableRef, img.Target().Digest)
+		mgr.LogImageEventWithAttributes(ctx, availableRef, availableRef, "deny", map[string]string{
+			"reason": err.Error(),
+		})
+		if rmErr := mgr.client.RemoveImage(ctx, availableRef); rmErr != nil {
+			log.With(ctx).Errorf("failed to remove denied image %s: %v", availableRef, rmErr)
+		}
 		writeStream(err)
 		return err
 	}
@@ -259,7 +358,16 @@ func (mgr *ImageManager) PullImage(ctx context.Context, ref string, authConfig *
 
 	mgr.LogImageEvent(ctx, img.Name(), namedRef.String(), "pull")
 
-	return mgr.StoreImageReference(ctx, img)
+	if err := mgr.StoreImageReference(ctx, img); err != nil {
+		return err
+	}
+
+	imgCfg, err := img.Config(ctx)
+	if err != nil {
+		return err
+	}
+	mgr.MarkImageUsed(ctx, imgCfg.Digest)
+	return nil
 }
 
 // PushImage pushes image to specified registry.
@@ -278,6 +386,44 @@ func (mgr *ImageManager) PushImage(ctx context.Context, name, tag string, authCo
 	return mgr.client.PushImage(ctx, ref.String(), authConfig, out)
 }
 
+// PushRepository pushes all the local tags of repository in order.
+func (mgr *ImageManager) PushRepository(ctx context.Context, name string, authConfig *types.AuthConfig, out io.Writer) error {
+	named, err := reference.Parse(name)
+	if err != nil {
+		return pkgerrors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+	if !reference.IsNamedOnly(named) {
+		return pkgerrors.Wrapf(errtypes.ErrInvalidParam, "repository name %s should not contain tag or digest", name)
+	}
+
+	refs := mgr.repositoryTags(named.Name())
+	if len(refs) == 0 {
+		return pkgerrors.Wrapf(errtypes.ErrNotfound, "no tag of repository %s", name)
+	}
+
+	for _, ref := range refs {
+		mgr.LogImageEvent(ctx, ref, ref, "push")
+		if err := mgr.client.PushImage(ctx, ref, authConfig, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// repositoryTags returns the sorted tagged references of repository.
+func (mgr *ImageManager) repositoryTags(name string) []string {
+	var refs []string
+	for _, info := range mgr.localStore.ListCtrdImageInfo() {
+		for _, ref := range mgr.localStore.GetPrimaryReferences(info.ID) {
+			if ref.Name() == name && reference.IsNameTagged(ref) {
+				refs = append(refs, ref.String())
+			}
+		}
+	}
+	sort.Strings(refs)
+	return refs
+}
+
 // GetImage returns imageInfo by reference.
 func (mgr *ImageManager) GetImage(ctx context.Context, idOrRef string) (*types.ImageInfo, error) {
 	id, _, _, err := mgr.CheckReference(ctx, idOrRef)
@@ -301,6 +447,7 @@ func (mgr *ImageManager) ListImages(ctx context.Context, filter filters.Args) ([
 	beforeImages := filter.Get("before")
 	sinceImages := filter.Get("since")
 	referenceFilter := filter.Get("reference")
+	platformFilter := filter.Get("platform")
 
 	// refuse undefined behavior
 	if len(beforeImages) > 1 {
@@ -311,13 +458,21 @@ func (mgr *ImageManager) ListImages(ctx context.Context, filter filters.Args) ([
 		return nil, pkgerrors.Wrapf(errtypes.ErrInvalidParam, "can't use since filter more than one")
 	}
 
+	danglingFilter, err := parseBoolFilter("dangling", filter.Get("dangling"))
+	if err != nil {
+		return nil, err
+	}
+	unusedFilter, err := parseBoolFilter("unused", filter.Get("unused"))
+	if err != nil {
+		return nil, err
+	}
+
 	ctrdImageInfos := mgr.localStore.ListCtrdImageInfo()
 	imgInfos := make([]types.ImageInfo, 0, len(ctrdImageInfos))
 
 	var (
 		beforeFilter, sinceFilter *types.ImageInfo
 		beforeTime, sinceTime     time.Time
-		err                       error
 	)
 
 	if len(beforeImages) > 0 {
@@ -354,12 +509,33 @@ func (mgr *ImageManager) ListImages(ctx context.Context, filter filters.Args) ([
 			}
 		}
 
+		matched, err := filterPlatform(platformFilter, img.Platform)
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			continue
+		}
+
+		if !filter.MatchKVList("label", img.OCISpec.Config.Labels) {
+			continue
+		}
+
+		if unusedFilter != nil && *unusedFilter != (img.Containers == 0) {
+			continue
+		}
+
 		imgInfo, err := mgr.containerdImageToImageInfo(ctx, img.ID)
 		if err != nil {
 			log.With(nil).Warnf("failed to convert containerd image(%v) to ImageInfo during list images: %v", img.ID, err)
 			continue
 		}
 
+		// the image without any tag is dangling
+		if danglingFilter != nil && *danglingFilter != (len(imgInfo.RepoTags) == 0) {
+			continue
+		}
+
 		if len(referenceFilter) == 0 {
 			imgInfos = append(imgInfos, imgInfo)
 			continue
@@ -384,7 +560,8 @@ func (mgr *ImageManager) ListImages(ctx context.Context, filter filters.Args) ([
 	return imgInfos, nil
 }
 
-// SearchImages searches imaged from specified registry.
+// SearchImages searches imaged from specified registry. The repositories in
+// catalog of registry are searched if the v1 search API is not served.
 func (mgr *ImageManager) SearchImages(ctx context.Context, name, registry string, auth *types.AuthConfig) ([]types.SearchResultItem, error) {
 	// Directly send API calls towards specified registry
 	if len(registry) == 0 {
@@ -407,6 +584,10 @@ func (mgr *ImageManager) SearchImages(ctx context.Context, name, registry string
 	}
 	defer res.Body.Close()
 
+	if res.StatusCode == http.StatusNotFound {
+		return mgr.searchCatalog(ctx, name, registry, auth)
+	}
+
 	if res.StatusCode != 200 {
 		return nil, fmt.Errorf("unexepected status code %d", res.StatusCode)
 	}
@@ -430,6 +611,55 @@ func (mgr *ImageManager) SearchImages(ctx context.Context, name, registry string
 	return result, err
 }
 
+// searchCatalog searches the repositories containing name in the catalog
+// of v2 registry.
+func (mgr *ImageManager) searchCatalog(ctx context.Context, name, registry string, auth *types.AuthConfig) ([]types.SearchResultItem, error) {
+	host := registry
+	if u, err := url.Parse(registry); err == nil && u.Host != "" {
+		host = u.Host
+	}
+
+	repos, err := mgr.registry.Catalog(ctx, host, auth)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []types.SearchResultItem
+	for _, repo := range repos {
+		if strings.Contains(repo, name) {
+			result = append(result, types.SearchResultItem{Name: repo})
+		}
+	}
+	return result, nil
+}
+
+// InspectDistribution returns the descriptor, platforms and manifest of
+// image in registry without pulling it.
+func (mgr *ImageManager) InspectDistribution(ctx context.Context, ref string, authConfig *types.AuthConfig) (*types.DistributionInspect, error) {
+	fullRef := addDefaultRegistryIfMissing(ref, mgr.DefaultRegistry, mgr.DefaultNamespace)
+	return mgr.registry.Inspect(ctx, fullRef, authConfig)
+}
+
+// ListDistributionTags lists the tags of repository in registry.
+func (mgr *ImageManager) ListDistributionTags(ctx context.Context, name string, authConfig *types.AuthConfig) (*types.DistributionTagsResp, error) {
+	named, err := reference.Parse(addDefaultRegistryIfMissing(name, mgr.DefaultRegistry, mgr.DefaultNamespace))
+	if err != nil {
+		return nil, pkgerrors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+	if !reference.IsNamedOnly(named) {
+		return nil, pkgerrors.Wrapf(errtypes.ErrInvalidParam, "repository name %s should not contain tag or digest", name)
+	}
+
+	tags, err := mgr.registry.Tags(ctx, named.Name(), authConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &types.DistributionTagsResp{
+		Name: named.Name(),
+		Tags: tags,
+	}, nil
+}
+
 // RemoveImage deletes a reference.
 //
 // NOTE: if the reference is short ID or ID, should remove all the references.
@@ -575,10 +805,12 @@ func (mgr *ImageManager) AddTag(ctx context.Context, sourceImage string, targetT
 		return err
 	}
 
-	// add the reference into containerd meta db
+	// add the reference into containerd meta db, the platform label
+	// is kept so that the content of the same platform is used.
 	_, err = mgr.client.CreateImageReference(ctx, ctrdmetaimages.Image{
 		Name:   tagRef.String(),
 		Target: ctrdImg.Target(),
+		Labels: platformLabels(ctrdImg.Labels()),
 	})
 	mgr.LogImageEvent(ctx, sourceImage, tagRef.String(), "tag")
 	return err
@@ -602,7 +834,7 @@ func (mgr *ImageManager) ImageHistory(ctx context.Context, idOrRef string) ([]ty
 	}
 
 	cs := img.ContentStore()
-	manifest, err := mgr.getManifest(ctx, cs, img, platforms.Default())
+	manifest, err := mgr.getManifest(ctx, cs, img, ctrd.ImagePlatformMatcher(img.Labels()))
 	if err != nil {
 		return nil, err
 	}
@@ -812,12 +1044,16 @@ func (mgr *ImageManager) StoreImageReference(ctx context.Context, img containerd
 		// 1. the name@digest has been pulled by user and we can't
 		// change it.
 		// 2. the existing one is created by restarting pouch
+		labels := platformLabels(img.Labels())
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[labelDigestRef] = "managed"
+
 		if _, err := mgr.client.CreateImageReference(ctx, ctrdmetaimages.Image{
 			Name:   digRef.String(),
 			Target: img.Target(),
-			Labels: map[string]string{
-				labelDigestRef: "managed",
-			},
+			Labels: labels,
 		}); err != nil && !errtypes.IsAlreadyExisted(err) {
 			return err
 		}
@@ -828,9 +1064,11 @@ func (mgr *ImageManager) StoreImageReference(ctx context.Context, img containerd
 	}
 
 	mgr.localStore.CacheCtrdImageInfo(imgCfg.Digest, CtrdImageInfo{
-		ID:      imgCfg.Digest,
-		Size:    size,
-		OCISpec: ociImage,
+		ID:       imgCfg.Digest,
+		Size:     size,
+		OCISpec:  ociImage,
+		Platform: imagePlatform(img.Labels(), ociImage),
+		LastUsed: imageLastUsed(img.Labels()),
 	})
 	return nil
 }
@@ -880,11 +1118,18 @@ func (mgr *ImageManager) containerdImageToImageInfo(ctx context.Context, id dige
 		}
 	}
 
+	var lastUsed string
+	if !ctrdImageInfo.LastUsed.IsZero() {
+		lastUsed = ctrdImageInfo.LastUsed.Format(utils.TimeLayout)
+	}
+
 	return types.ImageInfo{
 		Architecture: ociImage.Architecture,
 		Config:       getImageInfoConfigFromOciImage(ociImage),
+		Containers:   ctrdImageInfo.Containers,
 		CreatedAt:    ociImage.Created.Format(utils.TimeLayout),
 		ID:           ctrdImageInfo.ID.String(),
+		LastUsed:     lastUsed,
 		Os:           ociImage.OS,
 		RepoDigests:  repoDigests,
 		RepoTags:     repoTags,
@@ -892,7 +1137,8 @@ func (mgr *ImageManager) containerdImageToImageInfo(ctx context.Context, id dige
 			Type:   ociImage.RootFS.Type,
 			Layers: digestSliceToStringSlice(ociImage.RootFS.DiffIDs),
 		},
-		Size: ctrdImageInfo.Size,
+		Size:    ctrdImageInfo.Size,
+		Variant: ctrdImageInfo.Platform.Variant,
 	}, nil
 }
 
@@ -0,0 +1,256 @@
+package mgr
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/alibaba/pouch/apis/metrics"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/daemon/config"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/opencontainers/go-digest"
+)
+
+const (
+	// labelImageLastUsed records the last time the image is pulled or used
+	// by container in the containerd image meta data.
+	labelImageLastUsed = "io.alibaba.pouch.image.lastused"
+
+	// labelImageGCProtected protects the image from image gc if it is set
+	// to true in the image config.
+	labelImageGCProtected = "io.alibaba.pouch.image.gc.protected"
+
+	// snapshotterPluginPrefix is the prefix of snapshotter root directory
+	// in the containerd root.
+	snapshotterPluginPrefix = "io.containerd.snapshotter.v1"
+)
+
+// imageGCPolicy decides when and how many images are removed by image gc.
+type imageGCPolicy struct {
+	// highThreshold is the percent of disk usage which triggers image gc,
+	// zero means image gc is disabled.
+	highThreshold int
+
+	// lowThreshold is the percent of disk usage which image gc frees to.
+	lowThreshold int
+
+	// period is the period to check the disk usage.
+	period time.Duration
+
+	// fsPath is the path on the filesystem which stores images.
+	fsPath string
+}
+
+func newImageGCPolicy(cfg *config.Config) imageGCPolicy {
+	snapshotter := ctrd.CurrentSnapshotterName(context.TODO())
+
+	return imageGCPolicy{
+		highThreshold: cfg.ImageGCHighThreshold,
+		lowThreshold:  cfg.ImageGCLowThreshold,
+		period:        time.Duration(cfg.ImageGCPeriod) * time.Second,
+		fsPath:        filepath.Join(cfg.HomeDir, "containerd/root", snapshotterPluginPrefix+"."+snapshotter),
+	}
+}
+
+// bytesToFree returns the bytes to free if the usage exceeds the high
+// threshold, or zero.
+func (p imageGCPolicy) bytesToFree(used, total uint64) uint64 {
+	if p.highThreshold <= 0 || total == 0 {
+		return 0
+	}
+
+	if used*100 < uint64(p.highThreshold)*total {
+		return 0
+	}
+	return used - uint64(p.lowThreshold)*total/100
+}
+
+// imageLastUsed returns the last used time recorded in labels.
+func imageLastUsed(labels map[string]string) time.Time {
+	t, err := time.Parse(time.RFC3339Nano, labels[labelImageLastUsed])
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// MarkImageUsed records the current time as the last used time of image,
+// which is kept in the containerd image meta data of primary references.
+func (mgr *ImageManager) MarkImageUsed(ctx context.Context, id digest.Digest) {
+	now := time.Now()
+	mgr.localStore.UpdateLastUsed(id, now)
+
+	labels := map[string]string{
+		labelImageLastUsed: now.UTC().Format(time.RFC3339Nano),
+	}
+	for _, ref := range mgr.localStore.GetPrimaryReferences(id) {
+		if err := mgr.client.UpdateImageLabels(ctx, ref.String(), labels); err != nil {
+			log.With(ctx).Warnf("failed to record last used time of image %s: %v", ref.String(), err)
+		}
+	}
+}
+
+// UpdateImageContainers adds delta to the number of containers using image,
+// which is counted when the containers are loaded, created and removed.
+func (mgr *ImageManager) UpdateImageContainers(id digest.Digest, delta int64) {
+	mgr.localStore.UpdateContainers(id, delta)
+}
+
+// StartImageGC starts the image gc loop until ctx is done. The images used
+// by the containers are kept.
+func (mgr *ImageManager) StartImageGC(ctx context.Context, ctrMgr ContainerMgr) {
+	if mgr.gcPolicy.highThreshold <= 0 {
+		return
+	}
+
+	log.With(nil).Infof("start image gc on %s, high threshold %d%%, low threshold %d%%",
+		mgr.gcPolicy.fsPath, mgr.gcPolicy.highThreshold, mgr.gcPolicy.lowThreshold)
+
+	go func() {
+		ticker := time.NewTicker(mgr.gcPolicy.period)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if err := mgr.garbageCollectImages(ctx, ctrMgr); err != nil {
+				log.With(nil).Errorf("failed to gc images: %v", err)
+			}
+		}
+	}()
+}
+
+// garbageCollectImages removes the unused images in LRU order until the
+// disk usage falls below the low threshold.
+func (mgr *ImageManager) garbageCollectImages(ctx context.Context, ctrMgr ContainerMgr) error {
+	start := time.Now()
+
+	used, total, err := fsUsage(mgr.gcPolicy.fsPath)
+	if err != nil {
+		return err
+	}
+
+	toFree := mgr.gcPolicy.bytesToFree(used, total)
+	if toFree == 0 {
+		return nil
+	}
+
+	ids, err := imagesInUse(ctx, ctrMgr)
+	if err != nil {
+		return err
+	}
+
+	candidates := selectImagesToRemove(mgr.localStore.ListCtrdImageInfo(), ids, toFree, start)
+	log.With(nil).Infof("image gc is triggered, disk usage %d/%d, %d bytes to free, %d images to remove",
+		used, total, toFree, len(candidates))
+
+	for _, img := range candidates {
+		// the image may be used after gc started.
+		if info, err := mgr.localStore.GetCtrdImageInfo(img.ID); err != nil || info.LastUsed.After(start) || info.Containers > 0 {
+			continue
+		}
+		if used, err := imageInUse(ctx, ctrMgr, img.ID); err != nil || used {
+			continue
+		}
+
+		refName := ""
+		if refs := mgr.localStore.GetPrimaryReferences(img.ID); len(refs) > 0 {
+			refName = refs[0].String()
+		}
+
+		if err := mgr.RemoveImage(ctx, img.ID.String(), false); err != nil {
+			log.With(nil).Warnf("failed to remove image %s by image gc: %v", img.ID, err)
+			metrics.ImageGCRemovedCounter.WithLabelValues("failure").Inc()
+			continue
+		}
+
+		metrics.ImageGCRemovedCounter.WithLabelValues("success").Inc()
+		metrics.ImageGCReclaimedBytes.WithLabelValues().Add(float64(img.Size))
+
+		mgr.LogImageEventWithAttributes(ctx, img.ID.String(), refName, "gc", map[string]string{
+			"size":     strconv.FormatInt(img.Size, 10),
+			"lastUsed": img.LastUsed.Format(time.RFC3339Nano),
+		})
+	}
+	return nil
+}
+
+// imagesInUse returns the ids of images used by the containers.
+func imagesInUse(ctx context.Context, ctrMgr ContainerMgr) (map[digest.Digest]struct{}, error) {
+	containers, err := ctrMgr.List(ctx, &ContainerListOption{All: true})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[digest.Digest]struct{}, len(containers))
+	for _, c := range containers {
+		ids[digest.Digest(c.Image)] = struct{}{}
+	}
+	return ids, nil
+}
+
+// imageInUse checks whether any container uses the image.
+func imageInUse(ctx context.Context, ctrMgr ContainerMgr, id digest.Digest) (bool, error) {
+	containers, err := ctrMgr.List(ctx, &ContainerListOption{
+		All: true,
+		FilterFunc: func(c *Container) bool {
+			return c.Image == id.String()
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(containers) > 0, nil
+}
+
+// selectImagesToRemove returns the least recently used images until the
+// total size reaches the bytes to free. The images in use, protected by
+// label or used after the gc started are skipped.
+func selectImagesToRemove(infos []CtrdImageInfo, inUse map[digest.Digest]struct{}, toFree uint64, start time.Time) []CtrdImageInfo {
+	var candidates []CtrdImageInfo
+	for _, info := range infos {
+		if _, ok := inUse[info.ID]; ok {
+			continue
+		}
+		if info.OCISpec.Config.Labels[labelImageGCProtected] == "true" {
+			continue
+		}
+		if info.LastUsed.After(start) {
+			continue
+		}
+		candidates = append(candidates, info)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].LastUsed.Before(candidates[j].LastUsed)
+	})
+
+	var freed uint64
+	for i, c := range candidates {
+		if freed >= toFree {
+			return candidates[:i]
+		}
+		freed += uint64(c.Size)
+	}
+	return candidates
+}
+
+// fsUsage returns the used and total bytes of the filesystem holding path.
+func fsUsage(path string) (uint64, uint64, error) {
+	var stfs syscall.Statfs_t
+	if err := syscall.Statfs(path, &stfs); err != nil {
+		return 0, 0, err
+	}
+
+	used := (stfs.Blocks - stfs.Bfree) * uint64(stfs.Bsize)
+	return used, used + stfs.Bavail*uint64(stfs.Bsize), nil
+}
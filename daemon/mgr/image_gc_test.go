@@ -0,0 +1,51 @@
+package mgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageGCPolicyBytesToFree(t *testing.T) {
+	p := imageGCPolicy{highThreshold: 85, lowThreshold: 80}
+	assert.Equal(t, uint64(0), p.bytesToFree(84, 100))
+	assert.Equal(t, uint64(5), p.bytesToFree(85, 100))
+	assert.Equal(t, uint64(20), p.bytesToFree(100, 100))
+	assert.Equal(t, uint64(0), p.bytesToFree(0, 0))
+
+	// image gc is disabled.
+	assert.Equal(t, uint64(0), imageGCPolicy{}.bytesToFree(100, 100))
+}
+
+func TestSelectImagesToRemove(t *testing.T) {
+	start := time.Now()
+	newInfo := func(name string, size int64, lastUsed time.Time, labels map[string]string) CtrdImageInfo {
+		info := CtrdImageInfo{
+			ID:       digest.FromString(name),
+			Size:     size,
+			LastUsed: lastUsed,
+		}
+		info.OCISpec.Config.Labels = labels
+		return info
+	}
+
+	var (
+		unknown   = newInfo("unknown", 10, time.Time{}, nil)
+		oldest    = newInfo("oldest", 10, start.Add(-3*time.Hour), nil)
+		older     = newInfo("older", 10, start.Add(-2*time.Hour), nil)
+		old       = newInfo("old", 10, start.Add(-time.Hour), nil)
+		inUse     = newInfo("in-use", 10, start.Add(-4*time.Hour), nil)
+		protected = newInfo("protected", 10, time.Time{}, map[string]string{labelImageGCProtected: "true"})
+		newer     = newInfo("newer", 10, start.Add(time.Second), nil)
+	)
+
+	infos := []CtrdImageInfo{old, newer, protected, oldest, inUse, older, unknown}
+	used := map[digest.Digest]struct{}{inUse.ID: {}}
+
+	assert.Equal(t, []CtrdImageInfo{unknown, oldest}, selectImagesToRemove(infos, used, 15, start))
+	assert.Equal(t, []CtrdImageInfo{unknown, oldest, older}, selectImagesToRemove(infos, used, 30, start))
+	assert.Equal(t, []CtrdImageInfo{unknown, oldest, older, old}, selectImagesToRemove(infos, used, 1000, start))
+	assert.Len(t, selectImagesToRemove(infos, used, 0, start), 0)
+}
@@ -0,0 +1,342 @@
+package mgr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/reference"
+	"github.com/alibaba/pouch/registry"
+
+	ctrdmetaimages "github.com/containerd/containerd/images"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// manifestListLabelPrefix is the prefix of the labels which record the
+	// names of manifest list content, the suffix is the name of the list.
+	// The lists with the same content share one content in store, so each
+	// of them has its own label.
+	manifestListLabelPrefix = "io.alibaba.pouch.manifest.list."
+
+	// manifestImageLabelPrefix is the prefix of the labels which record the
+	// image of each manifest in the list, the suffix is the index.
+	manifestImageLabelPrefix = "io.alibaba.pouch.manifest.image."
+
+	// the labels to keep the manifest list and its manifests from the gc
+	// of containerd.
+	gcRootLabel             = "containerd.io/gc.root"
+	gcRefContentLabelPrefix = "containerd.io/gc.ref.content.m."
+)
+
+// manifestList is the manifest list stored in the content store.
+type manifestList struct {
+	desc  ocispec.Descriptor
+	index ocispec.Index
+
+	// images are the references of manifests in the index.
+	images []string
+}
+
+// manifestListData is the stored data of manifest list, the media type is
+// set since some registries reject the index without it.
+type manifestListData struct {
+	MediaType string `json:"mediaType,omitempty"`
+	ocispec.Index
+}
+
+// normalizeManifestRef returns the full reference of manifest list or image
+// with the default tag.
+func (mgr *ImageManager) normalizeManifestRef(ref string) (reference.Named, error) {
+	named, err := reference.Parse(addDefaultRegistryIfMissing(ref, mgr.DefaultRegistry, mgr.DefaultNamespace))
+	if err != nil {
+		return nil, pkgerrors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+	return reference.WithDefaultTagIfMissing(named), nil
+}
+
+// CreateManifestList creates the manifest list of images in registry, or adds
+// the images to the existing one if amend is set. The list is stored in the
+// content store until it is pushed.
+func (mgr *ImageManager) CreateManifestList(ctx context.Context, name string, images []string, amend bool, authConfig *types.AuthConfig) error {
+	named, err := mgr.normalizeManifestRef(name)
+	if err != nil {
+		return err
+	}
+	if reference.IsCanonicalDigested(named) {
+		return pkgerrors.Wrapf(errtypes.ErrInvalidParam, "manifest list %s should not be a digest reference", name)
+	}
+
+	host, _, err := registry.SplitName(named.Name())
+	if err != nil {
+		return err
+	}
+
+	list, err := mgr.getManifestList(ctx, named.String())
+	switch {
+	case err == nil && !amend:
+		return pkgerrors.Wrapf(errtypes.ErrAlreadyExisted, "manifest list %s already exists, use amend to add images to it", named)
+	case err != nil && !errtypes.IsNotfound(err):
+		return err
+	case err != nil:
+		list = &manifestList{
+			index: ocispec.Index{Versioned: specs.Versioned{SchemaVersion: 2}},
+		}
+	}
+
+	for _, image := range images {
+		imgNamed, err := mgr.normalizeManifestRef(image)
+		if err != nil {
+			return err
+		}
+
+		imgHost, imgRepo, err := registry.SplitName(imgNamed.Name())
+		if err != nil {
+			return err
+		}
+		if imgHost != host {
+			return pkgerrors.Wrapf(errtypes.ErrInvalidParam, "image %s should be in the same registry %s as manifest list", image, host)
+		}
+
+		desc, data, platforms, err := mgr.registry.ResolveManifest(ctx, imgNamed.String(), authConfig)
+		if err != nil {
+			return pkgerrors.Wrapf(err, "failed to resolve image %s", image)
+		}
+		if desc.MediaType == ocispec.MediaTypeImageIndex || desc.MediaType == ctrdmetaimages.MediaTypeDockerSchema2ManifestList {
+			return pkgerrors.Wrapf(errtypes.ErrInvalidParam, "image %s is a manifest list", image)
+		}
+
+		// the manifest is kept locally to push it with the list, and its
+		// blobs are mounted from the repository of image.
+		if err := mgr.client.WriteContent(ctx, desc, data, map[string]string{
+			ctrd.LabelDistributionSource + host: imgRepo,
+		}); err != nil {
+			return pkgerrors.Wrapf(err, "failed to store manifest of image %s", image)
+		}
+
+		if len(platforms) > 0 {
+			desc.Platform = &platforms[0]
+		}
+		list.set(imgNamed.String(), desc)
+	}
+
+	return mgr.saveManifestList(ctx, named.String(), list)
+}
+
+// AnnotateManifestList sets the platform of image in the manifest list.
+func (mgr *ImageManager) AnnotateManifestList(ctx context.Context, name string, config *types.ManifestAnnotateConfig) error {
+	named, err := mgr.normalizeManifestRef(name)
+	if err != nil {
+		return err
+	}
+
+	list, err := mgr.getManifestList(ctx, named.String())
+	if err != nil {
+		return err
+	}
+
+	imgNamed, err := mgr.normalizeManifestRef(config.Image)
+	if err != nil {
+		return err
+	}
+
+	idx := list.find(imgNamed)
+	if idx == -1 {
+		return pkgerrors.Wrapf(errtypes.ErrNotfound, "image %s is not in manifest list %s", config.Image, named)
+	}
+
+	m := &list.index.Manifests[idx]
+	if m.Platform == nil {
+		m.Platform = &ocispec.Platform{}
+	}
+	if config.Os != "" {
+		m.Platform.OS = config.Os
+	}
+	if config.Architecture != "" {
+		m.Platform.Architecture = config.Architecture
+	}
+	if config.Variant != "" {
+		m.Platform.Variant = config.Variant
+	}
+
+	return mgr.saveManifestList(ctx, named.String(), list)
+}
+
+// PushManifestList pushes the manifest list to registry.
+func (mgr *ImageManager) PushManifestList(ctx context.Context, name string, authConfig *types.AuthConfig, out io.Writer) error {
+	named, err := mgr.normalizeManifestRef(name)
+	if err != nil {
+		return err
+	}
+
+	list, err := mgr.getManifestList(ctx, named.String())
+	if err != nil {
+		return err
+	}
+
+	mgr.LogImageEvent(ctx, named.String(), named.String(), "push")
+	return mgr.client.PushManifestList(ctx, named.String(), list.desc, authConfig, out)
+}
+
+// getManifestList returns the manifest list by its full reference.
+func (mgr *ImageManager) getManifestList(ctx context.Context, ref string) (*manifestList, error) {
+	infos, err := mgr.client.ListContent(ctx, fmt.Sprintf("labels.%q", manifestListLabelPrefix+ref))
+	if err != nil {
+		return nil, err
+	}
+	if len(infos) == 0 {
+		return nil, pkgerrors.Wrapf(errtypes.ErrNotfound, "manifest list %s", ref)
+	}
+
+	data, labels, err := mgr.client.ReadContent(ctx, infos[0].Digest)
+	if err != nil {
+		return nil, err
+	}
+
+	var listData manifestListData
+	if err := json.Unmarshal(data, &listData); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to decode manifest list %s", ref)
+	}
+	if listData.MediaType == "" {
+		listData.MediaType = ocispec.MediaTypeImageIndex
+	}
+
+	list := &manifestList{
+		desc: ocispec.Descriptor{
+			MediaType: listData.MediaType,
+			Digest:    infos[0].Digest,
+			Size:      int64(len(data)),
+		},
+		index: listData.Index,
+	}
+	for i := range list.index.Manifests {
+		list.images = append(list.images, labels[manifestImageLabelPrefix+strconv.Itoa(i)])
+	}
+	return list, nil
+}
+
+// saveManifestList stores the manifest list with the labels referring its
+// manifests, and releases the previous content of the list.
+func (mgr *ImageManager) saveManifestList(ctx context.Context, ref string, list *manifestList) error {
+	mediaType := list.mediaType()
+	data, err := json.Marshal(manifestListData{
+		MediaType: mediaType,
+		Index:     list.index,
+	})
+	if err != nil {
+		return err
+	}
+
+	desc := ocispec.Descriptor{
+		MediaType: mediaType,
+		Digest:    digest.FromBytes(data),
+		Size:      int64(len(data)),
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	labels := map[string]string{
+		manifestListLabelPrefix + ref: now,
+		gcRootLabel:                   now,
+	}
+	for i, m := range list.index.Manifests {
+		labels[gcRefContentLabelPrefix+strconv.Itoa(i)] = m.Digest.String()
+		labels[manifestImageLabelPrefix+strconv.Itoa(i)] = list.images[i]
+	}
+
+	if err := mgr.client.WriteContent(ctx, desc, data, labels); err != nil {
+		return pkgerrors.Wrapf(err, "failed to store manifest list %s", ref)
+	}
+
+	if list.desc.Digest != "" && list.desc.Digest != desc.Digest {
+		if err := mgr.releaseManifestList(ctx, ref, list.desc.Digest); err != nil {
+			return pkgerrors.Wrapf(err, "failed to release the previous manifest list %s", ref)
+		}
+	}
+	return nil
+}
+
+// releaseManifestList removes the name of list from the content, the gc root
+// of content is removed only if no other list shares it.
+func (mgr *ImageManager) releaseManifestList(ctx context.Context, ref string, dgst digest.Digest) error {
+	_, labels, err := mgr.client.ReadContent(ctx, dgst)
+	if err != nil {
+		if errtypes.IsNotfound(err) {
+			return nil
+		}
+		return err
+	}
+
+	update := map[string]string{
+		manifestListLabelPrefix + ref: "",
+	}
+
+	shared := false
+	for key := range labels {
+		if strings.HasPrefix(key, manifestListLabelPrefix) && key != manifestListLabelPrefix+ref {
+			shared = true
+			break
+		}
+	}
+	if !shared {
+		update[gcRootLabel] = ""
+	}
+
+	return mgr.client.UpdateContentLabels(ctx, dgst, update)
+}
+
+// mediaType returns the media type of the list, the docker manifest list is
+// used if all the manifests are docker schema2 manifests, otherwise the oci
+// index is used.
+func (list *manifestList) mediaType() string {
+	if len(list.index.Manifests) == 0 {
+		return ocispec.MediaTypeImageIndex
+	}
+	for _, m := range list.index.Manifests {
+		if m.MediaType != ctrdmetaimages.MediaTypeDockerSchema2Manifest {
+			return ocispec.MediaTypeImageIndex
+		}
+	}
+	return ctrdmetaimages.MediaTypeDockerSchema2ManifestList
+}
+
+// set adds the manifest of image to the list, or replaces the one of the
+// same image.
+func (list *manifestList) set(image string, desc ocispec.Descriptor) {
+	for i := range list.images {
+		if list.images[i] == image {
+			list.index.Manifests[i] = desc
+			return
+		}
+	}
+	list.index.Manifests = append(list.index.Manifests, desc)
+	list.images = append(list.images, image)
+}
+
+// find returns the index of image in the list, the image is matched by
+// digest if it is a digest reference. It returns -1 if not found.
+func (list *manifestList) find(image reference.Named) int {
+	for i := range list.images {
+		if list.images[i] == image.String() {
+			return i
+		}
+	}
+
+	if digested, ok := image.(reference.Digested); ok {
+		for i, m := range list.index.Manifests {
+			if m.Digest == digested.Digest() {
+				return i
+			}
+		}
+	}
+	return -1
+}
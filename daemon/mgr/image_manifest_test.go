@@ -0,0 +1,59 @@
+package mgr
+
+import (
+	"testing"
+
+	"github.com/alibaba/pouch/pkg/reference"
+
+	ctrdmetaimages "github.com/containerd/containerd/images"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManifestListSetAndFind(t *testing.T) {
+	var (
+		amd64 = ocispec.Descriptor{Digest: digest.FromString("amd64")}
+		arm64 = ocispec.Descriptor{Digest: digest.FromString("arm64")}
+		list  = &manifestList{}
+	)
+
+	list.set("reg.example.com/app:amd64", amd64)
+	list.set("reg.example.com/app:arm64", amd64)
+	// the manifest of the same image is replaced.
+	list.set("reg.example.com/app:arm64", arm64)
+	assert.Equal(t, []ocispec.Descriptor{amd64, arm64}, list.index.Manifests)
+	assert.Equal(t, []string{"reg.example.com/app:amd64", "reg.example.com/app:arm64"}, list.images)
+
+	for ref, expected := range map[string]int{
+		"reg.example.com/app:amd64":                                  0,
+		"reg.example.com/app:arm64":                                  1,
+		"reg.example.com/app:latest":                                 -1,
+		"reg.example.com/app@" + digest.FromString("arm64").String(): 1,
+		"reg.example.com/app@" + digest.FromString("other").String(): -1,
+	} {
+		named, err := reference.Parse(ref)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, list.find(named), ref)
+	}
+}
+
+func TestManifestListMediaType(t *testing.T) {
+	var (
+		docker = ocispec.Descriptor{MediaType: ctrdmetaimages.MediaTypeDockerSchema2Manifest}
+		oci    = ocispec.Descriptor{MediaType: ocispec.MediaTypeImageManifest}
+	)
+
+	for _, c := range []struct {
+		manifests []ocispec.Descriptor
+		expected  string
+	}{
+		{expected: ocispec.MediaTypeImageIndex},
+		{manifests: []ocispec.Descriptor{docker, docker}, expected: ctrdmetaimages.MediaTypeDockerSchema2ManifestList},
+		{manifests: []ocispec.Descriptor{docker, oci}, expected: ocispec.MediaTypeImageIndex},
+		{manifests: []ocispec.Descriptor{oci}, expected: ocispec.MediaTypeImageIndex},
+	} {
+		list := &manifestList{index: ocispec.Index{Manifests: c.manifests}}
+		assert.Equal(t, c.expected, list.mediaType())
+	}
+}
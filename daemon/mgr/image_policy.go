@@ -0,0 +1,448 @@
+package mgr
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/reference"
+
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// imagePolicyAccept accepts the images in scope.
+	imagePolicyAccept = "accept"
+
+	// imagePolicyReject rejects the images in scope.
+	imagePolicyReject = "reject"
+
+	// cosignSignatureAnnotation is the annotation of signature layer which
+	// stores the base64 encoded signature of the layer content.
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+
+	// maxSignaturePayloadSize is the max size of signature payload.
+	maxSignaturePayloadSize = 1 << 20
+)
+
+// imagePolicy decides which images are allowed to pull and run. The policy
+// file looks like:
+//
+//	{
+//	  "default": "reject",
+//	  "rules": [
+//	    {
+//	      "scope": "reg.example.com/release",
+//	      "action": "accept",
+//	      "signed-by": ["/etc/pouch/keys/release.pub"]
+//	    },
+//	    {
+//	      "scope": "reg.example.com",
+//	      "action": "accept",
+//	      "require-digest": true
+//	    }
+//	  ]
+//	}
+//
+// The scope is a registry, or a repository with its namespaces, and the rule
+// of the most specific scope is applied to the image.
+type imagePolicy struct {
+	// Default is the action of images not in any scope, accept by default.
+	Default string `json:"default,omitempty"`
+
+	// Rules are the rules of registries and repositories.
+	Rules []*imagePolicyRule `json:"rules,omitempty"`
+
+	// verified caches the manifest digests whose signatures are verified,
+	// the key is the scope of rule and the digest, since the rules of
+	// different scopes may require different keys.
+	mu       sync.Mutex
+	verified map[string]struct{}
+}
+
+// imagePolicyRule is the rule of images in scope.
+type imagePolicyRule struct {
+	// Scope is the registry or the repository applied.
+	Scope string `json:"scope"`
+
+	// Action is accept or reject.
+	Action string `json:"action"`
+
+	// RequireDigest requires the image to be referenced by digest.
+	RequireDigest bool `json:"require-digest,omitempty"`
+
+	// SignedBy are the public keys, and the image must be signed by one
+	// of them.
+	SignedBy []string `json:"signed-by,omitempty"`
+
+	keys []crypto.PublicKey
+}
+
+// loadImagePolicy loads the policy file, nil is returned if the file is
+// not set.
+func loadImagePolicy(file string) (*imagePolicy, error) {
+	if file == "" {
+		return nil, nil
+	}
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to read image policy %s", file)
+	}
+
+	p := &imagePolicy{}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to parse image policy %s", file)
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, pkgerrors.Wrapf(err, "invalid image policy %s", file)
+	}
+	return p, nil
+}
+
+func (p *imagePolicy) validate() error {
+	if p.Default == "" {
+		p.Default = imagePolicyAccept
+	}
+	if p.Default != imagePolicyAccept && p.Default != imagePolicyReject {
+		return fmt.Errorf("unknown default action %s", p.Default)
+	}
+
+	scopes := map[string]bool{}
+	for _, r := range p.Rules {
+		r.Scope = strings.TrimSuffix(r.Scope, "/")
+		if r.Scope == "" {
+			return fmt.Errorf("scope of rule cannot be empty")
+		}
+		if scopes[r.Scope] {
+			return fmt.Errorf("duplicate rules of scope %s", r.Scope)
+		}
+		scopes[r.Scope] = true
+
+		if r.Action != imagePolicyAccept && r.Action != imagePolicyReject {
+			return fmt.Errorf("unknown action %s of scope %s", r.Action, r.Scope)
+		}
+
+		for _, f := range r.SignedBy {
+			key, err := loadPublicKey(f)
+			if err != nil {
+				return err
+			}
+			r.keys = append(r.keys, key)
+		}
+	}
+
+	p.verified = map[string]struct{}{}
+	return nil
+}
+
+// match returns the rule of the most specific scope of repository name,
+// nil means the default action.
+func (p *imagePolicy) match(name string) *imagePolicyRule {
+	var matched *imagePolicyRule
+	for _, r := range p.Rules {
+		if name != r.Scope && !strings.HasPrefix(name, r.Scope+"/") {
+			continue
+		}
+		if matched == nil || len(r.Scope) > len(matched.Scope) {
+			matched = r
+		}
+	}
+	return matched
+}
+
+// admit checks the reference against the policy, and returns the rule if
+// the signature should be verified.
+func (p *imagePolicy) admit(ref reference.Named) (*imagePolicyRule, error) {
+	r := p.match(ref.Name())
+	if r == nil {
+		if p.Default == imagePolicyReject {
+			return nil, pkgerrors.Wrapf(errtypes.ErrPolicyDenied, "image %s is not in any accepted scope", ref.String())
+		}
+		return nil, nil
+	}
+
+	if r.Action == imagePolicyReject {
+		return nil, pkgerrors.Wrapf(errtypes.ErrPolicyDenied, "image %s is rejected in scope %s", ref.String(), r.Scope)
+	}
+
+	if r.RequireDigest && !reference.IsCanonicalDigested(ref) {
+		return nil, pkgerrors.Wrapf(errtypes.ErrPolicyDenied, "image %s must be referenced by digest in scope %s", ref.String(), r.Scope)
+	}
+
+	if len(r.keys) == 0 {
+		return nil, nil
+	}
+	return r, nil
+}
+
+func (p *imagePolicy) isVerified(r *imagePolicyRule, dgst digest.Digest) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, ok := p.verified[r.Scope+"@"+dgst.String()]
+	return ok
+}
+
+func (p *imagePolicy) setVerified(r *imagePolicyRule, dgst digest.Digest) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.verified[r.Scope+"@"+dgst.String()] = struct{}{}
+}
+
+// enforceImagePolicy checks the image against the policy, and verifies the
+// signature of manifest digest if required. The digest is resolved by the
+// resolver if it is empty, and the verified digest is returned. A deny
+// event is logged if the image is denied.
+func (mgr *ImageManager) enforceImagePolicy(ctx context.Context, ref reference.Named, dgst digest.Digest, resolver remotes.Resolver) (digest.Digest, error) {
+	if mgr.imagePolicy == nil {
+		return dgst, nil
+	}
+
+	verified, err := mgr.checkImagePolicy(ctx, ref, dgst, resolver)
+	if err != nil {
+		log.With(ctx).Warnf("image %s is denied: %v", ref.String(), err)
+		mgr.LogImageEventWithAttributes(ctx, ref.String(), ref.String(), "deny", map[string]string{
+			"reason": err.Error(),
+		})
+		return "", err
+	}
+	return verified, nil
+}
+
+func (mgr *ImageManager) checkImagePolicy(ctx context.Context, ref reference.Named, dgst digest.Digest, resolver remotes.Resolver) (digest.Digest, error) {
+	rule, err := mgr.imagePolicy.admit(ref)
+	if err != nil || rule == nil {
+		return dgst, err
+	}
+
+	if dgst != "" && mgr.imagePolicy.isVerified(rule, dgst) {
+		return dgst, nil
+	}
+
+	if resolver == nil {
+		resolver, _, err = mgr.client.ResolveImage(ctx, ref.String(), []string{ref.String()}, nil, docker.ResolverOptions{})
+		if err != nil {
+			return "", pkgerrors.Wrapf(errtypes.ErrPolicyDenied, "failed to resolve %s to verify signature: %v", ref.String(), err)
+		}
+	}
+
+	if dgst == "" {
+		_, desc, err := resolver.Resolve(ctx, ref.String())
+		if err != nil {
+			return "", pkgerrors.Wrapf(errtypes.ErrPolicyDenied, "failed to resolve %s to verify signature: %v", ref.String(), err)
+		}
+		dgst = desc.Digest
+	}
+
+	if err := verifyCosignSignatures(ctx, resolver, ref.Name(), dgst, rule.keys); err != nil {
+		return "", pkgerrors.Wrapf(errtypes.ErrPolicyDenied, "image %s is not signed in scope %s: %v", ref.String(), rule.Scope, err)
+	}
+
+	mgr.imagePolicy.setVerified(rule, dgst)
+	return dgst, nil
+}
+
+// verifiedResolver makes sure the image resolved for pull is the verified
+// one, since the tag may be moved after the signature is verified. It fails
+// the pull before any content is fetched or the image is created.
+type verifiedResolver struct {
+	remotes.Resolver
+	verified digest.Digest
+}
+
+func (r *verifiedResolver) Resolve(ctx context.Context, ref string) (string, ocispec.Descriptor, error) {
+	name, desc, err := r.Resolver.Resolve(ctx, ref)
+	if err != nil {
+		return "", ocispec.Descriptor{}, err
+	}
+	if desc.Digest != r.verified {
+		return "", ocispec.Descriptor{}, pkgerrors.Wrapf(errtypes.ErrPolicyDenied, "image %s is changed to %s after verified", ref, desc.Digest)
+	}
+	return name, desc, nil
+}
+
+// CheckImagePolicy checks the local image against the image policy, the
+// image is denied if it is not referenced by digest when it is required,
+// or it is not signed by the keys required.
+func (mgr *ImageManager) CheckImagePolicy(ctx context.Context, idOrRef string) error {
+	if mgr.imagePolicy == nil {
+		return nil
+	}
+
+	actualID, actualRef, primaryRef, err := mgr.CheckReference(ctx, idOrRef)
+	if err != nil {
+		return err
+	}
+
+	// the image referenced by ID is checked by its primary reference.
+	ref := actualRef
+	if reference.IsNamedOnly(actualRef) || strings.HasPrefix(actualID.String(), actualRef.String()) {
+		ref = primaryRef
+	}
+
+	img, err := mgr.client.GetImage(ctx, primaryRef.String())
+	if err != nil {
+		return err
+	}
+
+	_, err = mgr.enforceImagePolicy(ctx, ref, img.Target().Digest, nil)
+	return err
+}
+
+// cosignSignaturePayload is the payload signed by cosign.
+type cosignSignaturePayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// verifyCosignSignatures verifies the signatures of manifest digest, which
+// are stored as the layers of artifact tagged as sha256-<hex>.sig in the
+// same repository. One of the signatures must be signed by one of keys.
+func verifyCosignSignatures(ctx context.Context, resolver remotes.Resolver, name string, dgst digest.Digest, keys []crypto.PublicKey) error {
+	sigRef := fmt.Sprintf("%s:%s-%s.sig", name, dgst.Algorithm(), dgst.Hex())
+
+	_, desc, err := resolver.Resolve(ctx, sigRef)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to resolve signatures %s", sigRef)
+	}
+
+	fetcher, err := resolver.Fetcher(ctx, sigRef)
+	if err != nil {
+		return err
+	}
+
+	data, err := fetchBlob(ctx, fetcher, desc)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to fetch signatures %s", sigRef)
+	}
+
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return pkgerrors.Wrapf(err, "failed to parse signatures %s", sigRef)
+	}
+
+	for _, layer := range manifest.Layers {
+		sig, ok := layer.Annotations[cosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+
+		payload, err := fetchBlob(ctx, fetcher, layer)
+		if err != nil {
+			return pkgerrors.Wrapf(err, "failed to fetch signature payload %s", layer.Digest)
+		}
+
+		if err := verifyCosignSignature(payload, sig, dgst, keys); err != nil {
+			log.With(ctx).Debugf("signature %s of %s is not verified: %v", layer.Digest, dgst, err)
+			continue
+		}
+		return nil
+	}
+	return fmt.Errorf("no signature of %s is verified", dgst)
+}
+
+// verifyCosignSignature verifies the payload signed for the digest.
+func verifyCosignSignature(payload []byte, sig string, dgst digest.Digest, keys []crypto.PublicKey) error {
+	raw, err := base64.StdEncoding.DecodeString(sig)
+	if err != nil {
+		return pkgerrors.Wrap(err, "invalid signature")
+	}
+
+	hashed := sha256.Sum256(payload)
+
+	signed := false
+	for _, key := range keys {
+		switch k := key.(type) {
+		case *ecdsa.PublicKey:
+			signed = ecdsa.VerifyASN1(k, hashed[:], raw)
+		case *rsa.PublicKey:
+			signed = rsa.VerifyPKCS1v15(k, crypto.SHA256, hashed[:], raw) == nil
+		}
+		if signed {
+			break
+		}
+	}
+	if !signed {
+		return fmt.Errorf("signature is not signed by the keys")
+	}
+
+	var p cosignSignaturePayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return pkgerrors.Wrap(err, "invalid signature payload")
+	}
+	if p.Critical.Image.DockerManifestDigest != dgst.String() {
+		return fmt.Errorf("signature is signed for %s", p.Critical.Image.DockerManifestDigest)
+	}
+	return nil
+}
+
+func fetchBlob(ctx context.Context, fetcher remotes.Fetcher, desc ocispec.Descriptor) ([]byte, error) {
+	if desc.Size > maxSignaturePayloadSize {
+		return nil, fmt.Errorf("size %d exceeds %d", desc.Size, maxSignaturePayloadSize)
+	}
+
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(rc, maxSignaturePayloadSize))
+	if err != nil {
+		return nil, err
+	}
+
+	if digest.FromBytes(data) != desc.Digest {
+		return nil, fmt.Errorf("digest of %s mismatched", desc.Digest)
+	}
+	return data, nil
+}
+
+// loadPublicKey loads the PEM encoded public key, only ECDSA and RSA keys
+// are supported.
+func loadPublicKey(file string) (crypto.PublicKey, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to read public key %s", file)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("public key %s is not PEM encoded", file)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to parse public key %s", file)
+	}
+
+	switch key.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("type %T of public key %s is not supported", key, file)
+	}
+}
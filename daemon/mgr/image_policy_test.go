@@ -0,0 +1,245 @@
+package mgr
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/reference"
+
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImagePolicyAdmit(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &imagePolicy{
+		Default: imagePolicyReject,
+		Rules: []*imagePolicyRule{
+			{Scope: "reg.example.com", Action: imagePolicyAccept, RequireDigest: true},
+			{Scope: "reg.example.com/release/", Action: imagePolicyAccept},
+			{Scope: "reg.example.com/release/signed", Action: imagePolicyAccept},
+			{Scope: "reg.example.com/test", Action: imagePolicyReject},
+		},
+	}
+	assert.NoError(t, p.validate())
+	p.Rules[2].keys = []crypto.PublicKey{&key.PublicKey}
+
+	dgst := "@sha256:" + strings.Repeat("0", 64)
+	for _, tc := range []struct {
+		ref    string
+		denied bool
+		signed bool
+	}{
+		{ref: "docker.io/library/busybox:latest", denied: true},
+		{ref: "reg.example.com/busybox:latest", denied: true},
+		{ref: "reg.example.com/busybox" + dgst},
+		{ref: "reg.example.com/test/busybox" + dgst, denied: true},
+		{ref: "reg.example.com/testing/busybox" + dgst},
+		{ref: "reg.example.com/release/busybox:latest"},
+		{ref: "reg.example.com/release/signed:latest", signed: true},
+		{ref: "reg.example.com/release/signed/busybox:latest", signed: true},
+	} {
+		ref, err := reference.Parse(tc.ref)
+		assert.NoError(t, err)
+
+		rule, err := p.admit(ref)
+		if tc.denied {
+			assert.True(t, errtypes.IsPolicyDenied(err), tc.ref)
+			continue
+		}
+		assert.NoError(t, err, tc.ref)
+		assert.Equal(t, tc.signed, rule != nil, tc.ref)
+	}
+
+	assert.Error(t, (&imagePolicy{Default: "deny"}).validate())
+	assert.Error(t, (&imagePolicy{Rules: []*imagePolicyRule{{Scope: "a", Action: "deny"}}}).validate())
+	assert.Error(t, (&imagePolicy{Rules: []*imagePolicyRule{
+		{Scope: "a", Action: imagePolicyAccept},
+		{Scope: "a/", Action: imagePolicyReject},
+	}}).validate())
+}
+
+func TestImagePolicyVerifiedCache(t *testing.T) {
+	p := &imagePolicy{
+		Rules: []*imagePolicyRule{
+			{Scope: "reg.example.com", Action: imagePolicyAccept},
+			{Scope: "reg.example.com/release", Action: imagePolicyAccept},
+		},
+	}
+	assert.NoError(t, p.validate())
+
+	// the digest verified under a rule is not verified under the others.
+	dgst := digest.FromString("manifest")
+	p.setVerified(p.Rules[0], dgst)
+	assert.True(t, p.isVerified(p.Rules[0], dgst))
+	assert.False(t, p.isVerified(p.Rules[1], dgst))
+}
+
+// staticResolver resolves any reference to the descriptor.
+type staticResolver struct {
+	remotes.Resolver
+	desc ocispec.Descriptor
+}
+
+func (r *staticResolver) Resolve(ctx context.Context, ref string) (string, ocispec.Descriptor, error) {
+	return ref, r.desc, nil
+}
+
+func TestVerifiedResolver(t *testing.T) {
+	verified := digest.FromString("verified")
+
+	r := &verifiedResolver{Resolver: &staticResolver{desc: ocispec.Descriptor{Digest: verified}}, verified: verified}
+	_, desc, err := r.Resolve(context.Background(), "reg.example.com/busybox:latest")
+	assert.NoError(t, err)
+	assert.Equal(t, verified, desc.Digest)
+
+	// the tag moved after verified is denied.
+	r = &verifiedResolver{Resolver: &staticResolver{desc: ocispec.Descriptor{Digest: digest.FromString("moved")}}, verified: verified}
+	_, _, err = r.Resolve(context.Background(), "reg.example.com/busybox:latest")
+	assert.True(t, errtypes.IsPolicyDenied(err))
+}
+
+// signatureRegistry is a registry stand-in which serves the cosign
+// signatures of busybox.
+type signatureRegistry struct {
+	tag      string
+	manifest []byte
+	blobs    map[digest.Digest][]byte
+}
+
+func newSignatureRegistry(t *testing.T, key *ecdsa.PrivateKey, signed digest.Digest) *signatureRegistry {
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"reg.example.com/busybox"},"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"},"optional":null}`, signed))
+	hashed := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hashed[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manifest, err := json.Marshal(ocispec.Manifest{
+		Layers: []ocispec.Descriptor{
+			{
+				MediaType: "application/vnd.dev.cosign.simplesigning.v1+json",
+				Digest:    digest.FromBytes(payload),
+				Size:      int64(len(payload)),
+				Annotations: map[string]string{
+					cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(sig),
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &signatureRegistry{
+		tag:      fmt.Sprintf("%s-%s.sig", signed.Algorithm(), signed.Hex()),
+		manifest: manifest,
+		blobs: map[digest.Digest][]byte{
+			digest.FromBytes(manifest): manifest,
+			digest.FromBytes(payload):  payload,
+		},
+	}
+}
+
+func (r *signatureRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	var data []byte
+	switch {
+	case req.URL.Path == "/v2/busybox/manifests/"+r.tag:
+		data = r.manifest
+		w.Header().Set("Content-Type", ocispec.MediaTypeImageManifest)
+	case strings.HasPrefix(req.URL.Path, "/v2/busybox/blobs/"), strings.HasPrefix(req.URL.Path, "/v2/busybox/manifests/sha256:"):
+		data = r.blobs[digest.Digest(req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:])]
+	}
+
+	if data == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Docker-Content-Digest", digest.FromBytes(data).String())
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.Write(data)
+}
+
+func TestVerifyCosignSignatures(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signed := digest.FromString("busybox")
+	srv := httptest.NewServer(newSignatureRegistry(t, key, signed))
+	defer srv.Close()
+
+	host := strings.TrimPrefix(srv.URL, "http://")
+	resolver := docker.NewResolver(docker.ResolverOptions{
+		Host: func(string) (string, error) {
+			return host, nil
+		},
+		PlainHTTP: true,
+	})
+
+	ctx := context.Background()
+	assert.NoError(t, verifyCosignSignatures(ctx, resolver, "reg.example.com/busybox", signed,
+		[]crypto.PublicKey{&other.PublicKey, &key.PublicKey}))
+
+	// signed by other key.
+	assert.Error(t, verifyCosignSignatures(ctx, resolver, "reg.example.com/busybox", signed,
+		[]crypto.PublicKey{&other.PublicKey}))
+
+	// no signature.
+	assert.Error(t, verifyCosignSignatures(ctx, resolver, "reg.example.com/busybox", digest.FromString("other"),
+		[]crypto.PublicKey{&key.PublicKey}))
+}
+
+func TestVerifyCosignSignature(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signed := digest.FromString("busybox")
+	payload := []byte(fmt.Sprintf(`{"critical":{"image":{"docker-manifest-digest":"%s"}}}`, signed))
+	hashed := sha256.Sum256(payload)
+	raw, err := ecdsa.SignASN1(rand.Reader, key, hashed[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := base64.StdEncoding.EncodeToString(raw)
+
+	keys := []crypto.PublicKey{&key.PublicKey}
+	assert.NoError(t, verifyCosignSignature(payload, sig, signed, keys))
+
+	// signed for other digest.
+	assert.Error(t, verifyCosignSignature(payload, sig, digest.FromString("other"), keys))
+
+	// payload is changed.
+	assert.Error(t, verifyCosignSignature(append(payload, ' '), sig, signed, keys))
+
+	// invalid signature.
+	assert.Error(t, verifyCosignSignature(payload, "!", signed, keys))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/reference"
@@ -72,11 +73,19 @@ type imageStore struct {
 	imageInfoCache map[digest.Digest]CtrdImageInfo
 }
 
-// CtrdImageInfo is used to cache the id, size and oci image information.
+// CtrdImageInfo is used to cache the id, size, oci image and platform information.
 type CtrdImageInfo struct {
-	ID      digest.Digest
-	Size    int64
-	OCISpec ocispec.Image
+	ID       digest.Digest
+	Size     int64
+	OCISpec  ocispec.Image
+	Platform ocispec.Platform
+
+	// LastUsed is the last time the image is pulled or used by container,
+	// zero means unknown.
+	LastUsed time.Time
+
+	// Containers is the number of containers using the image.
+	Containers int64
 }
 
 // referenceMap represents reference string to corresponding reference.Named
@@ -315,14 +324,47 @@ func (store *imageStore) GetCtrdImageInfo(id digest.Digest) (CtrdImageInfo, erro
 	return CtrdImageInfo{}, errCtrdImageInfoNotExist
 }
 
-// CacheCtrdImageInfo caches the oci image by image ID.
+// CacheCtrdImageInfo caches the oci image by image ID. The later last used
+// time and the number of containers are kept if the image has been cached.
 func (store *imageStore) CacheCtrdImageInfo(id digest.Digest, img CtrdImageInfo) {
 	store.Lock()
 	defer store.Unlock()
 
+	if i, ok := store.imageInfoCache[id]; ok {
+		if i.LastUsed.After(img.LastUsed) {
+			img.LastUsed = i.LastUsed
+		}
+		img.Containers = i.Containers
+	}
 	store.imageInfoCache[id] = img
 }
 
+// UpdateLastUsed updates the last used time of the cached image.
+func (store *imageStore) UpdateLastUsed(id digest.Digest, t time.Time) {
+	store.Lock()
+	defer store.Unlock()
+
+	if i, ok := store.imageInfoCache[id]; ok && t.After(i.LastUsed) {
+		i.LastUsed = t
+		store.imageInfoCache[id] = i
+	}
+}
+
+// UpdateContainers adds delta to the number of containers using the cached
+// image, the number never goes below zero.
+func (store *imageStore) UpdateContainers(id digest.Digest, delta int64) {
+	store.Lock()
+	defer store.Unlock()
+
+	if i, ok := store.imageInfoCache[id]; ok {
+		i.Containers += delta
+		if i.Containers < 0 {
+			i.Containers = 0
+		}
+		store.imageInfoCache[id] = i
+	}
+}
+
 // ClearCtrdImageInfo caches the oci image by image ID.
 func (store *imageStore) ClearCtrdImageInfo(id digest.Digest) {
 	store.Lock()
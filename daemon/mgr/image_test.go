@@ -0,0 +1,121 @@
+package mgr
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/reference"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListImagesFilters(t *testing.T) {
+	store, err := newImageStore()
+	if err != nil {
+		t.Fatalf("unexpected error during creating store: %v", err)
+	}
+
+	var (
+		taggedID   = digest.FromString("tagged")
+		danglingID = digest.FromString("dangling")
+
+		taggedRef, _   = reference.Parse("busybox:latest")
+		danglingRef, _ = reference.Parse("busybox@" + danglingID.String())
+	)
+
+	assert.NoError(t, store.AddReference(taggedID, taggedRef, taggedRef))
+	assert.NoError(t, store.AddReference(danglingID, danglingRef, danglingRef))
+
+	created := time.Now()
+	tagged := CtrdImageInfo{ID: taggedID, OCISpec: ocispec.Image{Created: &created}}
+	tagged.OCISpec.Config.Labels = map[string]string{"maintainer": "pouch"}
+	store.CacheCtrdImageInfo(taggedID, tagged)
+	store.CacheCtrdImageInfo(danglingID, CtrdImageInfo{ID: danglingID, OCISpec: ocispec.Image{Created: &created}})
+	store.UpdateContainers(taggedID, 1)
+
+	mgr := &ImageManager{localStore: store}
+	for _, tc := range []struct {
+		filters []string
+		ids     []digest.Digest
+	}{
+		{ids: []digest.Digest{taggedID, danglingID}},
+		{filters: []string{"dangling=true"}, ids: []digest.Digest{danglingID}},
+		{filters: []string{"dangling=false"}, ids: []digest.Digest{taggedID}},
+		{filters: []string{"unused=true"}, ids: []digest.Digest{danglingID}},
+		{filters: []string{"unused=false"}, ids: []digest.Digest{taggedID}},
+		{filters: []string{"label=maintainer"}, ids: []digest.Digest{taggedID}},
+		{filters: []string{"label=maintainer=pouch"}, ids: []digest.Digest{taggedID}},
+		{filters: []string{"label=maintainer=other"}},
+		{filters: []string{"unused=true", "label=maintainer"}},
+		{filters: []string{"reference=busy*"}, ids: []digest.Digest{taggedID, danglingID}},
+	} {
+		args, err := filters.FromFilterOpts(tc.filters)
+		assert.NoError(t, err)
+
+		imgs, err := mgr.ListImages(context.Background(), args)
+		assert.NoError(t, err, tc.filters)
+
+		ids := make([]string, 0, len(imgs))
+		for _, img := range imgs {
+			ids = append(ids, img.ID)
+		}
+		expected := digestSliceToStringSlice(tc.ids)
+		sort.Strings(ids)
+		sort.Strings(expected)
+		assert.Equal(t, expected, ids, tc.filters)
+	}
+
+	args, err := filters.FromFilterOpts([]string{"dangling=maybe"})
+	assert.NoError(t, err)
+	_, err = mgr.ListImages(context.Background(), args)
+	assert.True(t, errtypes.IsInvalidParam(err))
+
+	// the number of containers is reported and kept on recaching.
+	store.CacheCtrdImageInfo(taggedID, CtrdImageInfo{ID: taggedID, OCISpec: ocispec.Image{Created: &created}})
+	img, err := mgr.containerdImageToImageInfo(context.Background(), taggedID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), img.Containers)
+	assert.Equal(t, "", img.LastUsed)
+
+	// the number never goes below zero.
+	store.UpdateContainers(taggedID, -2)
+	info, err := store.GetCtrdImageInfo(taggedID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), info.Containers)
+}
+
+func TestRepositoryTags(t *testing.T) {
+	store, err := newImageStore()
+	if err != nil {
+		t.Fatalf("unexpected error during creating store: %v", err)
+	}
+
+	for id, refs := range map[digest.Digest][]string{
+		digest.FromString("v1"):    {"reg.example.com/app:v1", "reg.example.com/app:latest"},
+		digest.FromString("v2"):    {"reg.example.com/app:v2"},
+		digest.FromString("other"): {"reg.example.com/other:v1", "reg.example.com/app@" + digest.FromString("other").String()},
+	} {
+		for _, r := range refs {
+			named, err := reference.Parse(r)
+			assert.NoError(t, err)
+			assert.NoError(t, store.AddReference(id, named, named))
+		}
+		store.CacheCtrdImageInfo(id, CtrdImageInfo{ID: id})
+	}
+
+	mgr := &ImageManager{localStore: store}
+	assert.Equal(t, []string{"reg.example.com/app:latest", "reg.example.com/app:v1", "reg.example.com/app:v2"},
+		mgr.repositoryTags("reg.example.com/app"))
+	assert.Empty(t, mgr.repositoryTags("reg.example.com/none"))
+
+	err = mgr.PushRepository(context.Background(), "reg.example.com/app:v1", nil, nil)
+	assert.True(t, errtypes.IsInvalidParam(err))
+	err = mgr.PushRepository(context.Background(), "reg.example.com/none", nil, nil)
+	assert.True(t, errtypes.IsNotfound(err))
+}
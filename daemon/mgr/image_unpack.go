@@ -0,0 +1,49 @@
+package mgr
+
+import (
+	"context"
+
+	"github.com/alibaba/pouch/ctrd"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// UnpackImage unpacks the layers of image into the snapshotter, so containers
+// using the snapshotter can be created from it. The snapshotter of daemon is
+// used if snapshotter is empty.
+func (mgr *ImageManager) UnpackImage(ctx context.Context, idOrRef, snapshotter string) error {
+	if snapshotter == "" {
+		snapshotter = ctrd.CurrentSnapshotterName(context.TODO())
+	}
+
+	if err := checkSnapshotter(ctx, mgr.client, snapshotter, mgr.allowMultiSnapshotter); err != nil {
+		return err
+	}
+
+	_, _, ref, err := mgr.CheckReference(ctx, idOrRef)
+	if err != nil {
+		return err
+	}
+
+	img, err := mgr.client.GetImage(ctx, ref.String())
+	if err != nil {
+		return err
+	}
+
+	unpacked, err := img.IsUnpacked(ctx, snapshotter)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to check unpack status of image %s on %s snapshotter", ref, snapshotter)
+	}
+	if unpacked {
+		return nil
+	}
+
+	// NOTE: don't use pouchd lease here, the snapshots of image are
+	// referenced by the image config and removed with the image.
+	if err := img.Unpack(ctrd.WithImageUnpack(ctx), snapshotter); err != nil {
+		return pkgerrors.Wrapf(err, "failed to unpack image %s on %s snapshotter", ref, snapshotter)
+	}
+
+	mgr.LogImageEvent(ctx, idOrRef, ref.String(), "unpack")
+	return nil
+}
@@ -4,16 +4,21 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/reference"
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/content"
 	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/platforms"
 	digest "github.com/opencontainers/go-digest"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	pkgerrors "github.com/pkg/errors"
 )
 
 var legacyDockerConfigMediaType = "application/octet-stream"
@@ -51,6 +56,63 @@ func containerdImageToOciImage(ctx context.Context, img containerd.Image) (ocisp
 	return ociImage, nil
 }
 
+// imagePlatform returns the platform of image. The platform recorded when
+// pulling is preferred, since the variant is missing in the image config.
+func imagePlatform(labels map[string]string, img ocispec.Image) ocispec.Platform {
+	if p, ok := ctrd.ImagePlatform(labels); ok {
+		return p
+	}
+	return platforms.Normalize(ocispec.Platform{
+		OS:           img.OS,
+		Architecture: img.Architecture,
+	})
+}
+
+// platformLabels returns the labels only containing the platform of image,
+// it is nil if there is no platform recorded.
+func platformLabels(labels map[string]string) map[string]string {
+	if p, ok := labels[ctrd.ImagePlatformLabel]; ok {
+		return map[string]string{ctrd.ImagePlatformLabel: p}
+	}
+	return nil
+}
+
+// filterPlatform returns true if the platform matches any platform in filter.
+func filterPlatform(filter []string, platform ocispec.Platform) (bool, error) {
+	if len(filter) == 0 {
+		return true, nil
+	}
+
+	for _, specifier := range filter {
+		p, err := ctrd.ParsePlatform(specifier)
+		if err != nil {
+			return false, pkgerrors.Wrap(errtypes.ErrInvalidParam, err.Error())
+		}
+
+		if platforms.NewMatcher(p).Match(platform) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// parseBoolFilter returns the boolean value of filter key, or nil if the
+// filter is not set.
+func parseBoolFilter(key string, filter []string) (*bool, error) {
+	if len(filter) == 0 {
+		return nil, nil
+	}
+	if len(filter) > 1 {
+		return nil, pkgerrors.Wrapf(errtypes.ErrInvalidParam, "can't use %s filter more than one", key)
+	}
+
+	b, err := strconv.ParseBool(filter[0])
+	if err != nil {
+		return nil, pkgerrors.Wrapf(errtypes.ErrInvalidParam, "invalid %s filter %q, should be true or false", key, filter[0])
+	}
+	return &b, nil
+}
+
 // getImageInfoConfigFromOciImage returns config of ImageConfig from oci image.
 func getImageInfoConfigFromOciImage(img ocispec.Image) *types.ContainerConfig {
 	volumes := make(map[string]interface{})
@@ -3,7 +3,11 @@ package mgr
 import (
 	"testing"
 
+	"github.com/alibaba/pouch/ctrd"
 	"github.com/alibaba/pouch/pkg/reference"
+
+	"github.com/containerd/containerd/platforms"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -85,3 +89,43 @@ func TestUniqueLocatorReference(t *testing.T) {
 		assert.Equal(t, uniqueLocatorReference(refs), tc.expect)
 	}
 }
+
+func TestImagePlatform(t *testing.T) {
+	img := ocispec.Image{OS: "linux", Architecture: "arm"}
+
+	// the variant is missing in image config, take the default one.
+	assert.Equal(t, ocispec.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}, imagePlatform(nil, img))
+
+	labels := map[string]string{ctrd.ImagePlatformLabel: "linux/arm/v6"}
+	assert.Equal(t, ocispec.Platform{OS: "linux", Architecture: "arm", Variant: "v6"}, imagePlatform(labels, img))
+
+	// the invalid label is ignored.
+	labels[ctrd.ImagePlatformLabel] = "linux/arm/v6/foo"
+	assert.Equal(t, ocispec.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}, imagePlatform(labels, img))
+}
+
+func TestFilterPlatform(t *testing.T) {
+	arm64 := platforms.Normalize(ocispec.Platform{OS: "linux", Architecture: "aarch64"})
+
+	for _, tc := range []struct {
+		filter  []string
+		matched bool
+		err     bool
+	}{
+		{filter: nil, matched: true},
+		{filter: []string{"linux/arm64"}, matched: true},
+		{filter: []string{"linux/arm64/v8"}, matched: true},
+		{filter: []string{"linux/amd64", "linux/arm64"}, matched: true},
+		{filter: []string{"linux/amd64"}, matched: false},
+		{filter: []string{"linux/arm"}, matched: false},
+		{filter: []string{"linux/arm64/v8/foo"}, err: true},
+	} {
+		matched, err := filterPlatform(tc.filter, arm64)
+		if tc.err {
+			assert.Error(t, err, "%v", tc.filter)
+			continue
+		}
+		assert.NoError(t, err, "%v", tc.filter)
+		assert.Equal(t, tc.matched, matched, "%v", tc.filter)
+	}
+}
@@ -5,10 +5,16 @@ import (
 	"fmt"
 	"net"
 	"path"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
+	"github.com/alibaba/pouch/apis/opts"
 	apitypes "github.com/alibaba/pouch/apis/types"
+	criconfig "github.com/alibaba/pouch/cri/config"
+	cni "github.com/alibaba/pouch/cri/ocicni"
 	"github.com/alibaba/pouch/daemon/config"
 	"github.com/alibaba/pouch/daemon/events"
 	"github.com/alibaba/pouch/network"
@@ -59,14 +65,51 @@ type NetworkMgr interface {
 
 	// GetNetworkStats returns the network stats of specific sandbox
 	GetNetworkStats(sandboxID string) (map[string]apitypes.NetworkStats, error)
+
+	// Reserve reserves static addresses on network for the container with the given name.
+	Reserve(ctx context.Context, idName string, reservation apitypes.NetworkReservation) error
+
+	// Unreserve releases the addresses reserved on network for the container with the given name.
+	Unreserve(ctx context.Context, idName string, name string) error
+
+	// Reservations returns all the reservations on network.
+	Reservations(ctx context.Context, idName string) ([]*types.Reservation, error)
+
+	// NetworkEndpoints returns the endpoints of containers attached to network, keyed by container ID.
+	NetworkEndpoints(ctx context.Context, idName string) (map[string]apitypes.EndpointResource, error)
+
+	// StreamNetworkStats sends the traffic stats of endpoints attached to network as a stream.
+	StreamNetworkStats(ctx context.Context, idName string, config *NetworkStatsConfig) error
+
+	// NetworkPolicy returns the default ingress policy of network and the effective policy rules.
+	NetworkPolicy(ctx context.Context, idName string) (*apitypes.NetworkPolicy, []string, error)
 }
 
+// reservedEndpointPrefix is the name prefix of placeholder endpoints, which
+// hold the reserved addresses in IPAM while the owner is not connected.
+const reservedEndpointPrefix = "reserved-"
+
 // NetworkManager is the default implement of interface NetworkMgr.
 type NetworkManager struct {
 	store         *meta.Store
 	controller    libnetwork.NetworkController
 	config        network.Config
 	eventsService *events.Events
+	ctrMgr        ContainerMgr
+
+	// reservationStore persists the static addresses reserved on networks.
+	reservationStore *meta.Store
+
+	// policyStore persists the default ingress policies of networks.
+	policyStore *meta.Store
+	// policyRules caches the effective policy rules of networks, keyed by network ID.
+	policyRules map[string][]string
+	policyLock  sync.Mutex
+
+	// cniConfig is the config to load CNI plugins for the cni network mode.
+	cniConfig criconfig.Config
+	cniMgr    cni.CniMgr
+	cniLock   sync.Mutex
 }
 
 // NewNetworkManager creates a brand new network manager.
@@ -85,7 +128,7 @@ func NewNetworkManager(cfg *config.Config, store *meta.Store, ctrMgr ContainerMg
 		&ContainerListOption{
 			All: true,
 			FilterFunc: func(c *Container) bool {
-				return c.IsRunningOrPaused() && !isContainer(c.HostConfig.NetworkMode)
+				return c.IsRunningOrPaused() && !isContainer(c.HostConfig.NetworkMode) && !IsCNI(c.HostConfig.NetworkMode)
 			}})
 	if err != nil {
 		log.With(nil).Errorf("failed to new network manager: cannot get container list")
@@ -111,12 +154,55 @@ func NewNetworkManager(cfg *config.Config, store *meta.Store, ctrMgr ContainerMg
 		return nil, errors.Wrap(err, "failed to create network controller")
 	}
 
-	return &NetworkManager{
-		store:         store,
-		controller:    controller,
-		config:        cfg.NetworkConfig,
-		eventsService: eventsService,
-	}, nil
+	reservationStore, err := meta.NewStore(meta.Config{
+		Driver:  "local",
+		BaseDir: path.Join(cfg.NetworkConfig.MetaPath, "network", "reservations"),
+		Buckets: []meta.Bucket{
+			{
+				Name: meta.MetaJSONFile,
+				Type: reflect.TypeOf(types.Reservation{}),
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create reservation meta store")
+	}
+
+	policyStore, err := meta.NewStore(meta.Config{
+		Driver:  "local",
+		BaseDir: path.Join(cfg.NetworkConfig.MetaPath, "network", "policies"),
+		Buckets: []meta.Bucket{
+			{
+				Name: meta.MetaJSONFile,
+				Type: reflect.TypeOf(types.Policy{}),
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create policy meta store")
+	}
+
+	nm := &NetworkManager{
+		store:            store,
+		controller:       controller,
+		config:           cfg.NetworkConfig,
+		eventsService:    eventsService,
+		ctrMgr:           ctrMgr,
+		reservationStore: reservationStore,
+		policyStore:      policyStore,
+		policyRules:      make(map[string][]string),
+		cniConfig:        cfg.CriConfig,
+	}
+
+	// libnetwork removes the stale endpoints when it starts up, so the
+	// placeholder endpoints of reservations should be recreated.
+	nm.restoreReservations(context.Background())
+
+	// the policy rules may be lost after host reboots or be stale, so
+	// sync them with the endpoints on networks.
+	nm.restorePolicies(context.Background())
+
+	return nm, nil
 }
 
 // Create is used to create network.
@@ -130,6 +216,15 @@ func (nm *NetworkManager) Create(ctx context.Context, create apitypes.NetworkCre
 		return nil, errors.Wrap(err, "failed to build network's options")
 	}
 
+	if err := opts.ValidateNetworkPolicy(create.NetworkCreate.Policy); err != nil {
+		return nil, errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+	// policy rules are only programmed by iptables, the IPv6 traffic
+	// would bypass them.
+	if create.NetworkCreate.Policy != nil && create.NetworkCreate.EnableIPV6 {
+		return nil, errors.Wrap(errtypes.ErrInvalidParam, "network policy is not supported on IPv6 enabled network")
+	}
+
 	if net, err := nm.controller.NetworkByName(name); err == nil && net != nil {
 		return nil, errors.Wrapf(errtypes.ErrAlreadyExisted, "network %s", name)
 	}
@@ -146,6 +241,15 @@ func (nm *NetworkManager) Create(ctx context.Context, create apitypes.NetworkCre
 		Network: net,
 	}
 
+	if policy := create.NetworkCreate.Policy; policy != nil {
+		if err := nm.policyStore.Put(&types.Policy{NetworkPolicy: *policy, NetworkID: id}); err != nil {
+			if derr := net.Delete(); derr != nil {
+				log.With(ctx).Errorf("failed to delete network %s after failing to save policy: %v", name, derr)
+			}
+			return nil, errors.Wrapf(err, "failed to save policy of network %s", name)
+		}
+	}
+
 	nm.LogNetworkEvent(ctx, net, "create")
 
 	return &network, nil
@@ -200,10 +304,33 @@ func (nm *NetworkManager) Remove(ctx context.Context, name string) error {
 		return nil
 	}
 
+	reservations, err := nm.networkReservations(nw.ID())
+	if err != nil {
+		return err
+	}
+
+	// placeholder endpoints must be released before deleting network.
+	for _, r := range reservations {
+		nm.releaseReservation(nw, r)
+	}
+
 	if err := nw.Delete(); err != nil {
+		for _, r := range reservations {
+			if err := nm.holdReservation(ctx, nw, r); err != nil {
+				log.With(ctx).Warnf("failed to hold reservation %s on network %s: %v", r.Name, nw.Name(), err)
+			}
+		}
 		return err
 	}
 
+	for _, r := range reservations {
+		if err := nm.reservationStore.Remove(r.Key()); err != nil {
+			log.With(ctx).Warnf("failed to remove reservation %s of network %s: %v", r.Name, nw.Name(), err)
+		}
+	}
+
+	nm.removePolicy(ctx, nw.ID())
+
 	nm.LogNetworkEvent(ctx, nw, "destroy")
 	return nil
 }
@@ -286,6 +413,11 @@ func (nm *NetworkManager) EndpointCreate(ctx context.Context, endpoint *types.En
 		return "", errors.Wrap(errtypes.ErrInvalidParam, "networkConfig or endpointConfig cannot be empty")
 	}
 
+	// network of cni mode is set up by CNI plugins, not libnetwork.
+	if IsCNI(network) {
+		return nm.cniEndpointCreate(ctx, endpoint)
+	}
+
 	n, err := nm.controller.NetworkByName(network)
 	if err != nil {
 		if err == libnetwork.ErrNoSuchNetwork(network) {
@@ -294,8 +426,17 @@ func (nm *NetworkManager) EndpointCreate(ctx context.Context, endpoint *types.En
 		return "", err
 	}
 
+	// apply the addresses reserved for the container
+	reservation, err := nm.reservation(n.ID(), endpoint.OwnerName)
+	if err != nil {
+		return "", err
+	}
+	if reservation != nil {
+		applyReservation(reservation, endpointConfig)
+	}
+
 	// create endpoint
-	epOptions, err := endpointOptions(n, endpoint)
+	epOptions, err := endpointOptions(n, endpoint, reservation)
 	if err != nil {
 		return "", err
 	}
@@ -307,8 +448,19 @@ func (nm *NetworkManager) EndpointCreate(ctx context.Context, endpoint *types.En
 		ep.Delete(true)
 	}
 
+	// the placeholder endpoint should release the reserved addresses
+	// before the owner takes them.
+	if reservation != nil {
+		nm.releaseReservation(n, reservation)
+	}
+
 	ep, err := n.CreateEndpoint(endpointName, epOptions...)
 	if err != nil {
+		if reservation != nil {
+			if herr := nm.holdReservation(ctx, n, reservation); herr != nil {
+				log.With(ctx).Warnf("failed to hold reservation %s on network %s: %v", reservation.Name, n.Name(), herr)
+			}
+		}
 		return "", err
 	}
 
@@ -370,6 +522,27 @@ func (nm *NetworkManager) EndpointCreate(ctx context.Context, endpoint *types.En
 		}
 	}
 
+	// the endpoint can't stay on network if its policy is not enforced.
+	if perr := nm.syncNetworkPolicy(ctx, n, endpoint); perr != nil {
+		if lerr := ep.Leave(sb); lerr != nil {
+			log.With(ctx).Errorf("failed to leave sandbox after failing to sync policy of network %s: %v", n.Name(), lerr)
+		}
+		if derr := ep.Delete(true); derr != nil {
+			log.With(ctx).Errorf("failed to delete endpoint %s after failing to sync policy of network %s: %v", ep.Name(), n.Name(), derr)
+		}
+		if len(sb.Endpoints()) == 0 {
+			if derr := sb.Delete(); derr != nil {
+				log.With(ctx).Errorf("failed to delete sandbox %s after failing to sync policy of network %s: %v", sb.ID(), n.Name(), derr)
+			}
+		}
+		if reservation != nil {
+			if herr := nm.holdReservation(ctx, n, reservation); herr != nil {
+				log.With(ctx).Warnf("failed to hold reservation %s on network %s: %v", reservation.Name, n.Name(), herr)
+			}
+		}
+		return "", errors.Wrapf(perr, "failed to sync policy of network %s", n.Name())
+	}
+
 	return endpointName, nil
 }
 
@@ -396,6 +569,10 @@ func (nm *NetworkManager) EndpointRemove(ctx context.Context, endpoint *types.En
 
 	log.With(nil).Debugf("remove endpoint(%s) on network(%s)", epConfig.EndpointID, endpoint.Name)
 
+	if IsCNI(endpoint.Name) {
+		return nm.cniEndpointRemove(ctx, endpoint)
+	}
+
 	if sid == "" {
 		return nil
 	}
@@ -436,6 +613,25 @@ func (nm *NetworkManager) EndpointRemove(ctx context.Context, endpoint *types.En
 	// clean endpoint configure data
 	nm.cleanEndpointConfig(epConfig)
 
+	// the stale address of endpoint in ipsets must be removed, or the
+	// container taking the address later gets the access of it, so the
+	// failure is returned after the endpoint is cleaned.
+	var policyErr error
+
+	if n, err := nm.controller.NetworkByName(endpoint.Name); err == nil {
+		if err := nm.syncNetworkPolicy(ctx, n, nil); err != nil {
+			log.With(ctx).Errorf("failed to sync policy of network %s: %v", n.Name(), err)
+			policyErr = errors.Wrapf(err, "failed to sync policy of network %s", n.Name())
+		}
+
+		// hold the reserved addresses again after the owner leaves.
+		if r, err := nm.reservation(n.ID(), endpoint.OwnerName); err == nil && r != nil {
+			if err := nm.holdReservation(ctx, n, r); err != nil {
+				log.With(ctx).Warnf("failed to hold reservation %s on network %s: %v", r.Name, n.Name(), err)
+			}
+		}
+	}
+
 	// check sandbox has endpoint or not.
 	eplist = sb.Endpoints()
 	if len(eplist) == 0 {
@@ -445,7 +641,7 @@ func (nm *NetworkManager) EndpointRemove(ctx context.Context, endpoint *types.En
 		}
 	}
 
-	return nil
+	return policyErr
 }
 
 // GetNetworkStats returns the network stats of specific sandbox
@@ -477,6 +673,210 @@ func (nm *NetworkManager) GetNetworkStats(sandboxID string) (map[string]apitypes
 	return stats, nil
 }
 
+// Reserve reserves static addresses on network for the container with the given name.
+// The reserved addresses are excluded from dynamic allocation, and are
+// assigned to the container when it is connected to the network.
+func (nm *NetworkManager) Reserve(ctx context.Context, idName string, reservation apitypes.NetworkReservation) error {
+	n, err := nm.Get(ctx, idName)
+	if err != nil {
+		return err
+	}
+
+	if err := validateReservation(n.Network, &reservation); err != nil {
+		return errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+
+	reservations, err := nm.networkReservations(n.ID)
+	if err != nil {
+		return err
+	}
+	for _, r := range reservations {
+		if r.Name == reservation.Name {
+			return errors.Wrapf(errtypes.ErrAlreadyExisted, "reservation %s on network %s", r.Name, n.Name)
+		}
+		if conflict := reservationConflict(&r.NetworkReservation, &reservation); conflict != "" {
+			return errors.Wrapf(errtypes.ErrAlreadyExisted, "address %s reserved by %s on network %s", conflict, r.Name, n.Name)
+		}
+	}
+
+	r := &types.Reservation{
+		NetworkReservation: reservation,
+		NetworkID:          n.ID,
+	}
+	if err := nm.holdReservation(ctx, n.Network, r); err != nil {
+		return errors.Wrapf(err, "failed to reserve addresses on network %s", n.Name)
+	}
+
+	if err := nm.reservationStore.Put(r); err != nil {
+		nm.releaseReservation(n.Network, r)
+		return errors.Wrapf(err, "failed to save reservation %s", r.Name)
+	}
+
+	nm.LogNetworkEventWithAttributes(ctx, n.Network, "reserve", map[string]string{"container": r.Name})
+	return nil
+}
+
+// Unreserve releases the addresses reserved on network for the container with the given name.
+func (nm *NetworkManager) Unreserve(ctx context.Context, idName string, name string) error {
+	n, err := nm.Get(ctx, idName)
+	if err != nil {
+		return err
+	}
+
+	r, err := nm.reservation(n.ID, name)
+	if err != nil {
+		return err
+	}
+	if r == nil {
+		return errors.Wrapf(errtypes.ErrNotfound, "reservation %s on network %s", name, n.Name)
+	}
+
+	nm.releaseReservation(n.Network, r)
+
+	if err := nm.reservationStore.Remove(r.Key()); err != nil {
+		return errors.Wrapf(err, "failed to remove reservation %s", r.Name)
+	}
+
+	nm.LogNetworkEventWithAttributes(ctx, n.Network, "unreserve", map[string]string{"container": r.Name})
+	return nil
+}
+
+// Reservations returns all the reservations on network.
+func (nm *NetworkManager) Reservations(ctx context.Context, idName string) ([]*types.Reservation, error) {
+	n, err := nm.Get(ctx, idName)
+	if err != nil {
+		return nil, err
+	}
+
+	return nm.networkReservations(n.ID)
+}
+
+// networkReservations returns the reservations on network that specified id.
+func (nm *NetworkManager) networkReservations(networkID string) ([]*types.Reservation, error) {
+	objs, err := nm.reservationStore.List()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list reservations")
+	}
+
+	var reservations []*types.Reservation
+	for _, obj := range objs {
+		r, ok := obj.(*types.Reservation)
+		if !ok || r.NetworkID != networkID {
+			continue
+		}
+		reservations = append(reservations, r)
+	}
+
+	sort.Slice(reservations, func(i, j int) bool {
+		return reservations[i].Name < reservations[j].Name
+	})
+	return reservations, nil
+}
+
+// reservation returns the reservation of container on network, it returns
+// nil if there is no such reservation.
+func (nm *NetworkManager) reservation(networkID, name string) (*types.Reservation, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	reservations, err := nm.networkReservations(networkID)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range reservations {
+		if r.Name == name {
+			return r, nil
+		}
+	}
+	return nil, nil
+}
+
+// holdReservation creates the placeholder endpoint which holds the reserved
+// addresses in IPAM, so that they can not be allocated to others.
+func (nm *NetworkManager) holdReservation(ctx context.Context, n libnetwork.Network, r *types.Reservation) error {
+	if r.IPV4Address == "" && r.IPV6Address == "" {
+		return nil
+	}
+
+	if ep, _ := n.EndpointByName(reservedEndpointPrefix + r.Name); ep != nil {
+		return nil
+	}
+
+	// the owner may have been connected to the network with the addresses.
+	if holder := endpointByAddress(n, r); holder != nil {
+		if nm.isReservationOwner(ctx, holder, r) {
+			return nil
+		}
+		return errors.Wrapf(errtypes.ErrAlreadyExisted, "address of reservation %s is in use by endpoint %s", r.Name, holder.Name())
+	}
+
+	_, err := n.CreateEndpoint(reservedEndpointPrefix+r.Name,
+		libnetwork.CreateOptionIpam(net.ParseIP(r.IPV4Address), net.ParseIP(r.IPV6Address), nil, nil),
+		libnetwork.CreateOptionAnonymous(),
+		libnetwork.CreateOptionDisableResolution())
+	return err
+}
+
+// releaseReservation deletes the placeholder endpoint of reservation.
+func (nm *NetworkManager) releaseReservation(n libnetwork.Network, r *types.Reservation) {
+	ep, _ := n.EndpointByName(reservedEndpointPrefix + r.Name)
+	if ep == nil {
+		return
+	}
+
+	if err := ep.Delete(true); err != nil {
+		log.With(nil).Warnf("failed to delete placeholder endpoint of reservation %s: %v", r.Name, err)
+	}
+}
+
+// isReservationOwner checks whether endpoint is joined by the container which owns the reservation.
+func (nm *NetworkManager) isReservationOwner(ctx context.Context, ep libnetwork.Endpoint, r *types.Reservation) bool {
+	info := ep.Info()
+	if info == nil || info.Sandbox() == nil || nm.ctrMgr == nil {
+		return false
+	}
+	sb := info.Sandbox()
+
+	c, err := nm.ctrMgr.Get(ctx, sb.ContainerID())
+	if err != nil {
+		return false
+	}
+	return c.Name == r.Name
+}
+
+// restoreReservations holds the reserved addresses again, and cleans the
+// reservations whose network has been removed.
+func (nm *NetworkManager) restoreReservations(ctx context.Context) {
+	objs, err := nm.reservationStore.List()
+	if err != nil {
+		log.With(ctx).Errorf("failed to list reservations: %v", err)
+		return
+	}
+
+	for _, obj := range objs {
+		r, ok := obj.(*types.Reservation)
+		if !ok {
+			continue
+		}
+
+		n, err := nm.controller.NetworkByID(r.NetworkID)
+		if err != nil {
+			if isNoSuchNetworkError(err) {
+				log.With(ctx).Infof("remove reservation %s of removed network %s", r.Name, r.NetworkID)
+				if err := nm.reservationStore.Remove(r.Key()); err != nil {
+					log.With(ctx).Warnf("failed to remove reservation %s: %v", r.Name, err)
+				}
+			}
+			continue
+		}
+
+		if err := nm.holdReservation(ctx, n, r); err != nil {
+			log.With(ctx).Errorf("failed to hold reservation %s on network %s: %v", r.Name, n.Name(), err)
+		}
+	}
+}
+
 // Controller returns the network controller.
 func (nm *NetworkManager) Controller() libnetwork.NetworkController {
 	return nm.controller
@@ -592,7 +992,7 @@ func (nm *NetworkManager) getNetworkSandbox(id string) libnetwork.Sandbox {
 	return sb
 }
 
-func endpointOptions(n libnetwork.Network, endpoint *types.Endpoint) ([]libnetwork.EndpointOption, error) {
+func endpointOptions(n libnetwork.Network, endpoint *types.Endpoint, reservation *types.Reservation) ([]libnetwork.EndpointOption, error) {
 	var createOptions []libnetwork.EndpointOption
 	epConfig := endpoint.EndpointConfig
 	if epConfig != nil {
@@ -627,6 +1027,16 @@ func endpointOptions(n libnetwork.Network, endpoint *types.Endpoint) ([]libnetwo
 		genericOption, _ = utils.MergeMap(genericOption, options.Generic{netlabel.MacAddress: mac})
 		log.With(nil).Debugf("generate endpoint macaddress: (%s)", endpoint.MacAddress)
 	}
+
+	// the reserved mac address takes effect on any network.
+	if reservation != nil && reservation.MacAddress != "" {
+		mac, err := net.ParseMAC(reservation.MacAddress)
+		if err != nil {
+			return nil, err
+		}
+		genericOption, _ = utils.MergeMap(genericOption, options.Generic{netlabel.MacAddress: mac})
+		log.With(nil).Debugf("generate endpoint reserved macaddress: (%s)", reservation.MacAddress)
+	}
 	createOptions = append(createOptions, libnetwork.EndpointOptionGeneric(genericOption))
 
 	if endpoint.DisableResolver {
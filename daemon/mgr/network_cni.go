@@ -0,0 +1,201 @@
+package mgr
+
+import (
+	"context"
+	"net"
+
+	cni "github.com/alibaba/pouch/cri/ocicni"
+	"github.com/alibaba/pouch/network"
+	"github.com/alibaba/pouch/network/types"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/cri-o/ocicni/pkg/ocicni"
+	"github.com/docker/libnetwork/etchosts"
+	"github.com/docker/libnetwork/resolvconf"
+	networktypes "github.com/docker/libnetwork/types"
+	"github.com/pkg/errors"
+)
+
+// cniPodNamespace is the namespace passed to CNI plugins for the containers
+// created by pouch API, to distinguish from the pods created by CRI.
+const cniPodNamespace = "pouch"
+
+// cniManager returns the CNI manager which loads configs from the cni conf
+// dir, it is initialized at the first time of use.
+func (nm *NetworkManager) cniManager() (cni.CniMgr, error) {
+	nm.cniLock.Lock()
+	defer nm.cniLock.Unlock()
+
+	if nm.cniMgr != nil {
+		return nm.cniMgr, nil
+	}
+
+	cniMgr, err := cni.NewCniManager(&nm.cniConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to initialize cni manager")
+	}
+	nm.cniMgr = cniMgr
+	return cniMgr, nil
+}
+
+// cniPodNetwork builds the CNI pod network of endpoint.
+func cniPodNetwork(cniMgr cni.CniMgr, endpoint *types.Endpoint, netnsPath string) *ocicni.PodNetwork {
+	podNetwork := &ocicni.PodNetwork{
+		Name:      endpoint.OwnerName,
+		Namespace: cniPodNamespace,
+		ID:        endpoint.Owner,
+		NetNS:     netnsPath,
+	}
+
+	name := cniNetworkName(endpoint.Name)
+	if name != "" {
+		podNetwork.Networks = []string{name}
+	} else {
+		name = cniMgr.GetDefaultNetworkName()
+	}
+
+	// pass the user specified ip address to the plugins by CNI_ARGS.
+	if epConfig := endpoint.EndpointConfig; epConfig != nil && epConfig.IPAMConfig != nil && epConfig.IPAMConfig.IPV4Address != "" {
+		podNetwork.RuntimeConfig = map[string]ocicni.RuntimeConfig{
+			name: {IP: epConfig.IPAMConfig.IPV4Address},
+		}
+	}
+	return podNetwork
+}
+
+// cniEndpointCreate sets up the network of container by CNI plugins. The
+// network namespace is created here, and is joined by the container later.
+func (nm *NetworkManager) cniEndpointCreate(ctx context.Context, endpoint *types.Endpoint) (_ string, err error) {
+	cniMgr, err := nm.cniManager()
+	if err != nil {
+		return "", err
+	}
+
+	containerID := endpoint.Owner
+	networkConfig := endpoint.NetworkConfig
+	endpointConfig := endpoint.EndpointConfig
+
+	netnsPath, err := cniMgr.NewNetNS()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create network namespace")
+	}
+	defer func() {
+		if err != nil {
+			if err := cniMgr.RemoveNetNS(netnsPath); err != nil {
+				log.With(ctx).Errorf("failed to remove network namespace %s: %v", netnsPath, err)
+			}
+		}
+	}()
+
+	podNetwork := cniPodNetwork(cniMgr, endpoint, netnsPath)
+	if err = cniMgr.SetUpPodNetwork(podNetwork); err != nil {
+		return "", err
+	}
+	defer func() {
+		if err != nil {
+			if err := cniMgr.TearDownPodNetwork(podNetwork); err != nil {
+				log.With(ctx).Errorf("failed to tear down cni network of container %s: %v", containerID, err)
+			}
+		}
+	}()
+
+	if err = cniMgr.CheckPodNetwork(podNetwork); err != nil {
+		return "", err
+	}
+
+	ip, err := cniMgr.GetPodNetworkStatus(netnsPath)
+	if err != nil {
+		return "", err
+	}
+
+	if err = buildCNINetworkFiles(nm.config, endpoint, ip); err != nil {
+		return "", err
+	}
+
+	// update endpoint settings
+	networkConfig.SandboxKey = netnsPath
+	endpointConfig.EndpointID = containerID
+	endpointConfig.NetworkID = endpoint.Name
+	if parsed := net.ParseIP(ip); parsed != nil && parsed.To4() == nil {
+		endpointConfig.GlobalIPV6Address = ip
+	} else {
+		endpointConfig.IPAddress = ip
+	}
+
+	return containerID[:8], nil
+}
+
+// cniEndpointRemove tears down the network of container by CNI plugins, and
+// removes the network namespace.
+func (nm *NetworkManager) cniEndpointRemove(ctx context.Context, endpoint *types.Endpoint) error {
+	netnsPath := endpoint.NetworkConfig.SandboxKey
+	if netnsPath == "" {
+		return nil
+	}
+
+	cniMgr, err := nm.cniManager()
+	if err != nil {
+		return err
+	}
+
+	if err := cniMgr.TearDownPodNetwork(cniPodNetwork(cniMgr, endpoint, netnsPath)); err != nil {
+		return err
+	}
+
+	if err := cniMgr.RemoveNetNS(netnsPath); err != nil {
+		return errors.Wrapf(err, "failed to remove network namespace %s", netnsPath)
+	}
+
+	// clean endpoint configure data
+	nm.cleanEndpointConfig(endpoint.EndpointConfig)
+	endpoint.NetworkConfig.SandboxKey = ""
+
+	return nil
+}
+
+// buildCNINetworkFiles writes the hosts and resolv.conf files of container,
+// which are filled by libnetwork in the other network modes.
+func buildCNINetworkFiles(config network.Config, endpoint *types.Endpoint, ip string) error {
+	if endpoint.HostsPath != "" {
+		if err := etchosts.Build(endpoint.HostsPath, ip, string(endpoint.Hostname), endpoint.Domainname, nil); err != nil {
+			return errors.Wrapf(err, "failed to build hosts file %s", endpoint.HostsPath)
+		}
+	}
+
+	if endpoint.ResolvConfPath == "" {
+		return nil
+	}
+
+	hostResolv, err := resolvconf.Get()
+	if err != nil {
+		return errors.Wrap(err, "failed to get host resolv.conf")
+	}
+
+	// endpoint configs take precedence over daemon configs, then the host ones.
+	dns := resolvconf.GetNameservers(hostResolv.Content, networktypes.IP)
+	if len(endpoint.DNS) > 0 {
+		dns = endpoint.DNS
+	} else if len(config.DNS) > 0 {
+		dns = config.DNS
+	}
+
+	dnsSearch := resolvconf.GetSearchDomains(hostResolv.Content)
+	if len(endpoint.DNSSearch) > 0 {
+		dnsSearch = endpoint.DNSSearch
+	} else if len(config.DNSSearch) > 0 {
+		dnsSearch = config.DNSSearch
+	}
+
+	dnsOptions := resolvconf.GetOptions(hostResolv.Content)
+	if len(endpoint.DNSOptions) > 0 {
+		dnsOptions = endpoint.DNSOptions
+	} else if len(config.DNSOptions) > 0 {
+		dnsOptions = config.DNSOptions
+	}
+
+	if _, err := resolvconf.Build(endpoint.ResolvConfPath, dns, dnsSearch, dnsOptions); err != nil {
+		return errors.Wrapf(err, "failed to build resolv.conf %s", endpoint.ResolvConfPath)
+	}
+
+	return nil
+}
@@ -0,0 +1,455 @@
+package mgr
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/opts"
+	apitypes "github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/network/types"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/docker/libnetwork"
+	"github.com/docker/libnetwork/iptables"
+	"github.com/pkg/errors"
+)
+
+const (
+	// policyChain is the chain in filter table which dispatches the
+	// forwarded traffic to the policy chains of networks.
+	policyChain = "POUCH-POLICY"
+
+	// networkPolicyChainPrefix is the name prefix of policy chain of network.
+	networkPolicyChainPrefix = "POUCH-NET-"
+
+	// policyIPSetPrefix is the name prefix of ipsets, which contain the
+	// addresses of containers selected by labels in ingress rules.
+	policyIPSetPrefix = "pouch-"
+)
+
+// policyEndpoint is an endpoint on network with its effective policy.
+type policyEndpoint struct {
+	IP     string
+	IPv6   string
+	Labels map[string]string
+	Policy *apitypes.NetworkPolicy
+}
+
+// compiledPolicy is the iptables rules and ipsets compiled from the
+// policies of network and containers.
+type compiledPolicy struct {
+	// Chain is the policy chain of network.
+	Chain string
+	// Rules are the rules of policy chain, without "-A chain".
+	Rules [][]string
+	// IPSets are the member addresses of ipsets referenced by rules.
+	IPSets map[string][]string
+}
+
+// String returns the rules in format of iptables-save.
+func (c *compiledPolicy) String() []string {
+	rules := make([]string, 0, len(c.Rules))
+	for _, rule := range c.Rules {
+		rules = append(rules, strings.Join(append([]string{"-A", c.Chain}, rule...), " "))
+	}
+	return rules
+}
+
+// networkPolicyChain returns the policy chain name of network.
+func networkPolicyChain(networkID string) string {
+	return networkPolicyChainPrefix + shortID(networkID, 12)
+}
+
+// networkIPSetPrefix returns the name prefix of ipsets of network.
+func networkIPSetPrefix(networkID string) string {
+	return policyIPSetPrefix + shortID(networkID, 8) + "-"
+}
+
+// policyIPSetName returns the ipset name of the label selector, the length
+// of ipset name is limited to 31 characters.
+func policyIPSetName(networkID string, selector map[string]string) string {
+	keys := make([]string, 0, len(selector))
+	for k := range selector {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s=%s\n", k, selector[k])
+	}
+	return networkIPSetPrefix(networkID) + hex.EncodeToString(h.Sum(nil))[:8]
+}
+
+func shortID(id string, n int) string {
+	if len(id) > n {
+		return id[:n]
+	}
+	return id
+}
+
+// matchLabels checks whether labels contain all of the selector.
+func matchLabels(labels, selector map[string]string) bool {
+	for k, v := range selector {
+		if value, ok := labels[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+// compileNetworkPolicy compiles the policies of endpoints on network into
+// iptables rules. The traffic to an endpoint with policy is dropped unless
+// it matches one of the ingress rules, the matched traffic returns to the
+// FORWARD chain and goes through the other rules, such as icc. The rules
+// are IPv4 only, so the endpoint with policy must not have IPv6 address.
+func compileNetworkPolicy(networkID string, endpoints []policyEndpoint) (*compiledPolicy, error) {
+	c := &compiledPolicy{
+		Chain:  networkPolicyChain(networkID),
+		IPSets: make(map[string][]string),
+	}
+
+	sort.Slice(endpoints, func(i, j int) bool {
+		return endpoints[i].IP < endpoints[j].IP
+	})
+
+	for _, ep := range endpoints {
+		if ep.Policy == nil {
+			continue
+		}
+		if ep.IPv6 != "" {
+			return nil, errors.Errorf("network policy is not supported on endpoint %s with IPv6 address %s", ep.IP, ep.IPv6)
+		}
+
+		// replies of the connections initiated by the endpoint are allowed.
+		if len(c.Rules) == 0 {
+			c.Rules = append(c.Rules, []string{"-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "RETURN"})
+		}
+
+		dst := []string{"-d", ep.IP + "/32"}
+		for _, rule := range ep.Policy.Ingress {
+			if rule == nil {
+				continue
+			}
+
+			var src []string
+			if len(rule.FromLabels) > 0 {
+				name := policyIPSetName(networkID, rule.FromLabels)
+				if _, ok := c.IPSets[name]; !ok {
+					members := []string{}
+					for _, from := range endpoints {
+						if matchLabels(from.Labels, rule.FromLabels) {
+							members = append(members, from.IP)
+						}
+					}
+					c.IPSets[name] = members
+				}
+				src = []string{"-m", "set", "--match-set", name, "src"}
+			}
+
+			if len(rule.Ports) == 0 {
+				c.Rules = append(c.Rules, joinArgs(dst, src, []string{"-j", "RETURN"}))
+				continue
+			}
+
+			for _, port := range rule.Ports {
+				proto, start, end, err := opts.ParsePolicyPort(port)
+				if err != nil {
+					log.With(nil).Warnf("skip invalid port %s in ingress rule of %s: %v", port, ep.IP, err)
+					continue
+				}
+				dport := strconv.Itoa(start)
+				if end != start {
+					dport = dport + ":" + strconv.Itoa(end)
+				}
+				c.Rules = append(c.Rules, joinArgs(dst, []string{"-p", proto, "--dport", dport}, src, []string{"-j", "RETURN"}))
+			}
+		}
+
+		c.Rules = append(c.Rules, joinArgs(dst, []string{"-j", "DROP"}))
+	}
+
+	return c, nil
+}
+
+func joinArgs(args ...[]string) []string {
+	var res []string
+	for _, arg := range args {
+		res = append(res, arg...)
+	}
+	return res
+}
+
+// networkPolicy returns the default policy of network, nil means no policy.
+func (nm *NetworkManager) networkPolicy(networkID string) (*types.Policy, error) {
+	objs, err := nm.policyStore.List()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list network policies")
+	}
+
+	for _, obj := range objs {
+		p, ok := obj.(*types.Policy)
+		if ok && p.NetworkID == networkID {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
+
+// policyEndpoints returns the endpoints on network with their effective
+// policies. The endpoint which is joining network may be not in the
+// container's network settings yet, so it is passed by caller.
+func (nm *NetworkManager) policyEndpoints(ctx context.Context, n libnetwork.Network, joining *types.Endpoint) ([]policyEndpoint, error) {
+	var defaultPolicy *apitypes.NetworkPolicy
+	p, err := nm.networkPolicy(n.ID())
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		defaultPolicy = &p.NetworkPolicy
+	}
+
+	var endpoints []policyEndpoint
+	for _, ep := range n.Endpoints() {
+		info := ep.Info()
+		// endpoints without sandbox are the placeholders of reservations.
+		if info == nil || info.Sandbox() == nil || info.Iface() == nil || info.Iface().Address() == nil {
+			continue
+		}
+		containerID := info.Sandbox().ContainerID()
+
+		pe := policyEndpoint{
+			IP:     info.Iface().Address().IP.String(),
+			Policy: defaultPolicy,
+		}
+		if addr := info.Iface().AddressIPv6(); addr != nil && addr.IP.To16() != nil {
+			pe.IPv6 = addr.IP.String()
+		}
+
+		if nm.ctrMgr != nil {
+			if c, err := nm.ctrMgr.Get(ctx, containerID); err == nil {
+				if c.Config != nil {
+					pe.Labels = c.Config.Labels
+				}
+				if c.NetworkSettings != nil {
+					if epConfig, ok := c.NetworkSettings.Networks[n.Name()]; ok && epConfig != nil && epConfig.Policy != nil {
+						pe.Policy = epConfig.Policy
+					}
+				}
+			}
+		}
+
+		if joining != nil && joining.Owner == containerID && joining.EndpointConfig != nil && joining.EndpointConfig.Policy != nil {
+			pe.Policy = joining.EndpointConfig.Policy
+		}
+
+		endpoints = append(endpoints, pe)
+	}
+
+	return endpoints, nil
+}
+
+// syncNetworkPolicy compiles the policies on network and updates the
+// iptables rules and ipsets, it is called when endpoints join and leave.
+func (nm *NetworkManager) syncNetworkPolicy(ctx context.Context, n libnetwork.Network, joining *types.Endpoint) error {
+	// the endpoints are collected under the lock too, otherwise a sync with
+	// stale endpoints may apply its rules after a newer one.
+	nm.policyLock.Lock()
+	defer nm.policyLock.Unlock()
+
+	endpoints, err := nm.policyEndpoints(ctx, n, joining)
+	if err != nil {
+		return err
+	}
+	compiled, err := compileNetworkPolicy(n.ID(), endpoints)
+	if err != nil {
+		return err
+	}
+
+	if len(compiled.Rules) == 0 {
+		if _, ok := nm.policyRules[n.ID()]; !ok {
+			return nil
+		}
+		delete(nm.policyRules, n.ID())
+		return removeNetworkPolicy(n.ID())
+	}
+
+	if err := applyNetworkPolicy(compiled, networkIPSetPrefix(n.ID())); err != nil {
+		return errors.Wrapf(err, "failed to apply policy of network %s", n.Name())
+	}
+	nm.policyRules[n.ID()] = compiled.String()
+	return nil
+}
+
+// removePolicy removes the policy rules of network, and the default policy
+// stored.
+func (nm *NetworkManager) removePolicy(ctx context.Context, networkID string) {
+	nm.policyLock.Lock()
+	delete(nm.policyRules, networkID)
+	nm.policyLock.Unlock()
+
+	if err := removeNetworkPolicy(networkID); err != nil {
+		log.With(ctx).Warnf("failed to remove policy rules of network %s: %v", networkID, err)
+	}
+
+	if p, err := nm.networkPolicy(networkID); err == nil && p != nil {
+		if err := nm.policyStore.Remove(p.Key()); err != nil {
+			log.With(ctx).Warnf("failed to remove policy of network %s: %v", networkID, err)
+		}
+	}
+}
+
+// restorePolicies applies the policies of networks again, and cleans the
+// stale rules of networks which have no policy any more.
+func (nm *NetworkManager) restorePolicies(ctx context.Context) {
+	for _, n := range nm.controller.Networks() {
+		if iptables.ExistChain(networkPolicyChain(n.ID()), iptables.Filter) {
+			nm.policyLock.Lock()
+			nm.policyRules[n.ID()] = nil
+			nm.policyLock.Unlock()
+		}
+
+		if err := nm.syncNetworkPolicy(ctx, n, nil); err != nil {
+			log.With(ctx).Errorf("failed to restore policy of network %s: %v", n.Name(), err)
+		}
+	}
+}
+
+// NetworkPolicy returns the default policy of network and the effective
+// iptables rules compiled from the policies of network and containers.
+func (nm *NetworkManager) NetworkPolicy(ctx context.Context, idName string) (*apitypes.NetworkPolicy, []string, error) {
+	n, err := nm.Get(ctx, idName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var policy *apitypes.NetworkPolicy
+	p, err := nm.networkPolicy(n.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if p != nil {
+		policy = &p.NetworkPolicy
+	}
+
+	nm.policyLock.Lock()
+	rules := nm.policyRules[n.ID]
+	nm.policyLock.Unlock()
+
+	return policy, rules, nil
+}
+
+// applyNetworkPolicy programs the compiled rules and ipsets of network.
+func applyNetworkPolicy(c *compiledPolicy, ipsetPrefix string) error {
+	if _, err := iptables.NewChain(policyChain, iptables.Filter, false); err != nil {
+		return err
+	}
+	if !iptables.Exists(iptables.Filter, "FORWARD", "-j", policyChain) {
+		if err := iptables.RawCombinedOutput("-I", "FORWARD", "-j", policyChain); err != nil {
+			return errors.Wrapf(err, "failed to insert jump to %s chain", policyChain)
+		}
+	}
+
+	if _, err := iptables.NewChain(c.Chain, iptables.Filter, false); err != nil {
+		return err
+	}
+	if !iptables.Exists(iptables.Filter, policyChain, "-j", c.Chain) {
+		if err := iptables.RawCombinedOutput("-A", policyChain, "-j", c.Chain); err != nil {
+			return errors.Wrapf(err, "failed to add jump to %s chain", c.Chain)
+		}
+	}
+
+	// ipsets must be ready before rules reference them.
+	for name, members := range c.IPSets {
+		if err := ipset("create", name, "hash:ip", "-exist"); err != nil {
+			return err
+		}
+		if err := ipset("flush", name); err != nil {
+			return err
+		}
+		for _, ip := range members {
+			if err := ipset("add", name, ip, "-exist"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := iptables.RawCombinedOutput("-F", c.Chain); err != nil {
+		return errors.Wrapf(err, "failed to flush %s chain", c.Chain)
+	}
+	for _, rule := range c.Rules {
+		if err := iptables.RawCombinedOutput(append([]string{"-A", c.Chain}, rule...)...); err != nil {
+			return errors.Wrapf(err, "failed to add rule to %s chain", c.Chain)
+		}
+	}
+
+	// destroy the ipsets not referenced any more.
+	sets, err := listIPSets(ipsetPrefix)
+	if err != nil {
+		return err
+	}
+	for _, name := range sets {
+		if _, ok := c.IPSets[name]; !ok {
+			if err := ipset("destroy", name); err != nil {
+				log.With(nil).Warnf("failed to destroy ipset %s: %v", name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// removeNetworkPolicy removes the policy chain and ipsets of network.
+func removeNetworkPolicy(networkID string) error {
+	chain := networkPolicyChain(networkID)
+	if iptables.Exists(iptables.Filter, policyChain, "-j", chain) {
+		if err := iptables.RawCombinedOutput("-D", policyChain, "-j", chain); err != nil {
+			return errors.Wrapf(err, "failed to delete jump to %s chain", chain)
+		}
+	}
+	if err := iptables.RemoveExistingChain(chain, iptables.Filter); err != nil {
+		return err
+	}
+
+	sets, err := listIPSets(networkIPSetPrefix(networkID))
+	if err != nil {
+		return err
+	}
+	for _, name := range sets {
+		if err := ipset("destroy", name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ipset runs the ipset command.
+func ipset(args ...string) error {
+	if output, err := exec.Command("ipset", args...).CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "failed to run ipset %s: %s", strings.Join(args, " "), strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// listIPSets returns the names of ipsets with the prefix.
+func listIPSets(prefix string) ([]string, error) {
+	output, err := exec.Command("ipset", "list", "-n").CombinedOutput()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list ipsets: %s", strings.TrimSpace(string(output)))
+	}
+
+	var sets []string
+	for _, name := range strings.Split(string(output), "\n") {
+		name = strings.TrimSpace(name)
+		if strings.HasPrefix(name, prefix) {
+			sets = append(sets, name)
+		}
+	}
+	return sets, nil
+}
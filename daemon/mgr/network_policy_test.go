@@ -0,0 +1,68 @@
+package mgr
+
+import (
+	"testing"
+
+	apitypes "github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompileNetworkPolicy(t *testing.T) {
+	networkID := "0123456789abcdef0123456789abcdef"
+	chain := networkPolicyChain(networkID)
+	assert.Equal(t, "POUCH-NET-0123456789ab", chain)
+
+	// endpoints without policy produce no rules.
+	c, err := compileNetworkPolicy(networkID, []policyEndpoint{{IP: "10.0.0.2", IPv6: "fd00::2"}})
+	assert.NoError(t, err)
+	assert.Empty(t, c.Rules)
+
+	selector := map[string]string{"role": "frontend"}
+	ipset := policyIPSetName(networkID, selector)
+	assert.Equal(t, "pouch-01234567-", ipset[:15])
+	assert.True(t, len(ipset) <= 31)
+
+	endpoints := []policyEndpoint{
+		{
+			IP: "10.0.0.3",
+			Policy: &apitypes.NetworkPolicy{
+				Ingress: []*apitypes.NetworkPolicyRule{
+					{FromLabels: selector, Ports: []string{"8080/tcp", "1000-2000/udp"}},
+				},
+			},
+		},
+		{
+			IP:     "10.0.0.4",
+			Labels: map[string]string{"role": "frontend", "env": "prod"},
+		},
+		{
+			IP:     "10.0.0.2",
+			Policy: &apitypes.NetworkPolicy{Ingress: []*apitypes.NetworkPolicyRule{}},
+		},
+	}
+
+	c, err = compileNetworkPolicy(networkID, endpoints)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"-A " + chain + " -m conntrack --ctstate RELATED,ESTABLISHED -j RETURN",
+		"-A " + chain + " -d 10.0.0.2/32 -j DROP",
+		"-A " + chain + " -d 10.0.0.3/32 -p tcp --dport 8080 -m set --match-set " + ipset + " src -j RETURN",
+		"-A " + chain + " -d 10.0.0.3/32 -p udp --dport 1000:2000 -m set --match-set " + ipset + " src -j RETURN",
+		"-A " + chain + " -d 10.0.0.3/32 -j DROP",
+	}, c.String())
+	assert.Equal(t, map[string][]string{ipset: {"10.0.0.4"}}, c.IPSets)
+
+	// the IPv6 traffic to endpoint with policy can't be filtered.
+	endpoints[0].IPv6 = "fd00::3"
+	_, err = compileNetworkPolicy(networkID, endpoints)
+	assert.Error(t, err)
+}
+
+func TestMatchLabels(t *testing.T) {
+	labels := map[string]string{"role": "frontend", "env": "prod"}
+	assert.True(t, matchLabels(labels, nil))
+	assert.True(t, matchLabels(labels, map[string]string{"role": "frontend"}))
+	assert.False(t, matchLabels(labels, map[string]string{"role": "backend"}))
+	assert.False(t, matchLabels(nil, map[string]string{"role": ""}))
+}
@@ -0,0 +1,214 @@
+package mgr
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"time"
+
+	apitypes "github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/network/types"
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/docker/docker/pkg/ioutils"
+	"github.com/docker/libnetwork"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+)
+
+// NetworkStatsConfig contains all configs on network stats interface.
+// This struct is only used in daemon side.
+type NetworkStatsConfig struct {
+	Stream    bool
+	OutStream io.Writer
+}
+
+// NetworkEndpoints returns the endpoints of containers attached to the network, keyed by container ID.
+func (nm *NetworkManager) NetworkEndpoints(ctx context.Context, idName string) (map[string]apitypes.EndpointResource, error) {
+	n, err := nm.Get(ctx, idName)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := make(map[string]apitypes.EndpointResource)
+	for _, ep := range n.Network.Endpoints() {
+		info := ep.Info()
+		// endpoints without sandbox are the placeholders of reservations.
+		if info == nil || info.Sandbox() == nil {
+			continue
+		}
+		containerID := info.Sandbox().ContainerID()
+
+		resource := apitypes.EndpointResource{
+			EndpointID: ep.ID(),
+			Name:       ep.Name(),
+		}
+		if iface := info.Iface(); iface != nil {
+			if iface.MacAddress() != nil {
+				resource.MacAddress = iface.MacAddress().String()
+			}
+			if iface.Address() != nil {
+				resource.IPV4Address = iface.Address().String()
+			}
+			if iface.AddressIPv6() != nil {
+				resource.IPV6Address = iface.AddressIPv6().String()
+			}
+		}
+
+		if nm.ctrMgr != nil {
+			if c, err := nm.ctrMgr.Get(ctx, containerID); err == nil {
+				resource.Name = c.Name
+				if c.NetworkSettings != nil {
+					if epConfig, ok := c.NetworkSettings.Networks[n.Name]; ok && epConfig != nil {
+						resource.Aliases = epConfig.Aliases
+					}
+				}
+			}
+		}
+
+		endpoints[containerID] = resource
+	}
+
+	return endpoints, nil
+}
+
+// StreamNetworkStats collects the traffic stats of endpoints attached to
+// the network and sends back to caller as a stream.
+func (nm *NetworkManager) StreamNetworkStats(ctx context.Context, idName string, config *NetworkStatsConfig) error {
+	n, err := nm.Get(ctx, idName)
+	if err != nil {
+		return err
+	}
+
+	outStream := config.OutStream
+
+	// just collect stats data once.
+	if !config.Stream {
+		return json.NewEncoder(outStream).Encode(nm.networkStats(n))
+	}
+
+	wf := ioutils.NewWriteFlusher(outStream)
+	defer wf.Close()
+	wf.Flush()
+	outStream = wf
+
+	enc := json.NewEncoder(outStream)
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.With(nil).Infof("context is cancelled when streaming stats of network %s", n.ID)
+			return nil
+		default:
+			log.With(nil).Debugf("Start to stream stats of network %s", n.ID)
+			if err := enc.Encode(nm.networkStats(n)); err != nil {
+				return err
+			}
+
+			time.Sleep(DefaultStatsInterval)
+		}
+	}
+}
+
+// networkStats collects the traffic stats of each container endpoint
+// attached to the network, and aggregates them.
+func (nm *NetworkManager) networkStats(n *types.Network) *apitypes.NetworkStatsResp {
+	resp := &apitypes.NetworkStatsResp{
+		ID:        n.ID,
+		Name:      n.Name,
+		Read:      strfmt.DateTime(time.Now()),
+		Endpoints: make(map[string]apitypes.NetworkStats),
+		Total:     &apitypes.NetworkStats{},
+	}
+
+	for _, ep := range n.Network.Endpoints() {
+		info := ep.Info()
+		if info == nil || info.Sandbox() == nil || info.Iface() == nil {
+			continue
+		}
+		sb := info.Sandbox()
+
+		stats, err := nm.endpointStatistics(sb, info.Iface().MacAddress())
+		if err != nil {
+			log.With(nil).Debugf("failed to get stats of endpoint %s: %v", ep.ID(), err)
+			continue
+		}
+		stats.EndpointID = ep.ID()
+		stats.InstanceID = sb.ContainerID()
+
+		resp.Endpoints[sb.ContainerID()] = stats
+		addNetworkStats(resp.Total, &stats)
+	}
+
+	return resp
+}
+
+// endpointStatistics returns the stats of the interface of endpoint in
+// sandbox, the stats of interfaces are collected by sandbox, and the
+// interface of endpoint is the one with its mac address.
+func (nm *NetworkManager) endpointStatistics(sb libnetwork.Sandbox, mac net.HardwareAddr) (apitypes.NetworkStats, error) {
+	ifName, err := sandboxInterfaceName(sb, mac)
+	if err != nil {
+		return apitypes.NetworkStats{}, err
+	}
+
+	stats, err := nm.GetNetworkStats(sb.ID())
+	if err != nil {
+		return apitypes.NetworkStats{}, err
+	}
+
+	ifStats, ok := stats[ifName]
+	if !ok {
+		return apitypes.NetworkStats{}, errors.Errorf("no stats of interface %s in sandbox %s", ifName, sb.ID())
+	}
+	return ifStats, nil
+}
+
+// sandboxInterfaceName returns the name of interface with the given mac
+// address in the network namespace of sandbox, since libnetwork doesn't
+// tell the interface name of endpoint.
+func sandboxInterfaceName(sb libnetwork.Sandbox, mac net.HardwareAddr) (string, error) {
+	if sb.Key() == "" || mac == nil {
+		return "", errors.Errorf("sandbox %s has no network namespace", sb.ID())
+	}
+
+	ns, err := netns.GetFromPath(sb.Key())
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get network namespace of sandbox %s", sb.ID())
+	}
+	defer ns.Close()
+
+	h, err := netlink.NewHandleAt(ns)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create netlink handle of sandbox %s", sb.ID())
+	}
+	defer h.Delete()
+
+	links, err := h.LinkList()
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to list links of sandbox %s", sb.ID())
+	}
+
+	for _, link := range links {
+		if attrs := link.Attrs(); attrs != nil && attrs.HardwareAddr.String() == mac.String() {
+			return attrs.Name, nil
+		}
+	}
+
+	return "", errors.Errorf("no interface with mac address %s in sandbox %s", mac, sb.ID())
+}
+
+// addNetworkStats adds the counters of stats into total.
+func addNetworkStats(total *apitypes.NetworkStats, stats *apitypes.NetworkStats) {
+	total.RxBytes += stats.RxBytes
+	total.RxPackets += stats.RxPackets
+	total.RxErrors += stats.RxErrors
+	total.RxDropped += stats.RxDropped
+	total.TxBytes += stats.TxBytes
+	total.TxPackets += stats.TxPackets
+	total.TxErrors += stats.TxErrors
+	total.TxDropped += stats.TxDropped
+}
@@ -0,0 +1,25 @@
+package mgr
+
+import (
+	"testing"
+
+	apitypes "github.com/alibaba/pouch/apis/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddNetworkStats(t *testing.T) {
+	total := &apitypes.NetworkStats{}
+
+	addNetworkStats(total, &apitypes.NetworkStats{RxBytes: 100, RxPackets: 2, TxBytes: 50, TxPackets: 1, RxDropped: 1})
+	addNetworkStats(total, &apitypes.NetworkStats{RxBytes: 20, RxPackets: 1, TxBytes: 30, TxPackets: 3, TxErrors: 2})
+
+	assert.Equal(t, apitypes.NetworkStats{
+		RxBytes:   120,
+		RxPackets: 3,
+		RxDropped: 1,
+		TxBytes:   80,
+		TxPackets: 4,
+		TxErrors:  2,
+	}, *total)
+}
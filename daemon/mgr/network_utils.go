@@ -2,9 +2,13 @@ package mgr
 
 import (
 	"fmt"
+	"net"
 	"strings"
 
 	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/daemon/config"
+	networktypes "github.com/alibaba/pouch/network/types"
+	"github.com/alibaba/pouch/pkg/log"
 
 	"github.com/docker/libnetwork"
 )
@@ -36,9 +40,26 @@ func IsNetNS(mode string) bool {
 	return len(parts) > 1 && parts[0] == "netns"
 }
 
+// IsCNI is used to check if network mode is cni mode, the network of
+// container is set up by CNI plugins instead of libnetwork drivers.
+func IsCNI(mode string) bool {
+	parts := strings.SplitN(mode, ":", 2)
+	return parts[0] == "cni"
+}
+
+// cniNetworkName returns the CNI network specified in cni mode, such as
+// "cni:mynet". Empty means the default CNI network.
+func cniNetworkName(mode string) string {
+	parts := strings.SplitN(mode, ":", 2)
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
+
 // IsUserDefined is used to check if network mode is user-created.
 func IsUserDefined(mode string) bool {
-	return !IsBridge(mode) && !IsContainer(mode) && !IsHost(mode) && !IsNone(mode) && !IsNetNS(mode)
+	return !IsBridge(mode) && !IsContainer(mode) && !IsHost(mode) && !IsNone(mode) && !IsNetNS(mode) && !IsCNI(mode)
 }
 
 // IsDefault indicates whether container uses the default network stack.
@@ -95,3 +116,108 @@ func validateNetworkingConfig(network libnetwork.Network, epConfig *types.Endpoi
 
 	return nil
 }
+
+// validateReservation checks the reserved addresses are valid on the network.
+func validateReservation(network libnetwork.Network, r *types.NetworkReservation) error {
+	if r.Name == "" {
+		return fmt.Errorf("container name of reservation cannot be empty")
+	}
+	if !config.ValidNamePattern.MatchString(r.Name) {
+		return fmt.Errorf("invalid container name (%s), only %s are allowed", r.Name, config.ValidNameChars)
+	}
+
+	if r.IPV4Address == "" && r.IPV6Address == "" && r.MacAddress == "" {
+		return fmt.Errorf("reservation should contain at least one of IPv4, IPv6 or MAC address")
+	}
+
+	if r.IPV4Address != "" {
+		if ip := net.ParseIP(r.IPV4Address); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("invalid IPv4 address: %s", r.IPV4Address)
+		}
+	}
+	if r.IPV6Address != "" {
+		if ip := net.ParseIP(r.IPV6Address); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("invalid IPv6 address: %s", r.IPV6Address)
+		}
+	}
+	if r.MacAddress != "" {
+		if _, err := net.ParseMAC(r.MacAddress); err != nil {
+			return fmt.Errorf("invalid MAC address: %s", r.MacAddress)
+		}
+	}
+
+	return validateNetworkingConfig(network, &types.EndpointSettings{
+		IPAMConfig: &types.EndpointIPAMConfig{
+			IPV4Address: r.IPV4Address,
+			IPV6Address: r.IPV6Address,
+		},
+	})
+}
+
+// reservationConflict returns the address which is reserved by both reservations.
+func reservationConflict(a, b *types.NetworkReservation) string {
+	if sameIP(a.IPV4Address, b.IPV4Address) {
+		return a.IPV4Address
+	}
+	if sameIP(a.IPV6Address, b.IPV6Address) {
+		return a.IPV6Address
+	}
+	if a.MacAddress != "" && b.MacAddress != "" && strings.EqualFold(a.MacAddress, b.MacAddress) {
+		return a.MacAddress
+	}
+	return ""
+}
+
+// applyReservation sets the reserved addresses into the endpoint settings,
+// the reserved addresses take precedence over the specified ones.
+func applyReservation(r *networktypes.Reservation, epConfig *types.EndpointSettings) {
+	if epConfig == nil || (r.IPV4Address == "" && r.IPV6Address == "") {
+		return
+	}
+
+	if epConfig.IPAMConfig == nil {
+		epConfig.IPAMConfig = &types.EndpointIPAMConfig{}
+	}
+	ipam := epConfig.IPAMConfig
+
+	if r.IPV4Address != "" {
+		if ipam.IPV4Address != "" && !sameIP(ipam.IPV4Address, r.IPV4Address) {
+			log.With(nil).Warnf("IPv4 address %s is overridden by address %s reserved for container %s", ipam.IPV4Address, r.IPV4Address, r.Name)
+		}
+		ipam.IPV4Address = r.IPV4Address
+	}
+	if r.IPV6Address != "" {
+		if ipam.IPV6Address != "" && !sameIP(ipam.IPV6Address, r.IPV6Address) {
+			log.With(nil).Warnf("IPv6 address %s is overridden by address %s reserved for container %s", ipam.IPV6Address, r.IPV6Address, r.Name)
+		}
+		ipam.IPV6Address = r.IPV6Address
+	}
+}
+
+// endpointByAddress returns the endpoint on network which has been assigned
+// the reserved addresses.
+func endpointByAddress(network libnetwork.Network, r *networktypes.Reservation) libnetwork.Endpoint {
+	for _, ep := range network.Endpoints() {
+		info := ep.Info()
+		if info == nil || info.Iface() == nil {
+			continue
+		}
+		iface := info.Iface()
+		if iface.Address() != nil && sameIP(iface.Address().IP.String(), r.IPV4Address) {
+			return ep
+		}
+		if iface.AddressIPv6() != nil && sameIP(iface.AddressIPv6().IP.String(), r.IPV6Address) {
+			return ep
+		}
+	}
+	return nil
+}
+
+// sameIP checks whether the two non-empty strings represent the same IP address.
+func sameIP(a, b string) bool {
+	if a == "" || b == "" {
+		return false
+	}
+	ipA, ipB := net.ParseIP(a), net.ParseIP(b)
+	return ipA != nil && ipA.Equal(ipB)
+}
@@ -0,0 +1,143 @@
+package mgr
+
+import (
+	"testing"
+
+	apitypes "github.com/alibaba/pouch/apis/types"
+	networktypes "github.com/alibaba/pouch/network/types"
+)
+
+func Test_reservationConflict(t *testing.T) {
+	tests := []struct {
+		name string
+		a    apitypes.NetworkReservation
+		b    apitypes.NetworkReservation
+		want string
+	}{
+		{
+			name: "noConflict",
+			a:    apitypes.NetworkReservation{Name: "a", IPV4Address: "192.168.1.10"},
+			b:    apitypes.NetworkReservation{Name: "b", IPV4Address: "192.168.1.11"},
+			want: "",
+		},
+		{
+			name: "emptyAddressNoConflict",
+			a:    apitypes.NetworkReservation{Name: "a", MacAddress: "02:42:c0:a8:01:0a"},
+			b:    apitypes.NetworkReservation{Name: "b", IPV4Address: "192.168.1.11"},
+			want: "",
+		},
+		{
+			name: "ipv4Conflict",
+			a:    apitypes.NetworkReservation{Name: "a", IPV4Address: "192.168.1.10"},
+			b:    apitypes.NetworkReservation{Name: "b", IPV4Address: "192.168.1.10"},
+			want: "192.168.1.10",
+		},
+		{
+			name: "ipv6Conflict",
+			a:    apitypes.NetworkReservation{Name: "a", IPV6Address: "2002:db8:1::10"},
+			b:    apitypes.NetworkReservation{Name: "b", IPV6Address: "2002:db8:1:0::10"},
+			want: "2002:db8:1::10",
+		},
+		{
+			name: "macConflict",
+			a:    apitypes.NetworkReservation{Name: "a", MacAddress: "02:42:c0:a8:01:0a"},
+			b:    apitypes.NetworkReservation{Name: "b", MacAddress: "02:42:C0:A8:01:0A"},
+			want: "02:42:c0:a8:01:0a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := reservationConflict(&tt.a, &tt.b); got != tt.want {
+				t.Errorf("reservationConflict() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_applyReservation(t *testing.T) {
+	r := &networktypes.Reservation{
+		NetworkReservation: apitypes.NetworkReservation{
+			Name:        "web",
+			IPV4Address: "192.168.1.10",
+		},
+	}
+
+	epConfig := &apitypes.EndpointSettings{}
+	applyReservation(r, epConfig)
+	if epConfig.IPAMConfig == nil || epConfig.IPAMConfig.IPV4Address != "192.168.1.10" {
+		t.Fatalf("expected reserved address 192.168.1.10, got %v", epConfig.IPAMConfig)
+	}
+
+	epConfig = &apitypes.EndpointSettings{
+		IPAMConfig: &apitypes.EndpointIPAMConfig{
+			IPV4Address:  "192.168.1.20",
+			IPV6Address:  "2002:db8:1::20",
+			LinkLocalIps: []string{"169.254.0.1"},
+		},
+	}
+	applyReservation(r, epConfig)
+	if epConfig.IPAMConfig.IPV4Address != "192.168.1.10" {
+		t.Fatalf("expected reserved address to take precedence, got %s", epConfig.IPAMConfig.IPV4Address)
+	}
+	if epConfig.IPAMConfig.IPV6Address != "2002:db8:1::20" || len(epConfig.IPAMConfig.LinkLocalIps) != 1 {
+		t.Fatalf("expected unreserved addresses to be kept, got %v", epConfig.IPAMConfig)
+	}
+
+	// mac-only reservation does not touch the ipam config.
+	epConfig = &apitypes.EndpointSettings{}
+	applyReservation(&networktypes.Reservation{
+		NetworkReservation: apitypes.NetworkReservation{Name: "web", MacAddress: "02:42:c0:a8:01:0a"},
+	}, epConfig)
+	if epConfig.IPAMConfig != nil {
+		t.Fatalf("expected empty ipam config, got %v", epConfig.IPAMConfig)
+	}
+}
+
+func Test_validateReservation(t *testing.T) {
+	tests := []struct {
+		name    string
+		r       apitypes.NetworkReservation
+		wantErr bool
+	}{
+		{name: "emptyName", r: apitypes.NetworkReservation{IPV4Address: "192.168.1.10"}, wantErr: true},
+		{name: "invalidName", r: apitypes.NetworkReservation{Name: "-web", IPV4Address: "192.168.1.10"}, wantErr: true},
+		{name: "noAddress", r: apitypes.NetworkReservation{Name: "web"}, wantErr: true},
+		{name: "invalidIPv4", r: apitypes.NetworkReservation{Name: "web", IPV4Address: "2002:db8:1::10"}, wantErr: true},
+		{name: "invalidIPv6", r: apitypes.NetworkReservation{Name: "web", IPV6Address: "192.168.1.10"}, wantErr: true},
+		{name: "invalidMac", r: apitypes.NetworkReservation{Name: "web", MacAddress: "02:42"}, wantErr: true},
+		{name: "macOnly", r: apitypes.NetworkReservation{Name: "web", MacAddress: "02:42:c0:a8:01:0a"}, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateReservation(nil, &tt.r); (err != nil) != tt.wantErr {
+				t.Errorf("validateReservation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsCNI(t *testing.T) {
+	tests := []struct {
+		mode        string
+		isCNI       bool
+		networkName string
+	}{
+		{mode: "cni", isCNI: true, networkName: ""},
+		{mode: "cni:mynet", isCNI: true, networkName: "mynet"},
+		{mode: "bridge", isCNI: false, networkName: ""},
+		{mode: "cnihost", isCNI: false, networkName: ""},
+		{mode: "container:cni", isCNI: false, networkName: "cni"},
+	}
+
+	for _, tt := range tests {
+		if got := IsCNI(tt.mode); got != tt.isCNI {
+			t.Errorf("IsCNI(%q) = %v, want %v", tt.mode, got, tt.isCNI)
+		}
+		if tt.isCNI && IsUserDefined(tt.mode) {
+			t.Errorf("IsUserDefined(%q) should be false in cni mode", tt.mode)
+		}
+		if got := cniNetworkName(tt.mode); got != tt.networkName {
+			t.Errorf("cniNetworkName(%q) = %q, want %q", tt.mode, got, tt.networkName)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/log"
+	"github.com/containerd/containerd/plugin"
 	"github.com/containerd/containerd/snapshots"
 	"github.com/pkg/errors"
 )
@@ -157,3 +158,33 @@ func (s *SnapshotsSyncer) Sync() error {
 
 	return nil
 }
+
+// checkSnapshotter checks the snapshotter is available in containerd. Only
+// the snapshotter of daemon is allowed if multiple snapshotters are not
+// allowed, since pouchd refuses to start with snapshots in other snapshotters.
+func checkSnapshotter(ctx context.Context, client ctrd.APIClient, snapshotter string, allowMultiSnapshotter bool) error {
+	if snapshotter == ctrd.CurrentSnapshotterName(context.TODO()) {
+		return nil
+	}
+
+	if !allowMultiSnapshotter {
+		return errors.Wrapf(errtypes.ErrInvalidParam, "snapshotter %s is not allowed since multiple snapshotters are not allowed, "+
+			"the snapshotter of daemon is %s", snapshotter, ctrd.CurrentSnapshotterName(context.TODO()))
+	}
+
+	plugins, err := client.Plugins(ctx, []string{fmt.Sprintf("type==%s", plugin.SnapshotPlugin)})
+	if err != nil {
+		return errors.Wrap(err, "failed to get snapshotters of containerd")
+	}
+
+	for _, p := range plugins {
+		if p.ID != snapshotter {
+			continue
+		}
+		if p.Status != ctrd.PluginStatusOk {
+			return errors.Wrapf(errtypes.ErrInvalidParam, "snapshotter %s is not available", snapshotter)
+		}
+		return nil
+	}
+	return errors.Wrapf(errtypes.ErrInvalidParam, "containerd does not support snapshotter %s", snapshotter)
+}
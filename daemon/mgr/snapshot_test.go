@@ -1,9 +1,11 @@
 package mgr
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/alibaba/pouch/ctrd"
 	"github.com/alibaba/pouch/pkg/errtypes"
 	snapshot "github.com/containerd/containerd/snapshots"
 	"github.com/stretchr/testify/assert"
@@ -64,3 +66,15 @@ func Test_SnapshotStore(t *testing.T) {
 	assert.Equal(t, Snapshot{}, sn)
 	assert.Equal(t, errtypes.IsNotfound(err), true)
 }
+
+func Test_checkSnapshotter(t *testing.T) {
+	current := ctrd.CurrentSnapshotterName(context.TODO())
+
+	t.Logf("the snapshotter of daemon should always be allowed")
+	assert.NoError(t, checkSnapshotter(context.TODO(), nil, current, false))
+
+	t.Logf("other snapshotters should not be allowed without multiple snapshotters")
+	err := checkSnapshotter(context.TODO(), nil, current+"-other", false)
+	assert.Error(t, err)
+	assert.Equal(t, errtypes.IsInvalidParam(err), true)
+}
@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/alibaba/pouch/apis/opts"
 	"github.com/alibaba/pouch/apis/types"
 	"github.com/alibaba/pouch/ctrd"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/system"
+	volumetypes "github.com/alibaba/pouch/storage/volume/types"
 
 	"github.com/opencontainers/runc/libcontainer/configs"
 	"github.com/opencontainers/runc/libcontainer/devices"
@@ -89,7 +93,7 @@ func populatePlatform(ctx context.Context, c *Container, specWrapper *SpecWrappe
 	}
 
 	// start to setup linux resource
-	if err := setupResource(ctx, c, s); err != nil {
+	if err := setupResource(ctx, c, specWrapper); err != nil {
 		return err
 	}
 
@@ -97,7 +101,8 @@ func populatePlatform(ctx context.Context, c *Container, specWrapper *SpecWrappe
 }
 
 // setupResource creates linux resource spec.
-func setupResource(ctx context.Context, c *Container, s *specs.Spec) error {
+func setupResource(ctx context.Context, c *Container, specWrapper *SpecWrapper) error {
+	s := specWrapper.s
 	if s.Linux.Resources == nil {
 		s.Linux.Resources = &specs.LinuxResources{}
 	}
@@ -107,7 +112,7 @@ func setupResource(ctx context.Context, c *Container, s *specs.Spec) error {
 	setupMemory(ctx, c.HostConfig.Resources, s)
 
 	// start to setup blkio cgroup
-	if err := setupBlkio(ctx, c.HostConfig.Resources, s); err != nil {
+	if err := setupBlkio(ctx, c, specWrapper.volMgr, s); err != nil {
 		return err
 	}
 
@@ -124,8 +129,10 @@ func setupResource(ctx context.Context, c *Container, s *specs.Spec) error {
 	return nil
 }
 
-// setupResource creates linux blkio resource spec.
-func setupBlkio(ctx context.Context, r types.Resources, s *specs.Spec) error {
+// setupBlkio creates linux blkio resource spec, the io limits of volumes
+// are applied to their backing block devices.
+func setupBlkio(ctx context.Context, c *Container, volMgr VolumeMgr, s *specs.Spec) error {
+	r := c.HostConfig.Resources
 	weightDevice, err := ctrd.GetWeightDevice(r.BlkioWeightDevice)
 	if err != nil {
 		return err
@@ -147,6 +154,12 @@ func setupBlkio(ctx context.Context, r types.Resources, s *specs.Spec) error {
 		return err
 	}
 
+	limits := volumeIOLimits(ctx, c, volMgr)
+	readBpsDevice = mergeThrottleDevices(readBpsDevice, limits.readBps)
+	writeBpsDevice = mergeThrottleDevices(writeBpsDevice, limits.writeBps)
+	readIOpsDevice = mergeThrottleDevices(readIOpsDevice, limits.readIOps)
+	writeIOpsDevice = mergeThrottleDevices(writeIOpsDevice, limits.writeIOps)
+
 	s.Linux.Resources.BlockIO = &specs.LinuxBlockIO{
 		Weight:                  &r.BlkioWeight,
 		WeightDevice:            weightDevice,
@@ -159,6 +172,127 @@ func setupBlkio(ctx context.Context, r types.Resources, s *specs.Spec) error {
 	return nil
 }
 
+// volumeThrottleDevices are the throttle devices of volumes.
+type volumeThrottleDevices struct {
+	readBps   []specs.LinuxThrottleDevice
+	writeBps  []specs.LinuxThrottleDevice
+	readIOps  []specs.LinuxThrottleDevice
+	writeIOps []specs.LinuxThrottleDevice
+}
+
+// blockDevice identifies a block device by major and minor number.
+type blockDevice struct {
+	major int64
+	minor int64
+}
+
+// volumeIOLimits returns the throttle devices of the volumes used by
+// container. The limits of volumes on the same device are aggregated, since
+// they share the bandwidth of device in the container's cgroup.
+func volumeIOLimits(ctx context.Context, c *Container, volMgr VolumeMgr) volumeThrottleDevices {
+	if volMgr == nil {
+		return volumeThrottleDevices{}
+	}
+
+	var (
+		devices []blockDevice
+		configs []*volumetypes.VolumeConfig
+		seen    = make(map[string]bool)
+	)
+	for _, mp := range c.Mounts {
+		if mp.Name == "" || seen[mp.Name] {
+			continue
+		}
+		seen[mp.Name] = true
+
+		v, err := volMgr.Get(ctx, mp.Name)
+		if err != nil || v.Spec == nil || v.Spec.VolumeConfig == nil {
+			continue
+		}
+		cfg := v.Spec.VolumeConfig
+		if cfg.ReadBPS == 0 && cfg.WriteBPS == 0 && cfg.ReadIOPS == 0 && cfg.WriteIOPS == 0 {
+			continue
+		}
+
+		major, minor, err := system.GetBlockDevice(v.Path())
+		if err != nil {
+			log.With(ctx).Warnf("failed to get block device of volume %s, io limits are ignored: %v", mp.Name, err)
+			continue
+		}
+
+		devices = append(devices, blockDevice{major: major, minor: minor})
+		configs = append(configs, cfg)
+	}
+
+	return aggregateIOLimits(devices, configs)
+}
+
+// aggregateIOLimits sums the io limits of volumes on the same device.
+func aggregateIOLimits(devices []blockDevice, configs []*volumetypes.VolumeConfig) volumeThrottleDevices {
+	var (
+		order  []blockDevice
+		totals = make(map[blockDevice]*volumetypes.VolumeConfig)
+	)
+	for i, dev := range devices {
+		total, ok := totals[dev]
+		if !ok {
+			total = &volumetypes.VolumeConfig{}
+			totals[dev] = total
+			order = append(order, dev)
+		}
+		total.ReadBPS += configs[i].ReadBPS
+		total.WriteBPS += configs[i].WriteBPS
+		total.ReadIOPS += configs[i].ReadIOPS
+		total.WriteIOPS += configs[i].WriteIOPS
+	}
+
+	sort.Slice(order, func(i, j int) bool {
+		if order[i].major != order[j].major {
+			return order[i].major < order[j].major
+		}
+		return order[i].minor < order[j].minor
+	})
+
+	var res volumeThrottleDevices
+	add := func(devs *[]specs.LinuxThrottleDevice, dev blockDevice, rate int64) {
+		if rate <= 0 {
+			return
+		}
+		d := specs.LinuxThrottleDevice{Rate: uint64(rate)}
+		d.Major, d.Minor = dev.major, dev.minor
+		*devs = append(*devs, d)
+	}
+	for _, dev := range order {
+		total := totals[dev]
+		add(&res.readBps, dev, total.ReadBPS)
+		add(&res.writeBps, dev, total.WriteBPS)
+		add(&res.readIOps, dev, total.ReadIOPS)
+		add(&res.writeIOps, dev, total.WriteIOPS)
+	}
+	return res
+}
+
+// mergeThrottleDevices merges the throttle devices of volumes into the ones
+// of container, the lower rate takes effect on the same device.
+func mergeThrottleDevices(devs, volumeDevs []specs.LinuxThrottleDevice) []specs.LinuxThrottleDevice {
+	for _, vd := range volumeDevs {
+		found := false
+		for i := range devs {
+			if devs[i].Major == vd.Major && devs[i].Minor == vd.Minor {
+				found = true
+				if vd.Rate < devs[i].Rate {
+					devs[i].Rate = vd.Rate
+				}
+				break
+			}
+		}
+		if !found {
+			devs = append(devs, vd)
+		}
+	}
+	return devs
+}
+
 // setupResource creates linux cpu resource spec
 func setupCPU(ctx context.Context, r types.Resources, s *specs.Spec) {
 	cpu := &specs.LinuxCPU{
@@ -437,7 +571,7 @@ func setupNetworkNamespace(ctx context.Context, c *Container, specWrapper *SpecW
 		ns.Path = fmt.Sprintf("/proc/%d/ns/net", origContainer.State.Pid)
 	} else if IsNetNS(networkMode) {
 		ns.Path = strings.SplitN(networkMode, ":", 2)[1]
-	} else if IsHost(networkMode) {
+	} else if IsHost(networkMode) || IsCNI(networkMode) {
 		ns.Path = c.NetworkSettings.SandboxKey
 	}
 
@@ -0,0 +1,44 @@
+package mgr
+
+import (
+	"testing"
+
+	volumetypes "github.com/alibaba/pouch/storage/volume/types"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func throttleDevice(major, minor int64, rate uint64) specs.LinuxThrottleDevice {
+	d := specs.LinuxThrottleDevice{Rate: rate}
+	d.Major, d.Minor = major, minor
+	return d
+}
+
+func TestAggregateIOLimits(t *testing.T) {
+	devices := []blockDevice{{major: 253, minor: 0}, {major: 8, minor: 0}, {major: 253, minor: 0}}
+	configs := []*volumetypes.VolumeConfig{
+		{WriteBPS: 1024, ReadIOPS: 100},
+		{ReadBPS: 2048},
+		{WriteBPS: 1024, WriteIOPS: 50},
+	}
+
+	limits := aggregateIOLimits(devices, configs)
+	assert.Equal(t, []specs.LinuxThrottleDevice{throttleDevice(8, 0, 2048)}, limits.readBps)
+	assert.Equal(t, []specs.LinuxThrottleDevice{throttleDevice(253, 0, 2048)}, limits.writeBps)
+	assert.Equal(t, []specs.LinuxThrottleDevice{throttleDevice(253, 0, 100)}, limits.readIOps)
+	assert.Equal(t, []specs.LinuxThrottleDevice{throttleDevice(253, 0, 50)}, limits.writeIOps)
+}
+
+func TestMergeThrottleDevices(t *testing.T) {
+	devs := []specs.LinuxThrottleDevice{throttleDevice(8, 0, 1000), throttleDevice(8, 16, 1000)}
+	volumeDevs := []specs.LinuxThrottleDevice{throttleDevice(8, 0, 500), throttleDevice(8, 16, 2000), throttleDevice(253, 0, 300)}
+
+	assert.Equal(t, []specs.LinuxThrottleDevice{
+		throttleDevice(8, 0, 500),
+		throttleDevice(8, 16, 1000),
+		throttleDevice(253, 0, 300),
+	}, mergeThrottleDevices(devs, volumeDevs))
+
+	assert.Nil(t, mergeThrottleDevices(nil, nil))
+}
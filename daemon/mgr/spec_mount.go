@@ -74,6 +74,16 @@ func mergeContainerMount(mounts []specs.Mount, c *Container, s *specs.Spec) ([]s
 			}
 		}
 
+		if isTmpfsMount(mp) {
+			mounts = append(mounts, specs.Mount{
+				Source:      "tmpfs",
+				Destination: mp.Destination,
+				Type:        "tmpfs",
+				Options:     tmpfsMountOptions(c, mp),
+			})
+			continue
+		}
+
 		pg := mp.Propagation
 		rootfspg := s.Linux.RootfsPropagation
 		// Set rootfs propagation, default setting is private.
@@ -118,6 +128,30 @@ func mergeContainerMount(mounts []specs.Mount, c *Container, s *specs.Spec) ([]s
 	return mounts, nil
 }
 
+// tmpfsMountOptions returns the options of tmpfs mount point, the size and
+// mode are read from the mounts of container.
+func tmpfsMountOptions(c *Container, mp *types.MountPoint) []string {
+	opts := []string{"noexec", "nosuid", "nodev"}
+	if !mp.RW {
+		opts = append(opts, "ro")
+	}
+
+	for _, m := range c.HostConfig.Mounts {
+		if m.Type != types.MountTypeTmpfs || filepath.Clean(m.Target) != mp.Destination || m.TmpfsOptions == nil {
+			continue
+		}
+		if m.TmpfsOptions.SizeBytes > 0 {
+			opts = append(opts, fmt.Sprintf("size=%d", m.TmpfsOptions.SizeBytes))
+		}
+		if m.TmpfsOptions.Mode != 0 {
+			opts = append(opts, fmt.Sprintf("mode=%o", m.TmpfsOptions.Mode))
+		}
+		break
+	}
+
+	return opts
+}
+
 // setupMounts create mount spec.
 func setupMounts(ctx context.Context, c *Container, s *specs.Spec) error {
 	var (
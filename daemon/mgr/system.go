@@ -5,6 +5,7 @@ import (
 	"os"
 	"runtime"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -31,6 +32,10 @@ const (
 	unknownHostName      = "<unknown>"
 	unknownKernelVersion = "<unknown>"
 	unknownOSName        = "<unknown>"
+
+	// snapshotterUsageCacheTime is how long the cached usage of snapshotters
+	// is used before it is refreshed.
+	snapshotterUsageCacheTime = time.Minute
 )
 
 //SystemMgr as an interface defines all operations against host.
@@ -48,19 +53,28 @@ type SystemManager struct {
 	registry *registry.Client
 	config   *config.Config
 	imageMgr ImageMgr
+	client   ctrd.APIClient
 
 	store *meta.Store
 
 	eventsService *events.Events
+
+	// snapshotterUsages caches the usage of snapshotters, since computing
+	// it walks every snapshot.
+	snapshotterUsages     map[string]ctrd.SnapshotterUsage
+	snapshotterUsageAt    time.Time
+	snapshotterRefreshing bool
+	snapshotterUsageLock  sync.Mutex
 }
 
 // NewSystemManager creates a brand new system manager.
-func NewSystemManager(cfg *config.Config, store *meta.Store, imageManager ImageMgr, eventsService *events.Events) (*SystemManager, error) {
+func NewSystemManager(cfg *config.Config, store *meta.Store, imageManager ImageMgr, client ctrd.APIClient, eventsService *events.Events) (*SystemManager, error) {
 	return &SystemManager{
 		name:          "system_manager",
-		registry:      &registry.Client{},
+		registry:      registry.NewClient(cfg.RegistryConfigDir, cfg.InsecureRegistries),
 		config:        cfg,
 		imageMgr:      imageManager,
+		client:        client,
 		store:         store,
 		eventsService: eventsService,
 	}, nil
@@ -134,6 +148,11 @@ func (mgr *SystemManager) Info() (types.SystemInfo, error) {
 		securityOpts = append(securityOpts, "selinux")
 	}
 
+	snapshotters, err := mgr.snapshotters(context.Background())
+	if err != nil {
+		log.With(nil).Warnf("failed to get snapshotters: %v", err)
+	}
+
 	info := types.SystemInfo{
 		Architecture: runtime.GOARCH,
 		// CgroupDriver: ,
@@ -175,11 +194,73 @@ func (mgr *SystemManager) Info() (types.SystemInfo, error) {
 		Runtimes:        mgr.config.Runtimes,
 		SecurityOptions: securityOpts,
 		ServerVersion:   version.Version,
+		Snapshotters:    snapshotters,
 		ListenAddresses: mgr.config.Listen,
 	}
 	return info, nil
 }
 
+// snapshotters returns the snapshotters of containerd and their cached usage.
+// The usage is refreshed in background when the cache expires, so it is empty
+// until the first refresh finishes.
+func (mgr *SystemManager) snapshotters(ctx context.Context) ([]*types.SnapshotterInfo, error) {
+	if mgr.client == nil {
+		return nil, nil
+	}
+
+	snapshotters, err := mgr.client.ListSnapshotters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mgr.snapshotterUsageLock.Lock()
+	usages := mgr.snapshotterUsages
+	if !mgr.snapshotterRefreshing && time.Since(mgr.snapshotterUsageAt) > snapshotterUsageCacheTime {
+		mgr.snapshotterRefreshing = true
+		go mgr.refreshSnapshotterUsages(snapshotters)
+	}
+	mgr.snapshotterUsageLock.Unlock()
+
+	current := ctrd.CurrentSnapshotterName(context.TODO())
+	infos := make([]*types.SnapshotterInfo, 0, len(snapshotters))
+	for _, s := range snapshotters {
+		u := usages[s.Name]
+		infos = append(infos, &types.SnapshotterInfo{
+			Name:      s.Name,
+			Status:    s.Status,
+			Default:   s.Name == current,
+			Snapshots: u.Snapshots,
+			Size:      u.Size,
+			Inodes:    u.Inodes,
+		})
+	}
+	return infos, nil
+}
+
+// refreshSnapshotterUsages computes the usage of snapshotters whose status is
+// ok and replaces the cached ones.
+func (mgr *SystemManager) refreshSnapshotterUsages(snapshotters []ctrd.SnapshotterInfo) {
+	usages := make(map[string]ctrd.SnapshotterUsage, len(snapshotters))
+	for _, s := range snapshotters {
+		if s.Status != ctrd.PluginStatusOk {
+			continue
+		}
+
+		u, err := mgr.client.GetSnapshotterUsage(context.Background(), s.Name)
+		if err != nil {
+			log.With(nil).Warnf("failed to get usage of %s snapshotter: %v", s.Name, err)
+			continue
+		}
+		usages[s.Name] = u
+	}
+
+	mgr.snapshotterUsageLock.Lock()
+	mgr.snapshotterUsages = usages
+	mgr.snapshotterUsageAt = time.Now()
+	mgr.snapshotterRefreshing = false
+	mgr.snapshotterUsageLock.Unlock()
+}
+
 // SubscribeToEvents returns to events on the exchange. Events are sent through the returned
 // channel ch. If an error is encountered, it will be sent on channel errs and
 // errs will be closed. To end the subscription, cancel the provided context.
@@ -2,6 +2,7 @@ package mgr
 
 import (
 	"context"
+	"io"
 	"strings"
 
 	"github.com/alibaba/pouch/apis/filters"
@@ -43,6 +44,21 @@ type VolumeMgr interface {
 
 	// Detach is used to unbind a volume from container.
 	Detach(ctx context.Context, name string, options map[string]string) (*types.Volume, error)
+
+	// Update is used to change the size and labels of volume.
+	Update(ctx context.Context, name, size string, labels map[string]string) (*types.Volume, error)
+
+	// Usage returns the used size(bytes) of volume.
+	Usage(ctx context.Context, name string) (int64, error)
+
+	// Archive returns a tar stream of the data in volume.
+	Archive(ctx context.Context, name string) (io.ReadCloser, error)
+
+	// Extract extracts a tar stream into volume.
+	Extract(ctx context.Context, name string, content io.Reader) error
+
+	// Clone copies volume to a new volume with its labels, size and data.
+	Clone(ctx context.Context, name, target string) (*types.Volume, error)
 }
 
 // VolumeManager is the default implement of interface VolumeMgr.
@@ -152,6 +168,37 @@ func (vm *VolumeManager) Remove(ctx context.Context, name string) error {
 	return nil
 }
 
+// Update is used to change the size and labels of volume.
+func (vm *VolumeManager) Update(ctx context.Context, name, size string, labels map[string]string) (*types.Volume, error) {
+	id := types.VolumeContext{
+		Name: name,
+	}
+	v, err := vm.core.UpdateVolume(ctx, id, size, labels)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, errors.Wrap(errtypes.ErrVolumeNotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	attributes := map[string]string{"driver": v.Driver()}
+	if size != "" {
+		attributes["size"] = v.Size()
+	}
+	vm.LogVolumeEvent(ctx, name, "update", attributes)
+
+	return v, nil
+}
+
+// Usage returns the used size(bytes) of volume, a negative size means the
+// usage is not available.
+func (vm *VolumeManager) Usage(ctx context.Context, name string) (int64, error) {
+	id := types.VolumeContext{
+		Name: name,
+	}
+	return vm.core.VolumeUsage(ctx, id)
+}
+
 // Path returns the mount path of volume.
 func (vm *VolumeManager) Path(ctx context.Context, name string) (string, error) {
 	id := types.VolumeContext{
@@ -177,6 +224,9 @@ func (vm *VolumeManager) Attach(ctx context.Context, name string, options map[st
 
 	cid, ok := options[types.OptionRef]
 	if ok && cid != "" {
+		// the volume plugin mounts volume for each container.
+		id.MountID = cid
+
 		ref := v.Option(types.OptionRef)
 		if ref == "" {
 			options[types.OptionRef] = cid
@@ -210,6 +260,7 @@ func (vm *VolumeManager) Detach(ctx context.Context, name string, options map[st
 		if !strings.Contains(ref, cid) {
 			return v, nil
 		}
+		id.MountID = cid
 
 		if ref != "" {
 			ids := strings.Split(ref, ",")
@@ -0,0 +1,165 @@
+package mgr
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/alibaba/pouch/pkg/archive"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/ioutils"
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/pkg/utils"
+	"github.com/alibaba/pouch/storage/volume/types"
+
+	dockerarchive "github.com/docker/docker/pkg/archive"
+	"github.com/docker/docker/pkg/chrootarchive"
+	"github.com/pkg/errors"
+)
+
+// Archive returns a tar stream of the data in volume, it's up to the
+// caller to close the stream.
+func (vm *VolumeManager) Archive(ctx context.Context, name string) (io.ReadCloser, error) {
+	v, mountPath, release, err := vm.attachForCopy(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	data := archive.Tar(mountPath)
+
+	vm.LogVolumeEvent(ctx, name, "archive", map[string]string{"driver": v.Driver()})
+
+	// wait for io finish, then detach the volume
+	return ioutils.NewReadCloserWrapper(data, func() error {
+		err := data.Close()
+		release()
+		return err
+	}), nil
+}
+
+// Extract extracts the tar stream into volume, the existing files with
+// same name in volume are overwritten.
+func (vm *VolumeManager) Extract(ctx context.Context, name string, content io.Reader) error {
+	v, mountPath, release, err := vm.attachForCopy(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	// the archive is extracted in the chroot of volume, so the symlinks in
+	// volume, which may be replaced by the running containers, can't make
+	// it escape from the volume.
+	if err := chrootarchive.Untar(content, mountPath, &dockerarchive.TarOptions{}); err != nil {
+		return errors.Wrapf(err, "failed to extract archive into volume(%s)", name)
+	}
+
+	vm.LogVolumeEvent(ctx, name, "extract", map[string]string{"driver": v.Driver()})
+
+	return nil
+}
+
+// Clone creates the target volume with the driver, options, labels and size
+// of volume, then copies the data into it with the ownership kept.
+func (vm *VolumeManager) Clone(ctx context.Context, name, target string) (_ *types.Volume, err0 error) {
+	if _, err := vm.Get(ctx, target); err == nil {
+		return nil, errors.Wrapf(errtypes.ErrAlreadyExisted, "volume %s", target)
+	} else if !errtypes.IsVolumeNotFound(err) {
+		return nil, err
+	}
+
+	src, srcPath, release, err := vm.attachForCopy(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// only the config of volume is copied, the state of it is not.
+	options := map[string]string{}
+	for k, v := range src.Options() {
+		if utils.StringInSlice(types.StateOptions, k) {
+			continue
+		}
+		options[k] = v
+	}
+	if size := src.Size(); size != "" && size != "0" {
+		options["size"] = size
+	}
+	labels := map[string]string{}
+	for k, v := range src.Labels {
+		labels[k] = v
+	}
+
+	dst, err := vm.Create(ctx, target, src.Driver(), options, labels)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create volume(%s)", target)
+	}
+	defer func() {
+		if err0 != nil {
+			if err := vm.Remove(ctx, target); err != nil {
+				log.With(ctx).Errorf("failed to remove volume(%s) after clone failed: %v", target, err)
+			}
+		}
+	}()
+
+	_, dstPath, releaseDst, err := vm.attachForCopy(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseDst()
+
+	// the volumes of some drivers, such as mount driver, may share the
+	// same data, copying into itself would corrupt it.
+	srcInfo, err := os.Stat(srcPath)
+	if err != nil {
+		return nil, err
+	}
+	dstInfo, err := os.Stat(dstPath)
+	if err != nil {
+		return nil, err
+	}
+	if os.SameFile(srcInfo, dstInfo) {
+		return nil, errors.Wrapf(errtypes.ErrInvalidParam, "volume(%s) shares the data with volume(%s), cannot clone", target, name)
+	}
+
+	if err := archive.CopyWithTar(srcPath, dstPath); err != nil {
+		return nil, errors.Wrapf(err, "failed to copy data from volume(%s) to volume(%s)", name, target)
+	}
+
+	vm.LogVolumeEvent(ctx, target, "clone", map[string]string{"driver": dst.Driver(), "source": name})
+
+	return dst, nil
+}
+
+// attachForCopy returns volume and its mount path. The volume which is not
+// referenced by any container is attached without reference, the returned
+// release function detaches it if it is still not referenced.
+func (vm *VolumeManager) attachForCopy(ctx context.Context, name string) (*types.Volume, string, func(), error) {
+	v, err := vm.Get(ctx, name)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	id := types.VolumeContext{
+		Name: name,
+	}
+
+	release := func() {}
+	if len(v.References()) == 0 {
+		if v, err = vm.core.AttachVolume(ctx, id, nil); err != nil {
+			return nil, "", nil, errors.Wrapf(err, "failed to attach volume(%s)", name)
+		}
+		release = func() {
+			if _, err := vm.core.DetachVolume(ctx, id, nil); err != nil {
+				log.With(ctx).Errorf("failed to detach volume(%s): %v", name, err)
+			}
+		}
+	}
+
+	mountPath, err := vm.core.VolumePath(ctx, id)
+	if err != nil {
+		release()
+		return nil, "", nil, err
+	}
+
+	return v, mountPath, release, nil
+}
@@ -33,7 +33,7 @@ func GenContainerMgr(ctx context.Context, d DaemonProvider) (mgr.ContainerMgr, e
 
 // GenSystemMgr generates a SystemMgr instance according to config cfg.
 func GenSystemMgr(cfg *config.Config, d DaemonProvider) (mgr.SystemMgr, error) {
-	return mgr.NewSystemManager(cfg, d.MetaStore(), d.ImgMgr(), d.EventsService())
+	return mgr.NewSystemManager(cfg, d.MetaStore(), d.ImgMgr(), d.Containerd(), d.EventsService())
 }
 
 // GenImageMgr generates a ImageMgr instance according to config cfg.
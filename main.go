@@ -20,6 +20,7 @@ import (
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/system"
 	"github.com/alibaba/pouch/pkg/utils"
+	"github.com/alibaba/pouch/registry/hosts"
 	"github.com/alibaba/pouch/storage/quota"
 	"github.com/alibaba/pouch/version"
 
@@ -144,6 +145,14 @@ func setupFlags(cmd *cobra.Command) {
 	// registry
 	flagSet.StringArrayVar(&cfg.InsecureRegistries, "insecure-registries", []string{}, "enable insecure registry")
 	flagSet.StringArrayVar(&cfg.RegistryMirrors, "registry-mirrors", []string{}, "preferred mirror registry list")
+	flagSet.StringVar(&cfg.RegistryConfigDir, "registry-config-dir", hosts.DefaultConfigDir, "Directory of registry hosts config, which is reloaded on each pull and push")
+	flagSet.IntVar(&cfg.MaxConcurrentDownloads, "max-concurrent-downloads", 0, "Set the max concurrent downloads of all pulls, 0 means no limit")
+	flagSet.IntVar(&cfg.MaxConcurrentDownloadsPerPull, "max-concurrent-downloads-per-pull", 0, "Set the max concurrent downloads of each pull, 0 means no limit")
+	flagSet.IntVar(&cfg.MaxDownloadAttempts, "max-download-attempts", 5, "Set the max download attempts of each layer")
+	flagSet.StringVar(&cfg.ImagePolicy, "image-policy", "", "Policy file of images allowed to pull and run")
+	flagSet.IntVar(&cfg.ImageGCHighThreshold, "image-gc-high-threshold", 0, "Percent of disk usage which triggers image gc, 0 means image gc is disabled")
+	flagSet.IntVar(&cfg.ImageGCLowThreshold, "image-gc-low-threshold", 80, "Percent of disk usage which image gc frees to")
+	flagSet.IntVar(&cfg.ImageGCPeriod, "image-gc-period", 300, "Period in seconds to check disk usage for image gc")
 
 	// buildkit
 	flagSet.BoolVar(&cfg.EnableBuilder, "enable-builder", false, "Enable buildkit functionality")
@@ -225,6 +234,9 @@ func runDaemon(cmd *cobra.Command) error {
 	if cfg.QuotaDriver != "" {
 		quota.SetQuotaDriver(cfg.QuotaDriver)
 	}
+	if err := quota.SetAllocatedQuotaIDsFile(path.Join(cfg.HomeDir, "quota", "allocated.json")); err != nil {
+		return err
+	}
 
 	if err := checkLxcfsCfg(); err != nil {
 		return err
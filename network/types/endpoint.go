@@ -8,9 +8,10 @@ import (
 
 // Endpoint defines the network endpoint struct.
 type Endpoint struct {
-	Name  string
-	ID    string
-	Owner string
+	Name      string
+	ID        string
+	Owner     string
+	OwnerName string
 
 	Hostname       strfmt.Hostname
 	Domainname     string
@@ -0,0 +1,19 @@
+package types
+
+import (
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// Policy defines the default ingress firewall policy of containers on a
+// network.
+type Policy struct {
+	types.NetworkPolicy
+
+	// NetworkID is the id of network which the policy belongs to.
+	NetworkID string
+}
+
+// Key returns the key of policy in meta store.
+func (p *Policy) Key() string {
+	return p.NetworkID
+}
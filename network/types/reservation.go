@@ -0,0 +1,19 @@
+package types
+
+import (
+	"github.com/alibaba/pouch/apis/types"
+)
+
+// Reservation defines the static addresses reserved on a network for the
+// container with the given name.
+type Reservation struct {
+	types.NetworkReservation
+
+	// NetworkID is the id of network which the reservation belongs to.
+	NetworkID string
+}
+
+// Key returns the key of reservation in meta store.
+func (r *Reservation) Key() string {
+	return r.NetworkID + "_" + r.Name
+}
@@ -2,12 +2,17 @@ package archive
 
 import (
 	"archive/tar"
-	"bytes"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"syscall"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/log"
+
+	"github.com/containerd/continuity/fs"
 )
 
 func tarFromDir(src string, writer io.Writer) error {
@@ -25,14 +30,34 @@ func tarFromDir(src string, writer io.Writer) error {
 			return err
 		}
 
-		// create a new dir/file header
-		header, err := tar.FileInfoHeader(fi, fi.Name())
+		// the socket can't be represented in tar, skip it.
+		if fi.Mode()&os.ModeSocket != 0 {
+			log.With(nil).Warnf("archive: skipping %q since it is a socket", file)
+			return nil
+		}
+
+		var link string
+		if fi.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(file); err != nil {
+				return err
+			}
+		}
+
+		// create a new dir/file header, the owner is filled from file info.
+		header, err := tar.FileInfoHeader(fi, link)
 		if err != nil {
-			return err
+			log.With(nil).Warnf("archive: skipping %q since it can't be archived: %v", file, err)
+			return nil
 		}
 
 		// update the name to correctly reflect the desired destination when untaring
 		header.Name = strings.TrimPrefix(strings.Replace(file, src, "", 1), string(filepath.Separator))
+		if fi.IsDir() {
+			header.Name += "/"
+			if header.Name == "/" {
+				header.Name = "./"
+			}
+		}
 		// write the header
 		if err := tw.WriteHeader(header); err != nil {
 			return err
@@ -57,12 +82,26 @@ func tarFromDir(src string, writer io.Writer) error {
 
 func untarToDir(dst string, r io.Reader) error {
 	tr := tar.NewReader(r)
+	dst = filepath.Clean(dst)
+
+	// the modification time of directories is restored at last, since
+	// creating files in them changes it.
+	type dirTime struct {
+		path    string
+		modTime time.Time
+	}
+	var dirs []dirTime
 
 	for {
 		header, err := tr.Next()
 
 		switch {
 		case err == io.EOF:
+			for _, d := range dirs {
+				if err := os.Chtimes(d.path, d.modTime, d.modTime); err != nil {
+					return err
+				}
+			}
 			return nil
 		case err != nil:
 			return err
@@ -71,39 +110,143 @@ func untarToDir(dst string, r io.Reader) error {
 		}
 
 		// the target location where the dir/file should be created
-		target := filepath.Join(dst, header.Name)
+		target, err := resolveTarget(dst, header.Name)
+		if err != nil {
+			return err
+		}
 
 		// check the file type
 		switch header.Typeflag {
 		case tar.TypeDir:
-			if _, err := os.Stat(target); err != nil {
+			// an existing entry which is not a directory, such as a
+			// symlink, is replaced instead of being followed.
+			if fi, err := os.Lstat(target); err != nil || !fi.IsDir() {
+				if err := os.RemoveAll(target); err != nil {
+					return err
+				}
 				if err := os.MkdirAll(target, 0755); err != nil {
 					return err
 				}
 			}
-		case tar.TypeReg:
-			f, err := os.OpenFile(target, os.O_CREATE|os.O_RDWR, os.FileMode(header.Mode))
+			if err := os.Chmod(target, os.FileMode(header.Mode).Perm()); err != nil {
+				return err
+			}
+			dirs = append(dirs, dirTime{path: target, modTime: header.ModTime})
+		case tar.TypeReg, tar.TypeRegA:
+			if err := writeFile(target, os.FileMode(header.Mode).Perm(), tr); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.RemoveAll(target); err != nil {
+				return err
+			}
+			if err := os.Symlink(header.Linkname, target); err != nil {
+				return err
+			}
+		case tar.TypeLink:
+			source, err := resolveTarget(dst, header.Linkname)
 			if err != nil {
 				return err
 			}
-			defer f.Close()
+			if err := os.RemoveAll(target); err != nil {
+				return err
+			}
+			if err := os.Link(source, target); err != nil {
+				return err
+			}
+		default:
+			continue
+		}
 
-			if _, err := io.Copy(f, tr); err != nil {
+		if err := chownHeader(target, header); err != nil {
+			return err
+		}
+		if header.Typeflag == tar.TypeReg || header.Typeflag == tar.TypeRegA {
+			if err := os.Chtimes(target, time.Now(), header.ModTime); err != nil {
 				return err
 			}
 		}
 	}
 }
 
-// CopyWithTar create a tar from src directory,
-// and untar it in dst directory.
-func CopyWithTar(src, dst string) error {
-	buf := new(bytes.Buffer)
+// resolveTarget returns the location of entry name in dst. The symlinks in
+// the parent of entry are resolved in the scope of dst, so an entry written
+// through a symlink created by previous entries never escapes from dst. The
+// entry itself is not resolved, it is replaced instead of being followed.
+func resolveTarget(dst, name string) (string, error) {
+	target := filepath.Join(dst, name)
+	if target != dst && !strings.HasPrefix(target, dst+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid tar entry %q: outside of %s", name, dst)
+	}
+	if target == dst {
+		return dst, nil
+	}
+
+	parent, err := fs.RootPath(dst, filepath.Dir(strings.TrimPrefix(target, dst)))
+	if err != nil {
+		return "", err
+	}
+	if parent != dst && !strings.HasPrefix(parent, dst+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid tar entry %q: resolved to %s outside of %s", name, parent, dst)
+	}
+	return filepath.Join(parent, filepath.Base(target)), nil
+}
+
+// writeFile creates or truncates the file, then copies the content from r.
+// The existing entry is removed first if it is not a regular file, and the
+// file is opened without following symlinks.
+func writeFile(target string, mode os.FileMode, r io.Reader) error {
+	if fi, err := os.Lstat(target); err == nil && !fi.Mode().IsRegular() {
+		if err := os.RemoveAll(target); err != nil {
+			return err
+		}
+	}
 
-	if err := tarFromDir(src, buf); err != nil {
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY|syscall.O_NOFOLLOW, mode)
+	if err != nil {
 		return err
 	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, r); err != nil {
+		return err
+	}
+	return f.Chmod(mode)
+}
+
+// chownHeader restores the owner of the entry, it only works when the
+// process is running as root.
+func chownHeader(target string, header *tar.Header) error {
+	if os.Geteuid() != 0 {
+		return nil
+	}
+	return os.Lchown(target, header.Uid, header.Gid)
+}
+
+// Tar returns a tar stream of the contents in src directory, the owner,
+// permission and modification time of files are kept. It's up to the
+// caller to close the stream.
+func Tar(src string) io.ReadCloser {
+	pr, pw := io.Pipe()
 
-	return untarToDir(dst, buf)
+	go func() {
+		pw.CloseWithError(tarFromDir(src, pw))
+	}()
+
+	return pr
+}
+
+// Untar extracts the tar stream into dst directory, the existing files
+// with same name are overwritten.
+func Untar(r io.Reader, dst string) error {
+	return untarToDir(dst, r)
+}
+
+// CopyWithTar create a tar from src directory,
+// and untar it in dst directory.
+func CopyWithTar(src, dst string) error {
+	content := Tar(src)
+	defer content.Close()
 
+	return untarToDir(dst, content)
 }
@@ -1,7 +1,10 @@
 package archive
 
 import (
+	"archive/tar"
+	"bytes"
 	"io/ioutil"
+	"net"
 	"os"
 	"path"
 	"path/filepath"
@@ -42,6 +45,97 @@ func TestCopyWithTar(t *testing.T) {
 	}
 }
 
+func TestCopyWithTarSkipSocket(t *testing.T) {
+	source, err := ioutil.TempDir("", "source")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(source)
+
+	destination, err := ioutil.TempDir("", "destination")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(destination)
+
+	files := []string{"file1", "dir1/file2"}
+	if err := makeFiles(source, files); err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := net.Listen("unix", filepath.Join(source, "dir1", "app.sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	if err := CopyWithTar(source, destination); err != nil {
+		t.Fatal(err)
+	}
+
+	actualFiles := targetFiles(destination)
+	if !utils.StringSliceEqual(files, actualFiles) {
+		t.Fatalf("TestCopyWithTarSkipSocket expected get %v, but got %v", files, actualFiles)
+	}
+}
+
+func TestTarUntar(t *testing.T) {
+	source, err := ioutil.TempDir("", "source")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(source)
+
+	destination, err := ioutil.TempDir("", "destination")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(destination)
+
+	if err := makeFiles(source, []string{"dir1/file1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(path.Join(source, "dir1/file1"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(path.Join(source, "dir1"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("dir1/file1", path.Join(source, "link1")); err != nil {
+		t.Fatal(err)
+	}
+
+	content := Tar(source)
+	defer content.Close()
+	if err := Untar(content, destination); err != nil {
+		t.Fatal(err)
+	}
+
+	fi, err := os.Stat(path.Join(destination, "dir1/file1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode().Perm() != 0600 {
+		t.Fatalf("expected file mode 0600, but got %v", fi.Mode().Perm())
+	}
+
+	fi, err = os.Stat(path.Join(destination, "dir1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode().Perm() != 0700 {
+		t.Fatalf("expected dir mode 0700, but got %v", fi.Mode().Perm())
+	}
+
+	link, err := os.Readlink(path.Join(destination, "link1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if link != "dir1/file1" {
+		t.Fatalf("expected link to dir1/file1, but got %s", link)
+	}
+}
+
 func makeFiles(baseDir string, files []string) error {
 	for _, file := range files {
 		fullPath := path.Join(baseDir, file)
@@ -77,3 +171,75 @@ func targetFiles(baseDir string) []string {
 
 	return files
 }
+
+func TestUntarSymlinkEscape(t *testing.T) {
+	destination, err := ioutil.TempDir("", "destination")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(destination)
+
+	outside, err := ioutil.TempDir("", "outside")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(outside)
+
+	for _, entries := range [][]*tar.Header{
+		// write a file through a symlink to the outside directory.
+		{
+			{Name: "a", Typeflag: tar.TypeSymlink, Linkname: outside},
+			{Name: "a/passwd", Typeflag: tar.TypeReg, Mode: 0644},
+		},
+		// overwrite a file through a symlink pointing to it.
+		{
+			{Name: "b", Typeflag: tar.TypeSymlink, Linkname: filepath.Join(outside, "passwd")},
+			{Name: "b", Typeflag: tar.TypeReg, Mode: 0644},
+		},
+		// hardlink a file outside of destination.
+		{
+			{Name: "c", Typeflag: tar.TypeSymlink, Linkname: outside},
+			{Name: "d", Typeflag: tar.TypeLink, Linkname: "c/passwd"},
+		},
+	} {
+		if err := ioutil.WriteFile(filepath.Join(outside, "passwd"), []byte("root"), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		buf := &bytes.Buffer{}
+		tw := tar.NewWriter(buf)
+		for _, hdr := range entries {
+			if hdr.Typeflag == tar.TypeReg {
+				hdr.Size = int64(len("hacked"))
+			}
+			if err := tw.WriteHeader(hdr); err != nil {
+				t.Fatal(err)
+			}
+			if hdr.Typeflag == tar.TypeReg {
+				tw.Write([]byte("hacked"))
+			}
+		}
+		tw.Close()
+
+		Untar(buf, destination)
+
+		data, err := ioutil.ReadFile(filepath.Join(outside, "passwd"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "root" {
+			t.Fatalf("expected file outside of destination untouched, but got %q", data)
+		}
+		if fi, err := os.Lstat(filepath.Join(destination, "d")); err == nil && os.SameFile(fi, mustStat(t, filepath.Join(outside, "passwd"))) {
+			t.Fatalf("expected no hardlink to file outside of destination")
+		}
+	}
+}
+
+func mustStat(t *testing.T, name string) os.FileInfo {
+	fi, err := os.Stat(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fi
+}
@@ -40,6 +40,9 @@ var (
 
 	// ErrInvalidAuthorization represents that authorization failed.
 	ErrInvalidAuthorization = errorType{codeInvalidAuthorization, "authorization failed"}
+
+	// ErrPolicyDenied represents that the operation is denied by policy.
+	ErrPolicyDenied = errorType{codePolicyDenied, "denied by policy"}
 )
 
 const (
@@ -55,6 +58,7 @@ const (
 	codeNotModified
 	codePreCheckFailed
 	codeInvalidAuthorization
+	codePolicyDenied
 
 	// volume error code
 	codeVolumeExisted
@@ -101,6 +105,11 @@ func IsNotModified(err error) bool {
 	return checkError(err, codeNotModified)
 }
 
+// IsNotImplemented checks the error is not implemented error or not.
+func IsNotImplemented(err error) bool {
+	return checkError(err, codeNotImplemented)
+}
+
 // IsPreCheckFailed checks the error is failed to pre check or not.
 func IsPreCheckFailed(err error) bool {
 	return checkError(err, codePreCheckFailed)
@@ -111,6 +120,11 @@ func IsInvalidAuthorization(err error) bool {
 	return checkError(err, codeInvalidAuthorization)
 }
 
+// IsPolicyDenied checks the error is denied by policy or not.
+func IsPolicyDenied(err error) bool {
+	return checkError(err, codePolicyDenied)
+}
+
 func checkError(err error, code int) bool {
 	err = causeError(err)
 
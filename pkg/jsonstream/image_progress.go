@@ -19,9 +19,24 @@ const (
 	PullStatusExists = "exists"
 	// PullStatusDone represents done status.
 	PullStatusDone = "done"
+	// PullStatusRetrying represents retrying status after download failed.
+	PullStatusRetrying = "retrying"
+	// PullStatusResuming represents resuming status from the downloaded offset.
+	PullStatusResuming = "resuming"
 
 	// PushStatusUploading represents uploading status.
 	PushStatusUploading = "uploading"
+	// PushStatusWaiting represents waiting status before upload.
+	PushStatusWaiting = "waiting"
+	// PushStatusCommitting represents committing status after upload.
+	PushStatusCommitting = "committing"
+	// PushStatusExists represents the content exists in registry.
+	PushStatusExists = "exists"
+	// PushStatusMounted represents the blob is mounted from another
+	// repository of registry.
+	PushStatusMounted = "mounted"
+	// PushStatusDone represents done status.
+	PushStatusDone = "done"
 )
 
 // ProcessStatus returns the status of download or upload image
@@ -36,7 +51,7 @@ func ProcessStatus(short bool, msg JSONMessage) string {
 	switch msg.Status {
 	case PullStatusResolving, PullStatusWaiting:
 		return fmt.Sprintf("%s:\t%s\t%40r\t\n", msg.ID, msg.Status, progress.Bar(0.0))
-	case PullStatusDownloading, PushStatusUploading:
+	case PullStatusDownloading, PullStatusRetrying, PullStatusResuming, PushStatusUploading:
 		bar := progress.Bar(0)
 		current, total := progress.Bytes(msg.Detail.Current), progress.Bytes(msg.Detail.Total)
 
@@ -10,22 +10,27 @@ import (
 
 // PushJobs defines a job in upload progress
 type PushJobs struct {
-	jobs    map[string]struct{}
+	// name is the reference of image to be pushed.
+	name    string
+	jobs    map[string]int64
 	ordered []string
+	mounted map[string]string
 	tracker docker.StatusTracker
 	mu      sync.Mutex
 }
 
 // NewPushJobs news a PushJobs
-func NewPushJobs(tracker docker.StatusTracker) *PushJobs {
+func NewPushJobs(name string, tracker docker.StatusTracker) *PushJobs {
 	return &PushJobs{
-		jobs:    make(map[string]struct{}),
+		name:    name,
+		jobs:    make(map[string]int64),
+		mounted: make(map[string]string),
 		tracker: tracker,
 	}
 }
 
-// Add adds a ref in upload job
-func (j *PushJobs) Add(ref string) {
+// Add adds a ref with the size of content in upload job
+func (j *PushJobs) Add(ref string, size int64) {
 	j.mu.Lock()
 	defer j.mu.Unlock()
 
@@ -33,7 +38,15 @@ func (j *PushJobs) Add(ref string) {
 		return
 	}
 	j.ordered = append(j.ordered, ref)
-	j.jobs[ref] = struct{}{}
+	j.jobs[ref] = size
+}
+
+// Mounted marks the ref is mounted from the repository instead of uploaded.
+func (j *PushJobs) Mounted(ref, from string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.mounted[ref] = from
 }
 
 // Status gets PushJobs statuses
@@ -41,7 +54,13 @@ func (j *PushJobs) Status() []JSONMessage {
 	j.mu.Lock()
 	defer j.mu.Unlock()
 
-	statuses := make([]JSONMessage, 0, len(j.jobs))
+	statuses := make([]JSONMessage, 0, len(j.jobs)+1)
+	statuses = append(statuses, JSONMessage{
+		ID:     j.name,
+		Status: PullStatusResolved,
+		Detail: &ProgressDetail{},
+	})
+
 	for _, name := range j.ordered {
 		si := JSONMessage{
 			ID: name,
@@ -49,7 +68,10 @@ func (j *PushJobs) Status() []JSONMessage {
 
 		status, err := j.tracker.GetStatus(name)
 		if err != nil {
-			si.Status = "waiting"
+			si.Status = PushStatusWaiting
+			si.Detail = &ProgressDetail{
+				Total: j.jobs[name],
+			}
 		} else {
 			si.Detail = &ProgressDetail{
 				Current: status.Offset,
@@ -57,14 +79,20 @@ func (j *PushJobs) Status() []JSONMessage {
 			}
 			si.StartedAt = status.StartedAt
 			si.UpdatedAt = status.UpdatedAt
-			if status.Offset >= status.Total {
-				if status.UploadUUID == "" {
-					si.Status = "done"
-				} else {
-					si.Status = "committing"
-				}
-			} else {
-				si.Status = "uploading"
+
+			switch {
+			case j.mounted[name] != "":
+				si.Status = PushStatusMounted
+			case status.StartedAt.IsZero() && status.Total == 0:
+				// the status is set without upload if the content
+				// exists in registry.
+				si.Status = PushStatusExists
+			case status.Offset >= status.Total && status.UploadUUID == "":
+				si.Status = PushStatusDone
+			case status.Offset >= status.Total:
+				si.Status = PushStatusCommitting
+			default:
+				si.Status = PushStatusUploading
 			}
 		}
 		statuses = append(statuses, si)
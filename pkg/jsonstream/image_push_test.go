@@ -0,0 +1,38 @@
+package jsonstream
+
+import (
+	"testing"
+	"time"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPushJobsStatus(t *testing.T) {
+	tracker := docker.NewInMemoryTracker()
+	jobs := NewPushJobs("reg.example.com/app:v1", tracker)
+
+	for _, ref := range []string{"waiting", "uploading", "done", "exists", "mounted"} {
+		jobs.Add(ref, 10)
+	}
+	// the duplicate ref is ignored.
+	jobs.Add("done", 10)
+
+	now := time.Now()
+	tracker.SetStatus("uploading", docker.Status{Status: content.Status{Ref: "uploading", Offset: 4, Total: 10, StartedAt: now}})
+	tracker.SetStatus("done", docker.Status{Status: content.Status{Ref: "done", Offset: 10, Total: 10, StartedAt: now}})
+	tracker.SetStatus("exists", docker.Status{Status: content.Status{Ref: "exists"}})
+	tracker.SetStatus("mounted", docker.Status{Status: content.Status{Ref: "mounted"}})
+	jobs.Mounted("mounted", "library/base")
+
+	statuses := jobs.Status()
+	assert.Len(t, statuses, 6)
+	assert.Equal(t, JSONMessage{ID: "reg.example.com/app:v1", Status: PullStatusResolved, Detail: &ProgressDetail{}}, statuses[0])
+	assert.Equal(t, JSONMessage{ID: "waiting", Status: PushStatusWaiting, Detail: &ProgressDetail{Total: 10}}, statuses[1])
+
+	for i, expected := range []string{PushStatusUploading, PushStatusDone, PushStatusExists, PushStatusMounted} {
+		assert.Equal(t, expected, statuses[i+2].Status)
+	}
+	assert.Equal(t, &ProgressDetail{Current: 4, Total: 10}, statuses[2].Detail)
+}
@@ -84,6 +84,42 @@ func GetDevID(dir string) (uint64, error) {
 	return st.Dev, nil
 }
 
+// sysBlockDir is the sysfs directory of block devices, keyed by major:minor.
+var sysBlockDir = "/sys/dev/block"
+
+// ErrNotBlockDevice is returned when the path is not backed by block device,
+// such as tmpfs or nfs.
+var ErrNotBlockDevice = errors.New("not backed by block device")
+
+// GetBlockDevice returns the major and minor number of the disk backing the
+// directory. Partition is resolved to its parent disk, since blkio throttle
+// does not work on partitions.
+func GetBlockDevice(dir string) (int64, int64, error) {
+	dev, err := GetDevID(dir)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	major, minor := int64(unix.Major(dev)), int64(unix.Minor(dev))
+	if major == 0 {
+		return 0, 0, errors.Wrapf(ErrNotBlockDevice, "directory (%s)", dir)
+	}
+
+	devDir := fmt.Sprintf("%s/%d:%d", sysBlockDir, major, minor)
+	if _, err := os.Stat(devDir + "/partition"); err != nil {
+		return major, minor, nil
+	}
+
+	data, err := ioutil.ReadFile(devDir + "/../dev")
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to get disk of partition %d:%d", major, minor)
+	}
+	if _, err := fmt.Sscanf(strings.TrimSpace(string(data)), "%d:%d", &major, &minor); err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to parse disk of partition %d:%d", major, minor)
+	}
+	return major, minor, nil
+}
+
 // GetSerialNumber gets serial number or a machine.
 func GetSerialNumber() string {
 	var sn string
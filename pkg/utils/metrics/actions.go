@@ -2,22 +2,23 @@ package metrics
 
 // Action labels for different pod/container/image operations.
 const (
-	ActionCreateLabel    = "create"
-	ActionDeleteLabel    = "delete"
-	ActionRemoveLabel    = "remove"
-	ActionUpdateLabel    = "update"
-	ActionUpgradeLabel   = "upgrade"
-	ActionInfoLabel      = "info"
-	ActionListLabel      = "list"
-	ActionStatusLabel    = "status"
-	ActionStartLabel     = "start"
-	ActionStopLabel      = "stop"
-	ActionRenameLabel    = "rename"
-	ActionRestartLabel   = "restart"
-	ActionRunLabel       = "run"
-	ActionPullLabel      = "pull"
-	ActionStatsLabel     = "stats"
-	ActionStatsListLabel = "stats_list"
-	ActionPauseLabel     = "pause"
-	ActionUnpauseLabel   = "unpause"
+	ActionCreateLabel          = "create"
+	ActionDeleteLabel          = "delete"
+	ActionRemoveLabel          = "remove"
+	ActionUpdateLabel          = "update"
+	ActionUpgradeLabel         = "upgrade"
+	ActionInfoLabel            = "info"
+	ActionListLabel            = "list"
+	ActionStatusLabel          = "status"
+	ActionStartLabel           = "start"
+	ActionStopLabel            = "stop"
+	ActionRenameLabel          = "rename"
+	ActionRestartLabel         = "restart"
+	ActionRunLabel             = "run"
+	ActionPullLabel            = "pull"
+	ActionStatsLabel           = "stats"
+	ActionStatsListLabel       = "stats_list"
+	ActionPauseLabel           = "pause"
+	ActionUnpauseLabel         = "unpause"
+	ActionMigrateSnapshotLabel = "migrate_snapshot"
 )
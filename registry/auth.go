@@ -27,7 +27,8 @@ type registryEndpoint struct {
 
 // token defines a token that registry may return after login successfully.
 type token struct {
-	Token string `json:"token"`
+	Token       string `json:"token"`
+	AccessToken string `json:"access_token"`
 }
 
 // Auth authenticates the v1/v2 registry with the credentials.
@@ -0,0 +1,46 @@
+package registry
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/alibaba/pouch/apis/types"
+
+	"github.com/opencontainers/go-digest"
+)
+
+// MountBlob mounts the blob from the repository from into repo on the same
+// registry, so that it needn't be uploaded. It returns false if the registry
+// doesn't mount it, such as the blob doesn't exist in from.
+func (client *Client) MountBlob(ctx context.Context, registry, repo, from string, dgst digest.Digest, auth *types.AuthConfig) (bool, error) {
+	ep, err := client.newV2Endpoint(registry, auth)
+	if err != nil {
+		return false, err
+	}
+
+	q := url.Values{}
+	q.Set("mount", dgst.String())
+	q.Set("from", from)
+
+	scope := "repository:" + repo + ":pull,push " + pullScope(from)
+	resp, err := ep.do(ctx, http.MethodPost, repo+"/blobs/uploads/?"+q.Encode(), scope, nil,
+		http.StatusCreated, http.StatusAccepted)
+	if err != nil {
+		return false, err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode == http.StatusCreated {
+		return true, nil
+	}
+
+	// the registry starts an upload session instead, cancel it since the
+	// blob will be pushed in another session.
+	if location := resp.Header.Get("Location"); location != "" {
+		if resp, err := ep.do(ctx, http.MethodDelete, location, scope, nil, http.StatusNoContent, http.StatusNotFound); err == nil {
+			resp.Body.Close()
+		}
+	}
+	return false, nil
+}
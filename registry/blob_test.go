@@ -0,0 +1,51 @@
+package registry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMountBlob(t *testing.T) {
+	var (
+		exist     = digest.FromString("exist")
+		cancelled = false
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v2/app/blobs/uploads/":
+			if req.URL.Query().Get("from") == "library/base" && req.URL.Query().Get("mount") == exist.String() {
+				w.WriteHeader(http.StatusCreated)
+				return
+			}
+			w.Header().Set("Location", "/v2/app/blobs/uploads/session")
+			w.WriteHeader(http.StatusAccepted)
+		case req.Method == http.MethodDelete && req.URL.Path == "/v2/app/blobs/uploads/session":
+			cancelled = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	host := strings.TrimPrefix(srv.URL, "http://")
+	client := NewClient("", []string{host})
+
+	mounted, err := client.MountBlob(context.Background(), host, "app", "library/base", exist, nil)
+	assert.NoError(t, err)
+	assert.True(t, mounted)
+	assert.False(t, cancelled)
+
+	// the upload session is cancelled if it is not mounted.
+	mounted, err = client.MountBlob(context.Background(), host, "app", "library/base", digest.FromString("missing"), nil)
+	assert.NoError(t, err)
+	assert.False(t, mounted)
+	assert.True(t, cancelled)
+}
@@ -1,5 +1,282 @@
 package registry
 
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/registry/hosts"
+
+	"github.com/pkg/errors"
+)
+
+// maxErrorBody is the max bytes of the response body kept in error.
+const maxErrorBody = 1024
+
 // Client refers a client toward a specified registry.
 type Client struct {
+	// ConfigDir is the directory of registry hosts config, which provides
+	// the certificates and credentials of registry.
+	ConfigDir string
+
+	// InsecureRegistries are accessed by http.
+	InsecureRegistries []string
+}
+
+// NewClient creates a registry client with hosts config directory and
+// insecure registries.
+func NewClient(configDir string, insecureRegistries []string) *Client {
+	return &Client{
+		ConfigDir:          configDir,
+		InsecureRegistries: insecureRegistries,
+	}
+}
+
+// v2Endpoint is used to access the v2 API of one registry, the tokens are
+// cached by scope.
+type v2Endpoint struct {
+	url      *url.URL
+	httpCli  *http.Client
+	username string
+	password string
+
+	sync.Mutex
+	tokens map[string]string
+}
+
+// newV2Endpoint returns the endpoint of registry itself, the mirrors are not
+// used since they may not serve the whole registry. The credentials of user
+// take precedence over the ones in hosts config.
+func (client *Client) newV2Endpoint(registry string, auth *types.AuthConfig) (*v2Endpoint, error) {
+	if registry == "" || registry == "docker.io" {
+		registry = defaultV2Registry
+	}
+
+	hs, err := hosts.Lookup(client.ConfigDir, registry)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load hosts config of registry %s", registry)
+	}
+
+	host := hosts.Host{
+		Host:      registry,
+		Scheme:    "https",
+		TLSConfig: &tls.Config{},
+	}
+	for _, h := range hs {
+		if !h.Mirror {
+			host = h
+			break
+		}
+	}
+	if host.TLSConfig == nil {
+		host.TLSConfig = &tls.Config{}
+	}
+	if client.isInsecure(registry) {
+		host.Scheme = "http"
+		host.TLSConfig.InsecureSkipVerify = true
+	}
+
+	ep := &v2Endpoint{
+		url: &url.URL{
+			Scheme: host.Scheme,
+			Host:   host.Host,
+			Path:   "/v2/",
+		},
+		httpCli: &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: host.TLSConfig,
+			},
+		},
+		username: host.Username,
+		password: host.Secret,
+		tokens:   make(map[string]string),
+	}
+	if auth != nil && (auth.Username != "" || auth.Password != "") {
+		ep.username, ep.password = auth.Username, auth.Password
+	}
+	return ep, nil
+}
+
+func (client *Client) isInsecure(registry string) bool {
+	for _, r := range client.InsecureRegistries {
+		if strings.TrimPrefix(strings.TrimPrefix(r, "http://"), "https://") == registry {
+			return true
+		}
+	}
+	return false
+}
+
+// get sends the GET request to the path under /v2/, the response is returned
+// if the status is 200 OK.
+func (ep *v2Endpoint) get(ctx context.Context, path, scope string, header http.Header) (*http.Response, error) {
+	return ep.do(ctx, http.MethodGet, path, scope, header, http.StatusOK)
+}
+
+// do sends the request to the path under /v2/, and authorizes it by the
+// challenge of registry if it is unauthorized. The scope may contain several
+// space-separated scopes. The response is returned if the status is one of
+// the expected ones.
+func (ep *v2Endpoint) do(ctx context.Context, method, path, scope string, header http.Header, expected ...int) (*http.Response, error) {
+	u, err := ep.url.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+
+	newRequest := func() (*http.Request, error) {
+		req, err := http.NewRequest(method, u.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range header {
+			req.Header[k] = v
+		}
+
+		ep.Lock()
+		token, ok := ep.tokens[scope]
+		ep.Unlock()
+		if ok {
+			req.Header.Set("Authorization", token)
+		}
+		return req.WithContext(ctx), nil
+	}
+
+	req, err := newRequest()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := ep.httpCli.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenges := parseAuthHeader(resp.Header)
+		resp.Body.Close()
+
+		if err := ep.authorize(ctx, challenges, scope); err != nil {
+			return nil, err
+		}
+
+		if req, err = newRequest(); err != nil {
+			return nil, err
+		}
+		if resp, err = ep.httpCli.Do(req); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, status := range expected {
+		if resp.StatusCode == status {
+			return resp, nil
+		}
+	}
+	defer resp.Body.Close()
+	return nil, statusError(u.String(), resp)
+}
+
+// authorize gets the authorization of scope by the challenges, which is
+// used by the following requests.
+func (ep *v2Endpoint) authorize(ctx context.Context, challenges []Challenge, scope string) error {
+	for _, c := range challenges {
+		var (
+			authorization string
+			err           error
+		)
+
+		switch c.Scheme {
+		case "bearer":
+			authorization, err = ep.fetchToken(ctx, c.Parameters, scope)
+			if err != nil {
+				return err
+			}
+			authorization = "Bearer " + authorization
+		case "basic":
+			if ep.username == "" && ep.password == "" {
+				return errors.Wrapf(errtypes.ErrInvalidAuthorization, "no credentials for registry %s", ep.url.Host)
+			}
+			req := &http.Request{Header: make(http.Header)}
+			req.SetBasicAuth(ep.username, ep.password)
+			authorization = req.Header.Get("Authorization")
+		default:
+			continue
+		}
+
+		ep.Lock()
+		ep.tokens[scope] = authorization
+		ep.Unlock()
+		return nil
+	}
+	return errors.Wrapf(errtypes.ErrInvalidAuthorization, "unsupported challenges of registry %s", ep.url.Host)
+}
+
+// fetchToken gets the bearer token of scope from the realm of challenge.
+func (ep *v2Endpoint) fetchToken(ctx context.Context, params map[string]string, scope string) (string, error) {
+	realmURL, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return "", errors.Wrapf(errtypes.ErrInvalidAuthorization, "invalid realm %q of registry %s", params["realm"], ep.url.Host)
+	}
+
+	q := realmURL.Query()
+	if service := params["service"]; service != "" {
+		q.Set("service", service)
+	}
+	for _, s := range strings.Fields(scope) {
+		q.Add("scope", s)
+	}
+	realmURL.RawQuery = q.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, realmURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if ep.username != "" || ep.password != "" {
+		req.SetBasicAuth(ep.username, ep.password)
+	}
+
+	resp, err := ep.httpCli.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Wrapf(errtypes.ErrInvalidAuthorization, "failed to fetch token of registry %s with http status %s",
+			ep.url.Host, http.StatusText(resp.StatusCode))
+	}
+
+	t := token{}
+	if err := json.NewDecoder(resp.Body).Decode(&t); err != nil {
+		return "", errors.Wrap(err, "failed to decode token")
+	}
+	if t.Token == "" {
+		t.Token = t.AccessToken
+	}
+	if t.Token == "" {
+		return "", errors.Wrapf(errtypes.ErrInvalidAuthorization, "no token returned by %s", realmURL.Host)
+	}
+	return t.Token, nil
+}
+
+// statusError converts the unexpected status into error.
+func statusError(u string, resp *http.Response) error {
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	err := fmt.Errorf("unexpected http status %s of %s: %s", resp.Status, u, strings.TrimSpace(string(body)))
+
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return errors.Wrap(errtypes.ErrNotfound, err.Error())
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return errors.Wrap(errtypes.ErrInvalidAuthorization, err.Error())
+	}
+	return err
 }
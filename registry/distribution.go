@@ -0,0 +1,305 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+	"github.com/alibaba/pouch/pkg/reference"
+
+	"github.com/containerd/containerd/images"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+const (
+	// catalogPageSize is the number of repositories requested in one page.
+	catalogPageSize = 100
+
+	// maxManifestSize is the max size of manifest or image config.
+	maxManifestSize = 4 << 20
+)
+
+// manifestMediaTypes are the media types of manifest accepted.
+var manifestMediaTypes = []string{
+	ocispec.MediaTypeImageIndex,
+	images.MediaTypeDockerSchema2ManifestList,
+	ocispec.MediaTypeImageManifest,
+	images.MediaTypeDockerSchema2Manifest,
+}
+
+// SplitName splits the full name of repository into registry and path.
+func SplitName(name string) (string, string, error) {
+	idx := strings.IndexRune(name, '/')
+	if idx == -1 {
+		return "", "", errors.Wrapf(errtypes.ErrInvalidParam, "no registry in repository name %s", name)
+	}
+	return name[:idx], name[idx+1:], nil
+}
+
+// Catalog lists the repositories of registry by the _catalog API.
+func (client *Client) Catalog(ctx context.Context, registry string, auth *types.AuthConfig) ([]string, error) {
+	ep, err := client.newV2Endpoint(registry, auth)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		repos []string
+		path  = fmt.Sprintf("_catalog?n=%d", catalogPageSize)
+	)
+	for path != "" {
+		resp, err := ep.get(ctx, path, "registry:catalog:*", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		page := struct {
+			Repositories []string `json:"repositories"`
+		}{}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode catalog")
+		}
+
+		repos = append(repos, page.Repositories...)
+		path = nextPage(resp.Header)
+	}
+	return repos, nil
+}
+
+// Tags lists the tags of repository by the tags/list API.
+func (client *Client) Tags(ctx context.Context, name string, auth *types.AuthConfig) ([]string, error) {
+	registry, repo, err := SplitName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	ep, err := client.newV2Endpoint(registry, auth)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		tags []string
+		path = repo + "/tags/list"
+	)
+	for path != "" {
+		resp, err := ep.get(ctx, path, pullScope(repo), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		page := struct {
+			Tags []string `json:"tags"`
+		}{}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode tags")
+		}
+
+		tags = append(tags, page.Tags...)
+		path = nextPage(resp.Header)
+	}
+	return tags, nil
+}
+
+// Inspect returns the descriptor, platforms and manifest of image without
+// pulling it.
+func (client *Client) Inspect(ctx context.Context, ref string, auth *types.AuthConfig) (*types.DistributionInspect, error) {
+	desc, data, platforms, err := client.ResolveManifest(ctx, ref, auth)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &types.DistributionInspect{
+		Descriptor: &types.OCIDescriptor{
+			MediaType: desc.MediaType,
+			Digest:    desc.Digest.String(),
+			Size:      desc.Size,
+		},
+		Manifest: json.RawMessage(data),
+	}
+	for _, p := range platforms {
+		result.Platforms = append(result.Platforms, toPlatform(p))
+	}
+	return result, nil
+}
+
+// ResolveManifest fetches the manifest of image by tag or digest, and
+// returns its descriptor, content and platforms. The platforms are read from
+// manifest list, or the image config of manifest.
+func (client *Client) ResolveManifest(ctx context.Context, ref string, auth *types.AuthConfig) (ocispec.Descriptor, []byte, []ocispec.Platform, error) {
+	named, err := reference.Parse(ref)
+	if err != nil {
+		return ocispec.Descriptor{}, nil, nil, errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+	named = reference.WithDefaultTagIfMissing(named)
+
+	registry, repo, err := SplitName(named.Name())
+	if err != nil {
+		return ocispec.Descriptor{}, nil, nil, err
+	}
+
+	ep, err := client.newV2Endpoint(registry, auth)
+	if err != nil {
+		return ocispec.Descriptor{}, nil, nil, err
+	}
+
+	object := ""
+	switch r := named.(type) {
+	case reference.Digested:
+		object = r.Digest().String()
+	case reference.Tagged:
+		object = r.Tag()
+	}
+
+	desc, data, err := ep.fetchManifest(ctx, repo, object)
+	if err != nil {
+		return ocispec.Descriptor{}, nil, nil, err
+	}
+
+	var platforms []ocispec.Platform
+	switch desc.MediaType {
+	case ocispec.MediaTypeImageIndex, images.MediaTypeDockerSchema2ManifestList:
+		var index ocispec.Index
+		if err := json.Unmarshal(data, &index); err != nil {
+			return ocispec.Descriptor{}, nil, nil, errors.Wrap(err, "failed to decode manifest list")
+		}
+
+		for _, m := range index.Manifests {
+			if m.Platform != nil {
+				platforms = append(platforms, *m.Platform)
+			}
+		}
+	default:
+		var manifest ocispec.Manifest
+		if err := json.Unmarshal(data, &manifest); err != nil {
+			return ocispec.Descriptor{}, nil, nil, errors.Wrap(err, "failed to decode manifest")
+		}
+
+		if manifest.Config.Digest == "" {
+			break
+		}
+
+		config, err := ep.fetchBlob(ctx, repo, manifest.Config.Digest)
+		if err != nil {
+			return ocispec.Descriptor{}, nil, nil, err
+		}
+
+		var img ocispec.Image
+		if err := json.Unmarshal(config, &img); err != nil {
+			return ocispec.Descriptor{}, nil, nil, errors.Wrap(err, "failed to decode image config")
+		}
+		platforms = append(platforms, ocispec.Platform{
+			Architecture: img.Architecture,
+			OS:           img.OS,
+		})
+	}
+	return desc, data, platforms, nil
+}
+
+// fetchManifest fetches the manifest by tag or digest, the digest of content
+// is verified.
+func (ep *v2Endpoint) fetchManifest(ctx context.Context, repo, object string) (ocispec.Descriptor, []byte, error) {
+	header := http.Header{}
+	header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+
+	resp, err := ep.get(ctx, repo+"/manifests/"+object, pullScope(repo), header)
+	if err != nil {
+		return ocispec.Descriptor{}, nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxManifestSize))
+	if err != nil {
+		return ocispec.Descriptor{}, nil, errors.Wrap(err, "failed to read manifest")
+	}
+
+	dgst := digest.FromBytes(data)
+	if expected, err := digest.Parse(object); err == nil && expected != dgst {
+		return ocispec.Descriptor{}, nil, errors.Errorf("digest of manifest %s mismatches %s", dgst, expected)
+	}
+
+	mediaType := resp.Header.Get("Content-Type")
+	if idx := strings.IndexRune(mediaType, ';'); idx != -1 {
+		mediaType = mediaType[:idx]
+	}
+	if mediaType == "" || mediaType == "application/json" {
+		// the media type is in the content if it's not returned.
+		var m struct {
+			MediaType string `json:"mediaType"`
+		}
+		json.Unmarshal(data, &m)
+		mediaType = m.MediaType
+	}
+
+	return ocispec.Descriptor{
+		MediaType: mediaType,
+		Digest:    dgst,
+		Size:      int64(len(data)),
+	}, data, nil
+}
+
+// fetchBlob fetches the small blob, such as image config, by digest.
+func (ep *v2Endpoint) fetchBlob(ctx context.Context, repo string, dgst digest.Digest) ([]byte, error) {
+	resp, err := ep.get(ctx, repo+"/blobs/"+dgst.String(), pullScope(repo), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxManifestSize))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read blob %s", dgst)
+	}
+	if digest.FromBytes(data) != dgst {
+		return nil, errors.Errorf("digest of blob mismatches %s", dgst)
+	}
+	return data, nil
+}
+
+func pullScope(repo string) string {
+	return "repository:" + repo + ":pull"
+}
+
+// nextPage returns the path of next page in the Link header, or empty if
+// it's the last page.
+func nextPage(header http.Header) string {
+	link := header.Get("Link")
+	if link == "" || !strings.Contains(link, `rel="next"`) {
+		return ""
+	}
+
+	start, end := strings.IndexRune(link, '<'), strings.IndexRune(link, '>')
+	if start == -1 || end < start {
+		return ""
+	}
+
+	u, err := url.Parse(link[start+1 : end])
+	if err != nil {
+		return ""
+	}
+	// the link is relative to /v2/.
+	return strings.TrimPrefix(u.RequestURI(), "/v2/")
+}
+
+func toPlatform(p ocispec.Platform) *types.OCIPlatform {
+	return &types.OCIPlatform{
+		Architecture: p.Architecture,
+		Os:           p.OS,
+		OsVersion:    p.OSVersion,
+		OsFeatures:   p.OSFeatures,
+		Variant:      p.Variant,
+	}
+}
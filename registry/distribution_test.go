@@ -0,0 +1,152 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/pouch/apis/types"
+	"github.com/alibaba/pouch/pkg/errtypes"
+
+	"github.com/containerd/containerd/images"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+// testRegistry is a v2 registry stand-in which authorizes the requests by
+// bearer token, and serves one repository busybox.
+type testRegistry struct {
+	host  string
+	blobs map[string][]byte
+	types map[string]string
+}
+
+func newTestRegistry(t *testing.T) (*testRegistry, *httptest.Server) {
+	r := &testRegistry{
+		blobs: make(map[string][]byte),
+		types: make(map[string]string),
+	}
+
+	add := func(mediaType string, v interface{}) ocispec.Descriptor {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dgst := digest.FromBytes(data)
+		r.blobs[dgst.String()] = data
+		r.types[dgst.String()] = mediaType
+		return ocispec.Descriptor{MediaType: mediaType, Digest: dgst, Size: int64(len(data))}
+	}
+
+	config := add(ocispec.MediaTypeImageConfig, ocispec.Image{Architecture: "arm64", OS: "linux"})
+	manifest := add(images.MediaTypeDockerSchema2Manifest, ocispec.Manifest{Config: config})
+	manifest.Platform = &ocispec.Platform{Architecture: "arm", OS: "linux", Variant: "v7"}
+	index := add(images.MediaTypeDockerSchema2ManifestList, ocispec.Index{Manifests: []ocispec.Descriptor{manifest}})
+
+	r.blobs["latest"], r.types["latest"] = r.blobs[index.Digest.String()], index.MediaType
+	r.blobs["arm64"], r.types["arm64"] = r.blobs[manifest.Digest.String()], manifest.MediaType
+
+	srv := httptest.NewServer(r)
+	r.host = strings.TrimPrefix(srv.URL, "http://")
+	return r, srv
+}
+
+func (r *testRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/token" {
+		if user, pass, _ := req.BasicAuth(); user != "pouch" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"access_token": "token-" + req.URL.Query().Get("scope")})
+		return
+	}
+
+	scope := "repository:busybox:pull"
+	if req.URL.Path == "/v2/_catalog" {
+		scope = "registry:catalog:*"
+	}
+	if req.Header.Get("Authorization") != "Bearer token-"+scope {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="http://%s/token",service="test"`, r.host))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch {
+	case req.URL.Path == "/v2/_catalog" && req.URL.Query().Get("last") == "":
+		w.Header().Set("Link", `</v2/_catalog?last=busybox&n=1>; rel="next"`)
+		json.NewEncoder(w).Encode(map[string][]string{"repositories": {"busybox"}})
+	case req.URL.Path == "/v2/_catalog":
+		json.NewEncoder(w).Encode(map[string][]string{"repositories": {"library/nginx"}})
+	case req.URL.Path == "/v2/busybox/tags/list":
+		json.NewEncoder(w).Encode(map[string][]string{"tags": {"arm64", "latest"}})
+	case strings.HasPrefix(req.URL.Path, "/v2/busybox/manifests/"), strings.HasPrefix(req.URL.Path, "/v2/busybox/blobs/"):
+		object := req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:]
+		data, ok := r.blobs[object]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", r.types[object])
+		w.Write(data)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestDistribution(t *testing.T) {
+	reg, srv := newTestRegistry(t)
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := NewClient("", []string{reg.host})
+	auth := &types.AuthConfig{Username: "pouch", Password: "secret"}
+
+	repos, err := client.Catalog(ctx, reg.host, auth)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"busybox", "library/nginx"}, repos)
+
+	tags, err := client.Tags(ctx, reg.host+"/busybox", auth)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"arm64", "latest"}, tags)
+
+	// the platforms of manifest list.
+	result, err := client.Inspect(ctx, reg.host+"/busybox", auth)
+	assert.NoError(t, err)
+	assert.Equal(t, images.MediaTypeDockerSchema2ManifestList, result.Descriptor.MediaType)
+	assert.Equal(t, digest.FromBytes(reg.blobs["latest"]).String(), result.Descriptor.Digest)
+	assert.Equal(t, []*types.OCIPlatform{{Architecture: "arm", Os: "linux", Variant: "v7"}}, result.Platforms)
+	assert.Equal(t, json.RawMessage(reg.blobs["latest"]), result.Manifest)
+
+	// the platform of manifest is read from image config.
+	result, err = client.Inspect(ctx, reg.host+"/busybox:arm64", auth)
+	assert.NoError(t, err)
+	assert.Equal(t, images.MediaTypeDockerSchema2Manifest, result.Descriptor.MediaType)
+	assert.Equal(t, []*types.OCIPlatform{{Architecture: "arm64", Os: "linux"}}, result.Platforms)
+
+	// by digest.
+	result, err = client.Inspect(ctx, reg.host+"/busybox@"+digest.FromBytes(reg.blobs["arm64"]).String(), auth)
+	assert.NoError(t, err)
+	assert.Equal(t, digest.FromBytes(reg.blobs["arm64"]).String(), result.Descriptor.Digest)
+
+	_, err = client.Inspect(ctx, reg.host+"/busybox:unknown", auth)
+	assert.True(t, errtypes.IsNotfound(err))
+
+	_, err = client.Tags(ctx, reg.host+"/busybox", &types.AuthConfig{Username: "pouch", Password: "wrong"})
+	assert.True(t, errtypes.IsInvalidAuthorization(err))
+}
+
+func TestNextPage(t *testing.T) {
+	header := http.Header{}
+	assert.Equal(t, "", nextPage(header))
+
+	header.Set("Link", `</v2/_catalog?last=a&n=100>; rel="next"`)
+	assert.Equal(t, "_catalog?last=a&n=100", nextPage(header))
+
+	header.Set("Link", `<https://reg.example.com/v2/busybox/tags/list?last=b&n=100>; rel="next"`)
+	assert.Equal(t, "busybox/tags/list?last=b&n=100", nextPage(header))
+}
@@ -0,0 +1,461 @@
+// Package hosts loads the configuration of registry hosts. Each registry has
+// a directory named by its host[:port] in the config directory, the
+// _default directory is used by the registries without their own one:
+//
+//	/etc/pouch/certs.d/
+//	├── _default
+//	│   └── hosts.toml
+//	└── reg.example.com:5000
+//	    ├── ca.crt
+//	    ├── client.cert
+//	    ├── client.key
+//	    └── hosts.toml
+//
+// The hosts.toml looks like:
+//
+//	server = "https://reg.example.com:5000"
+//	capabilities = ["pull", "resolve", "push"]
+//	ca = "ca.crt"
+//	client = [["client.cert", "client.key"]]
+//
+//	[auth]
+//	  username = "admin"
+//	  password = "secret"
+//
+//	[host."https://mirror.example.com"]
+//	  capabilities = ["pull", "resolve"]
+//	  skip_verify = true
+//
+//	[host."http://10.0.0.1:5000"]
+//	  capabilities = ["pull"]
+//
+// The mirrors are tried in the order defined in file, before the server. If
+// there is no hosts.toml in the directory, the *.crt files are taken as CA,
+// and the *.cert and *.key files as client certificates of the registry.
+// The server of _default one is ignored, and the auth and client certificates
+// of server are not allowed in it, since they would be sent to any registry.
+package hosts
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultConfigDir is the default directory of registry hosts config.
+	DefaultConfigDir = "/etc/pouch/certs.d"
+
+	// defaultHostDir is the directory used by registry without config.
+	defaultHostDir = "_default"
+
+	// hostsFile is the name of hosts config file in the directory of registry.
+	hostsFile = "hosts.toml"
+)
+
+// Capabilities represents the operations allowed on host.
+type Capabilities uint8
+
+const (
+	// CapabilityPull allows to fetch the content by digest.
+	CapabilityPull Capabilities = 1 << iota
+
+	// CapabilityResolve allows to resolve the tag into digest.
+	CapabilityResolve
+
+	// CapabilityPush allows to push the content.
+	CapabilityPush
+)
+
+// Has returns true if all the capabilities c are allowed.
+func (c Capabilities) Has(t Capabilities) bool {
+	return c&t == t
+}
+
+// Host is the endpoint to access registry, which is the registry itself or
+// one mirror of it.
+type Host struct {
+	// Host is the address in form of host[:port].
+	Host string
+
+	// Scheme is http or https.
+	Scheme string
+
+	// Mirror is true if the host is a mirror of registry.
+	Mirror bool
+
+	// Capabilities are the operations allowed on host.
+	Capabilities Capabilities
+
+	// TLSConfig is used to access host by https.
+	TLSConfig *tls.Config
+
+	// Username and Secret are the credentials of host configured.
+	Username string
+	Secret   string
+}
+
+type authConfig struct {
+	Username string `toml:"username"`
+	Password string `toml:"password"`
+
+	// Auth is base64 encoded "username:password".
+	Auth string `toml:"auth"`
+}
+
+type hostConfig struct {
+	Capabilities []string    `toml:"capabilities"`
+	CA           interface{} `toml:"ca"`
+	Client       interface{} `toml:"client"`
+	SkipVerify   bool        `toml:"skip_verify"`
+	Auth         *authConfig `toml:"auth"`
+}
+
+type hostsConfig struct {
+	Server       string                `toml:"server"`
+	Capabilities []string              `toml:"capabilities"`
+	CA           interface{}           `toml:"ca"`
+	Client       interface{}           `toml:"client"`
+	SkipVerify   bool                  `toml:"skip_verify"`
+	Auth         *authConfig           `toml:"auth"`
+	Host         map[string]hostConfig `toml:"host"`
+}
+
+// Lookup returns the hosts of registry in config directory, the mirrors are
+// in front of the registry itself. Nil is returned if there is no config for
+// the registry.
+func Lookup(dir, registry string) ([]Host, error) {
+	if dir == "" {
+		return nil, nil
+	}
+
+	isDefault := false
+	hostDir := filepath.Join(dir, registry)
+	if _, err := os.Stat(hostDir); err != nil {
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+
+		isDefault = true
+		hostDir = filepath.Join(dir, defaultHostDir)
+		if _, err := os.Stat(hostDir); err != nil {
+			if os.IsNotExist(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(hostDir, hostsFile))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+		if isDefault {
+			return nil, nil
+		}
+
+		h, err := loadCertsDir(hostDir, registry)
+		if err != nil {
+			return nil, err
+		}
+		return []Host{h}, nil
+	}
+
+	hosts, err := parseHostsConfig(hostDir, registry, isDefault, data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s", filepath.Join(hostDir, hostsFile))
+	}
+	return hosts, nil
+}
+
+// parseHostsConfig parses the hosts.toml of registry, the server is ignored
+// in _default one, and the auth and client of server are rejected in it.
+func parseHostsConfig(hostDir, registry string, isDefault bool, data []byte) ([]Host, error) {
+	var cfg hostsConfig
+
+	md, err := toml.Decode(string(data), &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if isDefault && (cfg.Auth != nil || cfg.Client != nil) {
+		return nil, fmt.Errorf("auth and client of server are not allowed in %s", defaultHostDir)
+	}
+
+	var hosts []Host
+
+	// the keys are in the order defined in file.
+	seen := map[string]bool{}
+	for _, key := range md.Keys() {
+		if len(key) < 2 || key[0] != "host" || seen[key[1]] {
+			continue
+		}
+		seen[key[1]] = true
+
+		hc := cfg.Host[key[1]]
+		h, err := newHost(hostDir, key[1], hc.Capabilities, CapabilityPull|CapabilityResolve,
+			hc.CA, hc.Client, hc.SkipVerify, hc.Auth)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid host %s", key[1])
+		}
+		h.Mirror = true
+		hosts = append(hosts, h)
+	}
+
+	server := cfg.Server
+	if server == "" || isDefault {
+		server = "https://" + defaultHost(registry)
+	}
+
+	h, err := newHost(hostDir, server, cfg.Capabilities, CapabilityPull|CapabilityResolve|CapabilityPush,
+		cfg.CA, cfg.Client, cfg.SkipVerify, cfg.Auth)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid server %s", server)
+	}
+	return append(hosts, h), nil
+}
+
+func newHost(hostDir, server string, capabilities []string, defaultCapabilities Capabilities,
+	ca, client interface{}, skipVerify bool, auth *authConfig) (Host, error) {
+
+	u, err := url.Parse(server)
+	if err != nil {
+		return Host{}, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return Host{}, fmt.Errorf("scheme %s is not supported", u.Scheme)
+	}
+	if u.Host == "" {
+		return Host{}, fmt.Errorf("host is required")
+	}
+	if p := strings.TrimSuffix(u.Path, "/"); p != "" && p != "/v2" {
+		return Host{}, fmt.Errorf("path %s is not supported", u.Path)
+	}
+
+	h := Host{
+		Host:         u.Host,
+		Scheme:       u.Scheme,
+		Capabilities: defaultCapabilities,
+	}
+
+	if capabilities != nil {
+		if h.Capabilities, err = parseCapabilities(capabilities); err != nil {
+			return Host{}, err
+		}
+	}
+
+	if h.TLSConfig, err = newTLSConfig(hostDir, ca, client, skipVerify); err != nil {
+		return Host{}, err
+	}
+
+	if auth != nil {
+		if h.Username, h.Secret, err = parseAuth(auth); err != nil {
+			return Host{}, err
+		}
+	}
+	return h, nil
+}
+
+func parseCapabilities(capabilities []string) (Capabilities, error) {
+	var c Capabilities
+	for _, v := range capabilities {
+		switch v {
+		case "pull":
+			c |= CapabilityPull
+		case "resolve":
+			c |= CapabilityResolve
+		case "push":
+			c |= CapabilityPush
+		default:
+			return 0, fmt.Errorf("unknown capability %s", v)
+		}
+	}
+	return c, nil
+}
+
+func parseAuth(auth *authConfig) (string, string, error) {
+	if auth.Auth == "" {
+		return auth.Username, auth.Password, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+	if err != nil {
+		return "", "", errors.Wrap(err, "invalid auth")
+	}
+
+	parts := strings.SplitN(string(decoded), ":", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid auth, it should be base64 encoded username:password")
+	}
+	return parts[0], parts[1], nil
+}
+
+// newTLSConfig returns the tls config with the CA and client certificates.
+// The ca is a file or a list of files, and the client is a list of cert
+// and key pairs, or only one pair.
+func newTLSConfig(hostDir string, ca, client interface{}, skipVerify bool) (*tls.Config, error) {
+	cfg := &tls.Config{
+		InsecureSkipVerify: skipVerify,
+	}
+
+	var cas []string
+	switch v := ca.(type) {
+	case nil:
+	case string:
+		cas = []string{v}
+	case []interface{}:
+		for _, f := range v {
+			s, ok := f.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid ca %v", ca)
+			}
+			cas = append(cas, s)
+		}
+	default:
+		return nil, fmt.Errorf("invalid ca %v", ca)
+	}
+
+	var pairs [][2]string
+	switch v := client.(type) {
+	case nil:
+	case []interface{}:
+		if pair, ok := toPair(v); ok {
+			pairs = append(pairs, pair)
+			break
+		}
+		for _, p := range v {
+			l, ok := p.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("invalid client %v", client)
+			}
+			pair, ok := toPair(l)
+			if !ok {
+				return nil, fmt.Errorf("invalid client %v", client)
+			}
+			pairs = append(pairs, pair)
+		}
+	default:
+		return nil, fmt.Errorf("invalid client %v", client)
+	}
+
+	for i := range cas {
+		cas[i] = absPath(hostDir, cas[i])
+	}
+	for i := range pairs {
+		pairs[i] = [2]string{absPath(hostDir, pairs[i][0]), absPath(hostDir, pairs[i][1])}
+	}
+
+	if err := loadCertificates(cfg, cas, pairs); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// loadCertsDir loads the *.crt files as CA, and *.cert and *.key files as
+// client certificates of registry.
+func loadCertsDir(hostDir, registry string) (Host, error) {
+	files, err := ioutil.ReadDir(hostDir)
+	if err != nil {
+		return Host{}, err
+	}
+
+	var (
+		cas   []string
+		pairs [][2]string
+	)
+
+	for _, f := range files {
+		name := f.Name()
+		switch {
+		case strings.HasSuffix(name, ".crt"):
+			cas = append(cas, filepath.Join(hostDir, name))
+		case strings.HasSuffix(name, ".cert"):
+			key := strings.TrimSuffix(name, ".cert") + ".key"
+			if _, err := os.Stat(filepath.Join(hostDir, key)); err != nil {
+				return Host{}, fmt.Errorf("missing key %s for client certificate %s", key, name)
+			}
+			pairs = append(pairs, [2]string{filepath.Join(hostDir, name), filepath.Join(hostDir, key)})
+		case strings.HasSuffix(name, ".key"):
+			cert := strings.TrimSuffix(name, ".key") + ".cert"
+			if _, err := os.Stat(filepath.Join(hostDir, cert)); err != nil {
+				return Host{}, fmt.Errorf("missing client certificate %s for key %s", cert, name)
+			}
+		}
+	}
+
+	cfg := &tls.Config{}
+	if err := loadCertificates(cfg, cas, pairs); err != nil {
+		return Host{}, err
+	}
+
+	return Host{
+		Host:         defaultHost(registry),
+		Scheme:       "https",
+		Capabilities: CapabilityPull | CapabilityResolve | CapabilityPush,
+		TLSConfig:    cfg,
+	}, nil
+}
+
+func loadCertificates(cfg *tls.Config, cas []string, pairs [][2]string) error {
+	if len(cas) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+
+		for _, ca := range cas {
+			data, err := ioutil.ReadFile(ca)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read ca %s", ca)
+			}
+			if !pool.AppendCertsFromPEM(data) {
+				return fmt.Errorf("failed to load ca %s", ca)
+			}
+		}
+		cfg.RootCAs = pool
+	}
+
+	for _, pair := range pairs {
+		cert, err := tls.LoadX509KeyPair(pair[0], pair[1])
+		if err != nil {
+			return errors.Wrapf(err, "failed to load client certificate %s", pair[0])
+		}
+		cfg.Certificates = append(cfg.Certificates, cert)
+	}
+	return nil
+}
+
+func toPair(l []interface{}) ([2]string, bool) {
+	if len(l) != 2 {
+		return [2]string{}, false
+	}
+
+	cert, ok1 := l[0].(string)
+	key, ok2 := l[1].(string)
+	return [2]string{cert, key}, ok1 && ok2
+}
+
+func absPath(dir, p string) string {
+	if filepath.IsAbs(p) {
+		return p
+	}
+	return filepath.Join(dir, p)
+}
+
+// defaultHost returns the host to access registry.
+func defaultHost(registry string) string {
+	if registry == "docker.io" {
+		return "registry-1.docker.io"
+	}
+	return registry
+}
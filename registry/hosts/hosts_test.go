@@ -0,0 +1,200 @@
+package hosts
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeCertificate writes a self-signed certificate and its key into dir.
+func writeCertificate(t *testing.T, dir, cert, key string) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "pouch"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &priv.PublicKey, priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, cert),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644))
+	if key != "" {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, key),
+			pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	}
+}
+
+func TestLookup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "certs.d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// no config
+	hs, err := Lookup(dir, "reg.example.com")
+	assert.NoError(t, err)
+	assert.Nil(t, hs)
+
+	hs, err = Lookup("", "reg.example.com")
+	assert.NoError(t, err)
+	assert.Nil(t, hs)
+
+	regDir := filepath.Join(dir, "reg.example.com:5000")
+	assert.NoError(t, os.MkdirAll(regDir, 0755))
+	writeCertificate(t, regDir, "ca.crt", "")
+	writeCertificate(t, regDir, "client.cert", "client.key")
+
+	// the certificates in directory without hosts.toml
+	hs, err = Lookup(dir, "reg.example.com:5000")
+	assert.NoError(t, err)
+	if assert.Len(t, hs, 1) {
+		assert.Equal(t, "reg.example.com:5000", hs[0].Host)
+		assert.Equal(t, "https", hs[0].Scheme)
+		assert.False(t, hs[0].Mirror)
+		assert.True(t, hs[0].Capabilities.Has(CapabilityPull|CapabilityResolve|CapabilityPush))
+		assert.NotNil(t, hs[0].TLSConfig.RootCAs)
+		assert.Len(t, hs[0].TLSConfig.Certificates, 1)
+	}
+
+	config := `
+server = "http://reg.example.com:5000"
+capabilities = ["push"]
+
+[auth]
+  auth = "YWRtaW46c2VjcmV0"
+
+[host."https://mirror2.example.com"]
+  capabilities = ["pull", "resolve"]
+  ca = "ca.crt"
+  client = [["client.cert", "client.key"]]
+
+  [host."https://mirror2.example.com".auth]
+    username = "mirror"
+    password = "pass"
+
+[host."http://mirror1.example.com:5000/v2"]
+  capabilities = ["pull"]
+  skip_verify = true
+`
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(regDir, hostsFile), []byte(config), 0644))
+
+	hs, err = Lookup(dir, "reg.example.com:5000")
+	assert.NoError(t, err)
+	if assert.Len(t, hs, 3) {
+		// the mirrors are in the order defined in file.
+		assert.Equal(t, "mirror2.example.com", hs[0].Host)
+		assert.Equal(t, "https", hs[0].Scheme)
+		assert.True(t, hs[0].Mirror)
+		assert.Equal(t, CapabilityPull|CapabilityResolve, hs[0].Capabilities)
+		assert.NotNil(t, hs[0].TLSConfig.RootCAs)
+		assert.Len(t, hs[0].TLSConfig.Certificates, 1)
+		assert.Equal(t, "mirror", hs[0].Username)
+		assert.Equal(t, "pass", hs[0].Secret)
+
+		assert.Equal(t, "mirror1.example.com:5000", hs[1].Host)
+		assert.Equal(t, "http", hs[1].Scheme)
+		assert.Equal(t, CapabilityPull, hs[1].Capabilities)
+		assert.True(t, hs[1].TLSConfig.InsecureSkipVerify)
+
+		assert.Equal(t, "reg.example.com:5000", hs[2].Host)
+		assert.Equal(t, "http", hs[2].Scheme)
+		assert.False(t, hs[2].Mirror)
+		assert.Equal(t, CapabilityPush, hs[2].Capabilities)
+		assert.Equal(t, "admin", hs[2].Username)
+		assert.Equal(t, "secret", hs[2].Secret)
+	}
+
+	// the _default is used by other registries, but its server is ignored.
+	defaultDir := filepath.Join(dir, defaultHostDir)
+	assert.NoError(t, os.MkdirAll(defaultDir, 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(defaultDir, hostsFile), []byte(`
+server = "https://other.example.com"
+
+[host."https://mirror.example.com"]
+`), 0644))
+
+	hs, err = Lookup(dir, "docker.io")
+	assert.NoError(t, err)
+	if assert.Len(t, hs, 2) {
+		assert.Equal(t, "mirror.example.com", hs[0].Host)
+		assert.Equal(t, CapabilityPull|CapabilityResolve, hs[0].Capabilities)
+		assert.Equal(t, "registry-1.docker.io", hs[1].Host)
+	}
+
+	// the credentials and client certificates in _default would be sent to
+	// any registry.
+	writeCertificate(t, defaultDir, "client.cert", "client.key")
+	for _, config := range []string{
+		`[auth]
+		username = "admin"
+		password = "secret"`,
+		`client = [["client.cert", "client.key"]]`,
+	} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(defaultDir, hostsFile), []byte(config), 0644))
+
+		_, err = Lookup(dir, "docker.io")
+		assert.Error(t, err, config)
+	}
+}
+
+func TestLookupInvalidConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "certs.d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	regDir := filepath.Join(dir, "reg.example.com")
+	assert.NoError(t, os.MkdirAll(regDir, 0755))
+
+	for _, config := range []string{
+		`server = "ftp://reg.example.com"`,
+		`server = "https://reg.example.com/prefix"`,
+		`capabilities = ["pull", "delete"]`,
+		`ca = "missing.crt"`,
+		`client = "client.cert"`,
+		`[auth]
+		auth = "invalid"`,
+		`[host."https://mirror.example.com"]
+		ca = 1`,
+		`server = `,
+	} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(regDir, hostsFile), []byte(config), 0644))
+
+		_, err := Lookup(dir, "reg.example.com")
+		assert.Error(t, err, config)
+	}
+
+	// the key of client certificate is missing.
+	assert.NoError(t, os.Remove(filepath.Join(regDir, hostsFile)))
+	writeCertificate(t, regDir, "client.cert", "")
+
+	_, err = Lookup(dir, "reg.example.com")
+	assert.Error(t, err)
+}
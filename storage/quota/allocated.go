@@ -0,0 +1,119 @@
+// +build linux
+
+package quota
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// allocatedQuotaIDs records the quota IDs allocated by pouchd and the time
+// they are allocated. The quota IDs used by other tools on the host never
+// appear in it, so only the recorded ones can be cleared safely.
+type allocatedQuotaIDs struct {
+	lock sync.Mutex
+	file string
+	ids  map[uint32]time.Time
+}
+
+var allocated = &allocatedQuotaIDs{
+	ids: make(map[uint32]time.Time),
+}
+
+// SetAllocatedQuotaIDsFile sets the file where the allocated quota IDs are
+// persisted, and loads the recorded ones from it.
+func SetAllocatedQuotaIDsFile(file string) error {
+	allocated.lock.Lock()
+	defer allocated.lock.Unlock()
+
+	ids := make(map[uint32]time.Time)
+	data, err := ioutil.ReadFile(file)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to read allocated quota ids from %s", file)
+	}
+	if len(data) > 0 {
+		var records map[string]time.Time
+		if err := json.Unmarshal(data, &records); err != nil {
+			return errors.Wrapf(err, "failed to decode allocated quota ids from %s", file)
+		}
+		for k, t := range records {
+			id, err := strconv.ParseUint(k, 10, 32)
+			if err != nil {
+				continue
+			}
+			ids[uint32(id)] = t
+		}
+	}
+
+	allocated.file = file
+	allocated.ids = ids
+	return nil
+}
+
+// AllocatedQuotaIDTime returns the time the quota ID is allocated by pouchd,
+// the second return value is false if it is not allocated by pouchd.
+func AllocatedQuotaIDTime(quotaID uint32) (time.Time, bool) {
+	allocated.lock.Lock()
+	defer allocated.lock.Unlock()
+
+	t, ok := allocated.ids[quotaID]
+	return t, ok
+}
+
+// recordAllocatedQuotaID records the quota ID allocated by pouchd.
+func recordAllocatedQuotaID(quotaID uint32) {
+	allocated.lock.Lock()
+	defer allocated.lock.Unlock()
+
+	allocated.ids[quotaID] = time.Now().UTC()
+	if err := allocated.persist(); err != nil {
+		log.With(nil).Errorf("failed to record allocated quota id(%d): %v", quotaID, err)
+	}
+}
+
+// releaseAllocatedQuotaID removes the quota ID from the allocated ones.
+func releaseAllocatedQuotaID(quotaID uint32) {
+	allocated.lock.Lock()
+	defer allocated.lock.Unlock()
+
+	if _, ok := allocated.ids[quotaID]; !ok {
+		return
+	}
+	delete(allocated.ids, quotaID)
+	if err := allocated.persist(); err != nil {
+		log.With(nil).Errorf("failed to release allocated quota id(%d): %v", quotaID, err)
+	}
+}
+
+// persist writes the allocated quota IDs into file, caller should hold the lock.
+func (a *allocatedQuotaIDs) persist() error {
+	if a.file == "" {
+		return nil
+	}
+
+	records := make(map[string]time.Time, len(a.ids))
+	for id, t := range a.ids {
+		records[strconv.FormatUint(uint64(id), 10)] = t
+	}
+	data, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(a.file), 0700); err != nil {
+		return err
+	}
+	tmp := a.file + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, a.file)
+}
@@ -91,7 +91,7 @@ func (quota *GrpQuotaDriver) EnforceQuota(dir string) (*MountInfo, error) {
 			return nil, errors.Wrapf(err, "failed to setquota, stdout: (%s), stderr: (%s), exit: (%d)",
 				stdout, stderr, exit)
 		}
-		if err := quota.setQuota(0, 0, mountPoint); err != nil {
+		if err := quota.setQuota(0, 0, 0, mountPoint); err != nil {
 			os.Remove(filename)
 			log.With(nil).Errorf("failed to set quota, mountpoint: (%s), err: (%v)", mountPoint, err)
 			return nil, errors.Wrapf(err, "failed to set quota, mountpoint: (%s)", mountPoint)
@@ -182,8 +182,8 @@ func (quota *GrpQuotaDriver) CheckMountpoint(devID uint64) (string, bool, string
 }
 
 // SetDiskQuota is used to set quota for directory.
-func (quota *GrpQuotaDriver) SetDiskQuota(dir string, size string, quotaID uint32) error {
-	log.With(nil).Debugf("set disk quota, dir: %s, size: %s, quotaID: %d", dir, size, quotaID)
+func (quota *GrpQuotaDriver) SetDiskQuota(dir string, size string, inodes uint64, quotaID uint32) error {
+	log.With(nil).Debugf("set disk quota, dir: %s, size: %s, inodes: %d, quotaID: %d", dir, size, inodes, quotaID)
 
 	mountInfo, err := quota.EnforceQuota(dir)
 	if err != nil {
@@ -211,7 +211,7 @@ func (quota *GrpQuotaDriver) SetDiskQuota(dir string, size string, quotaID uint3
 		return errors.Errorf("failed to find quota id to set subtree")
 	}
 
-	return quota.setQuota(id, limit, mountInfo.MountPoint)
+	return quota.setQuota(id, limit, inodes, mountInfo.MountPoint)
 }
 
 // GetQuotaIDInFileAttr returns quota ID in the directory attributes.
@@ -283,6 +283,7 @@ func (quota *GrpQuotaDriver) GetNextQuotaID() (uint32, error) {
 	}
 	quota.quotaIDs[id] = struct{}{}
 	quota.lastID = id
+	recordAllocatedQuotaID(id)
 
 	log.With(nil).Debugf("get next project quota id: %d", id)
 	return id, nil
@@ -374,13 +375,47 @@ func (quota *GrpQuotaDriver) setQuotaID(dir string, qid uint32) (uint32, error)
 		dir, strid, stdout, stderr, exit)
 }
 
-func (quota *GrpQuotaDriver) setQuota(quotaID uint32, diskQuota uint64, mountPoint string) error {
-	log.With(nil).Debugf("set user quota, quotaID: %d, limit: %d, mountpoint: %s", quotaID, diskQuota, mountPoint)
+func (quota *GrpQuotaDriver) setQuota(quotaID uint32, diskQuota, inodeQuota uint64, mountPoint string) error {
+	log.With(nil).Debugf("set user quota, quotaID: %d, limit: %d, inodes: %d, mountpoint: %s", quotaID, diskQuota, inodeQuota, mountPoint)
 
 	quotaIDStr := strconv.FormatUint(uint64(quotaID), 10)
 	limit := strconv.FormatUint(diskQuota, 10)
+	inodes := strconv.FormatUint(inodeQuota, 10)
 
-	exit, stdout, stderr, err := exec.Run(0, "setquota", "-g", quotaIDStr, "0", limit, "0", "0", mountPoint)
-	return errors.Wrapf(err, "failed to set quota, mountpoint: (%s), quota id: (%d), quota: (%d kbytes), stdout: (%s), stderr: (%s), exit: (%d)",
-		mountPoint, quotaID, diskQuota, stdout, stderr, exit)
+	exit, stdout, stderr, err := exec.Run(0, "setquota", "-g", quotaIDStr, "0", limit, "0", inodes, mountPoint)
+	return errors.Wrapf(err, "failed to set quota, mountpoint: (%s), quota id: (%d), quota: (%d kbytes), inodes: (%d), stdout: (%s), stderr: (%s), exit: (%d)",
+		mountPoint, quotaID, diskQuota, inodeQuota, stdout, stderr, exit)
+}
+
+// GetQuotaReport returns the usage and limits of all the quota IDs on the
+// filesystem of mountpoint, which are read from the group quota report.
+// execution command: `repquota -gn mountpoint`
+func (quota *GrpQuotaDriver) GetQuotaReport(mountPoint string) ([]*QuotaUsage, error) {
+	return getQuotaReport("-gn", mountPoint)
+}
+
+// GetQuotaUsage returns the usage and limits of quota ID on all the
+// filesystems with group quota enabled, one entry per device.
+// execution command: `repquota -gan`
+func (quota *GrpQuotaDriver) GetQuotaUsage(quotaID uint32) ([]*QuotaUsage, error) {
+	return getQuotaUsage(quotaID, "-gan")
+}
+
+// ClearQuota removes the limits of quota ID on the filesystem of mountpoint,
+// and releases it from the allocated quota IDs.
+// execution command: `setquota -g qid 0 0 0 0 mountpoint`
+func (quota *GrpQuotaDriver) ClearQuota(quotaID uint32, mountPoint string) error {
+	quotaIDStr := strconv.FormatUint(uint64(quotaID), 10)
+	exit, stdout, stderr, err := exec.Run(0, "setquota", "-g", quotaIDStr, "0", "0", "0", "0", mountPoint)
+	if err != nil {
+		return errors.Wrapf(err, "failed to clear quota, mountpoint: (%s), quota id: (%d), stdout: (%s), stderr: (%s), exit: (%d)",
+			mountPoint, quotaID, stdout, stderr, exit)
+	}
+
+	quota.lock.Lock()
+	delete(quota.quotaIDs, quotaID)
+	quota.lock.Unlock()
+	releaseAllocatedQuotaID(quotaID)
+
+	return nil
 }
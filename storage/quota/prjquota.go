@@ -111,11 +111,12 @@ func (quota *PrjQuotaDriver) setQuotaID(dir string, qid uint32, mountInfo *Mount
 		dir, strid, stdout, stderr, exit)
 }
 
-// SetDiskQuota uses the following two parameters to set disk quota for a directory.
+// SetDiskQuota uses the following parameters to set disk quota for a directory.
 // * quota size: a byte size of requested quota.
+// * inodes: the inode limit of requested quota, 0 means no limit.
 // * quota ID: an ID represent quota attr which is used in the global scope.
-func (quota *PrjQuotaDriver) SetDiskQuota(dir string, size string, quotaID uint32) error {
-	log.With(nil).Debugf("set disk quota, dir: %s, size: %s, quotaID: %d", dir, size, quotaID)
+func (quota *PrjQuotaDriver) SetDiskQuota(dir string, size string, inodes uint64, quotaID uint32) error {
+	log.With(nil).Debugf("set disk quota, dir: %s, size: %s, inodes: %d, quotaID: %d", dir, size, inodes, quotaID)
 	mountInfo, err := quota.EnforceQuota(dir)
 	if err != nil {
 		return errors.Wrapf(err, "failed to enforce quota, dir: (%s)", dir)
@@ -142,7 +143,7 @@ func (quota *PrjQuotaDriver) SetDiskQuota(dir string, size string, quotaID uint3
 		return errors.Errorf("failed to find quota id to set subtree")
 	}
 
-	return quota.setQuota(id, limit, mountInfo)
+	return quota.setQuota(id, limit, inodes, mountInfo)
 }
 
 // CheckMountpoint is used to check mount point.
@@ -212,20 +213,22 @@ func (quota *PrjQuotaDriver) CheckMountpoint(devID uint64) (string, bool, string
 // setQuota uses system tool "setquota" to set project quota for binding of limit and mountpoint and quotaID.
 // * quotaID: quota ID which means this ID is used in the global scope.
 // * blockLimit: block limit number for mountpoint.
+// * inodeLimit: inode limit number for mountpoint.
 // * mountPoint: the mountpoint of the device in the filesystem
 // ext4: setquota -P qid $softlimit $hardlimit $softinode $hardinode mountpoint
-func (quota *PrjQuotaDriver) setQuota(quotaID uint32, blockLimit uint64, mountInfo *MountInfo) error {
+func (quota *PrjQuotaDriver) setQuota(quotaID uint32, blockLimit, inodeLimit uint64, mountInfo *MountInfo) error {
 	mountPoint := mountInfo.MountPoint
-	log.With(nil).Debugf("set project quota, quotaID: %d, limit: %d, mountpoint: %s", quotaID, blockLimit, mountPoint)
+	log.With(nil).Debugf("set project quota, quotaID: %d, limit: %d, inodes: %d, mountpoint: %s", quotaID, blockLimit, inodeLimit, mountPoint)
 
 	quotaIDStr := strconv.FormatUint(uint64(quotaID), 10)
 	blockLimitStr := strconv.FormatUint(blockLimit, 10)
+	inodeLimitStr := strconv.FormatUint(inodeLimit, 10)
 	// set project quota
-	exit, stdout, stderr, err := exec.Run(0, "setquota", "-P", quotaIDStr, "0", blockLimitStr, "0", "0", mountPoint)
-	log.With(nil).Infof("set quota size, mountpoint: (%s), quota id: (%d), quota: (%d kbytes), stdout: (%s), stderr: (%s), exit: (%d)",
-		mountPoint, quotaID, blockLimit, stdout, stderr, exit)
-	return errors.Wrapf(err, "failed to set quota, mountpoint: (%s), quota id: (%d), quota: (%d kbytes), stdout: (%s), stderr: (%s), exit: (%d)",
-		mountPoint, quotaID, blockLimit, stdout, stderr, exit)
+	exit, stdout, stderr, err := exec.Run(0, "setquota", "-P", quotaIDStr, "0", blockLimitStr, "0", inodeLimitStr, mountPoint)
+	log.With(nil).Infof("set quota size, mountpoint: (%s), quota id: (%d), quota: (%d kbytes), inodes: (%d), stdout: (%s), stderr: (%s), exit: (%d)",
+		mountPoint, quotaID, blockLimit, inodeLimit, stdout, stderr, exit)
+	return errors.Wrapf(err, "failed to set quota, mountpoint: (%s), quota id: (%d), quota: (%d kbytes), inodes: (%d), stdout: (%s), stderr: (%s), exit: (%d)",
+		mountPoint, quotaID, blockLimit, inodeLimit, stdout, stderr, exit)
 }
 
 // GetQuotaIDInFileAttr gets attributes of the file which is in the inode.
@@ -301,6 +304,7 @@ func (quota *PrjQuotaDriver) GetNextQuotaID() (uint32, error) {
 	}
 	quota.quotaIDs[id] = struct{}{}
 	quota.lastID = id
+	recordAllocatedQuotaID(id)
 
 	log.With(nil).Debugf("get next project quota id: %d", id)
 	return id, nil
@@ -321,3 +325,36 @@ func (quota *PrjQuotaDriver) SetFileAttrRecursive(dir string, quotaID uint32) er
 		dir, strID, stdout, stderr, exit)
 	return errors.Wrapf(err, "failed to set file(%s) quota id(%s) by recursively", dir, strID)
 }
+
+// GetQuotaReport returns the usage and limits of all the quota IDs on the
+// filesystem of mountpoint, which are read from the project quota report.
+// execution command: `repquota -Pn mountpoint`
+func (quota *PrjQuotaDriver) GetQuotaReport(mountPoint string) ([]*QuotaUsage, error) {
+	return getQuotaReport("-Pn", mountPoint)
+}
+
+// GetQuotaUsage returns the usage and limits of quota ID on all the
+// filesystems with project quota enabled, one entry per device.
+// execution command: `repquota -Pan`
+func (quota *PrjQuotaDriver) GetQuotaUsage(quotaID uint32) ([]*QuotaUsage, error) {
+	return getQuotaUsage(quotaID, "-Pan")
+}
+
+// ClearQuota removes the limits of quota ID on the filesystem of mountpoint,
+// and releases it from the allocated quota IDs.
+// execution command: `setquota -P qid 0 0 0 0 mountpoint`
+func (quota *PrjQuotaDriver) ClearQuota(quotaID uint32, mountPoint string) error {
+	quotaIDStr := strconv.FormatUint(uint64(quotaID), 10)
+	exit, stdout, stderr, err := exec.Run(0, "setquota", "-P", quotaIDStr, "0", "0", "0", "0", mountPoint)
+	if err != nil {
+		return errors.Wrapf(err, "failed to clear quota, mountpoint: (%s), quota id: (%d), stdout: (%s), stderr: (%s), exit: (%d)",
+			mountPoint, quotaID, stdout, stderr, exit)
+	}
+
+	quota.lock.Lock()
+	delete(quota.quotaIDs, quotaID)
+	quota.lock.Unlock()
+	releaseAllocatedQuotaID(quotaID)
+
+	return nil
+}
@@ -39,10 +39,11 @@ type BaseQuota interface {
 	// EnforceQuota is used to enforce disk quota effect on specified directory.
 	EnforceQuota(dir string) (*MountInfo, error)
 
-	// SetDiskQuota uses the following two parameters to set disk quota for a directory.
+	// SetDiskQuota uses the following parameters to set disk quota for a directory.
 	// * quota size: a byte size of requested quota.
+	// * inodes: the inode limit of requested quota, 0 means no limit.
 	// * quota ID: an ID represent quota attr which is used in the global scope.
-	SetDiskQuota(dir string, size string, quotaID uint32) error
+	SetDiskQuota(dir string, size string, inodes uint64, quotaID uint32) error
 
 	// CheckMountpoint is used to check mount point.
 	// It returns mointpoint, enable quota and filesystem type of the device.
@@ -61,6 +62,18 @@ type BaseQuota interface {
 
 	// SetFileAttrRecursive set the file attr by recursively.
 	SetFileAttrRecursive(dir string, quotaID uint32) error
+
+	// GetQuotaReport returns the usage and limits of all the quota IDs on
+	// the filesystem of mountpoint.
+	GetQuotaReport(mountPoint string) ([]*QuotaUsage, error)
+
+	// GetQuotaUsage returns the usage and limits of quota ID on all the
+	// filesystems with quota enabled, one entry per device.
+	GetQuotaUsage(quotaID uint32) ([]*QuotaUsage, error)
+
+	// ClearQuota removes the limits of quota ID on the filesystem of
+	// mountpoint, and releases it from the allocated quota IDs.
+	ClearQuota(quotaID uint32, mountPoint string) error
 }
 
 // NewQuotaDriver returns a quota instance.
@@ -98,12 +111,17 @@ func SetQuotaDriver(name string) {
 
 // SetDiskQuota is used to set quota for directory.
 func SetDiskQuota(dir string, size string, quotaID uint32) error {
-	log.With(nil).Infof("set disk quota, dir(%s), size(%s), quotaID(%d)", dir, size, quotaID)
+	return SetDiskQuotaWithInodes(dir, size, 0, quotaID)
+}
+
+// SetDiskQuotaWithInodes is used to set quota with inode limit for directory.
+func SetDiskQuotaWithInodes(dir string, size string, inodes uint64, quotaID uint32) error {
+	log.With(nil).Infof("set disk quota, dir(%s), size(%s), inodes(%d), quotaID(%d)", dir, size, inodes, quotaID)
 	if isRegular, err := CheckRegularFile(dir); err != nil || !isRegular {
 		log.With(nil).Debugf("set quota skip not regular file: %s", dir)
 		return err
 	}
-	return GQuotaDriver.SetDiskQuota(dir, size, quotaID)
+	return GQuotaDriver.SetDiskQuota(dir, size, inodes, quotaID)
 }
 
 // CheckMountpoint is used to check mount point.
@@ -136,8 +154,208 @@ func GetQuotaID(dir string) (uint32, error) {
 	return id, nil
 }
 
+// GetQuotaReport returns the usage and limits of all the quota IDs on the
+// filesystem of mountpoint.
+func GetQuotaReport(mountPoint string) ([]*QuotaUsage, error) {
+	return GQuotaDriver.GetQuotaReport(mountPoint)
+}
+
+// GetQuotaUsage returns the usage and limits of quota ID on all the
+// filesystems with quota enabled, one entry per device.
+func GetQuotaUsage(quotaID uint32) ([]*QuotaUsage, error) {
+	return GQuotaDriver.GetQuotaUsage(quotaID)
+}
+
+// ClearQuota removes the limits of quota ID on the filesystem of mountpoint.
+func ClearQuota(quotaID uint32, mountPoint string) error {
+	log.With(nil).Infof("clear quota, quotaID(%d), mountpoint(%s)", quotaID, mountPoint)
+	return GQuotaDriver.ClearQuota(quotaID, mountPoint)
+}
+
+// ReassignQuotaID sets the new quota ID on directory recursively, the limits
+// of the quota ID previously set on directory are copied to the new one.
+func ReassignQuotaID(dir string, quotaID uint32) error {
+	log.With(nil).Infof("reassign quota id, dir(%s), quotaID(%d)", dir, quotaID)
+
+	usage, err := GetDirQuotaUsage(dir)
+	if err != nil {
+		return err
+	}
+
+	if usage.HardLimit > 0 {
+		size := strconv.FormatUint(usage.HardLimit/1024, 10) + "k"
+		if err := GQuotaDriver.SetDiskQuota(dir, size, usage.InodesHardLimit, quotaID); err != nil {
+			return errors.Wrapf(err, "failed to set quota of dir(%s)", dir)
+		}
+	} else if err := GQuotaDriver.SetQuotaIDInFileAttr(dir, quotaID); err != nil {
+		return err
+	}
+
+	return SetFileAttrRecursive(dir, quotaID)
+}
+
+// GetQuotaUsedSize returns the used size(bytes) of the quota ID which is set
+// on directory, it is read from the quota report of the filesystem, so it
+// is much cheaper than walking the directory.
+func GetQuotaUsedSize(dir string) (uint64, error) {
+	usage, err := GetDirQuotaUsage(dir)
+	if err != nil {
+		return 0, err
+	}
+	return usage.Size, nil
+}
+
+// GetDirQuotaUsage returns the usage and limits of the quota ID which is set
+// on directory, only the filesystem of directory is reported.
+func GetDirQuotaUsage(dir string) (*QuotaUsage, error) {
+	quotaID := GetQuotaIDInFileAttr(dir)
+	if quotaID == 0 {
+		return nil, errors.Errorf("no quota id is set on directory(%s)", dir)
+	}
+
+	mountPoint, err := GetQuotaMountpoint(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := GetQuotaReport(mountPoint)
+	if err != nil {
+		return nil, err
+	}
+	if usages := filterQuotaUsage(report, quotaID); len(usages) > 0 {
+		return usages[0], nil
+	}
+	return nil, errors.Errorf("quota id(%d) is not found in quota report", quotaID)
+}
+
+// GetQuotaMountpoint returns the mountpoint of the filesystem which holds
+// directory, the quota should be enabled on the filesystem.
+func GetQuotaMountpoint(dir string) (string, error) {
+	devID, err := getDevID(dir)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get device id of directory(%s)", dir)
+	}
+	mountPoint, enableQuota, _ := CheckMountpoint(devID)
+	if !enableQuota {
+		return "", errors.Errorf("quota is not enabled on the device of directory(%s)", dir)
+	}
+	return mountPoint, nil
+}
+
+// getQuotaReport executes repquota with the options and parses the usage of
+// all the quota IDs from its output.
+func getQuotaReport(args ...string) ([]*QuotaUsage, error) {
+	exit, output, stderr, err := exec.Run(0, "repquota", args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to execute [repquota %s], stdout: (%s), stderr: (%s), exit: (%d)",
+			strings.Join(args, " "), output, stderr, exit)
+	}
+
+	return parseQuotaReport(output)
+}
+
+// getQuotaUsage executes repquota with the options and returns the usage of
+// quota ID on each device in the report.
+func getQuotaUsage(quotaID uint32, args ...string) ([]*QuotaUsage, error) {
+	report, err := getQuotaReport(args...)
+	if err != nil {
+		return nil, err
+	}
+	return filterQuotaUsage(report, quotaID), nil
+}
+
+// filterQuotaUsage returns the usage of quota ID in the report.
+func filterQuotaUsage(report []*QuotaUsage, quotaID uint32) []*QuotaUsage {
+	var usages []*QuotaUsage
+	for _, usage := range report {
+		if usage.QuotaID == quotaID {
+			usages = append(usages, usage)
+		}
+	}
+	return usages
+}
+
+// parseQuotaReport returns the usage of quota IDs in the output of repquota,
+// the blocks are reported in kilobytes. The usage of quota ID on each device
+// is reported separately, since the same quota ID may be used on different
+// filesystems with the same limits.
+//
+// *** Report for project quotas on device /dev/sdb1
+// #16777220 +- 2048576       0 2048575              9     0     0
+// #16777221 +-    4096    1024    8192  6days       9     0     0
+//
+// The grace time is only reported if the soft limit is exceeded.
+func parseQuotaReport(output string) ([]*QuotaUsage, error) {
+	var (
+		report []*QuotaUsage
+		device string
+	)
+
+	for _, line := range strings.Split(output, "\n") {
+		if strings.HasPrefix(line, "*** Report for") {
+			if idx := strings.LastIndex(line, " on device "); idx != -1 {
+				device = strings.TrimSpace(line[idx+len(" on device "):])
+			}
+			continue
+		}
+
+		parts := strings.Fields(line)
+		if len(parts) < 2 || len(parts[0]) <= 1 || parts[0][0] != '#' {
+			continue
+		}
+
+		id, err := strconv.ParseUint(parts[0][1:], 10, 32)
+		if err != nil {
+			continue
+		}
+		quotaID := uint32(id)
+
+		values, err := parseQuotaReportValues(parts[2:])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse quota report of quota id(%d): %s", quotaID, line)
+		}
+
+		report = append(report, &QuotaUsage{
+			QuotaID:         quotaID,
+			Device:          device,
+			Size:            values[0] * 1024,
+			SoftLimit:       values[1] * 1024,
+			HardLimit:       values[2] * 1024,
+			Inodes:          values[3],
+			InodesSoftLimit: values[4],
+			InodesHardLimit: values[5],
+		})
+	}
+
+	return report, nil
+}
+
+// parseQuotaReportValues parses the block used, soft, hard limits and the
+// inode used, soft, hard limits in fields, the grace time which is never a
+// plain number, such as "6days" or "none", is skipped.
+func parseQuotaReportValues(fields []string) ([]uint64, error) {
+	var values []uint64
+
+	for _, field := range fields {
+		v, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			if len(values) == 3 {
+				continue
+			}
+			return nil, err
+		}
+
+		values = append(values, v)
+		if len(values) == 6 {
+			return values, nil
+		}
+	}
+
+	return nil, errors.Errorf("expect 6 values, but got %d", len(values))
+}
+
 // SetRootfsDiskQuota is to set container rootfs dir disk quota.
-func SetRootfsDiskQuota(basefs, size string, quotaID uint32, update bool) (uint32, error) {
+func SetRootfsDiskQuota(basefs, size string, inodes uint64, quotaID uint32, update bool) (uint32, error) {
 	overlayMountInfo, err := getOverlayMountInfo(basefs)
 	if err != nil {
 		return 0, errors.Wrapf(err, "failed to get overlay(%s) mount info", basefs)
@@ -151,7 +369,7 @@ func SetRootfsDiskQuota(basefs, size string, quotaID uint32, update bool) (uint3
 			}
 		}
 
-		if err := SetDiskQuota(dir, size, quotaID); err != nil {
+		if err := SetDiskQuotaWithInodes(dir, size, inodes, quotaID); err != nil {
 			return 0, errors.Wrapf(err, "failed to set dir(%s) disk quota", dir)
 		}
 
@@ -3,7 +3,9 @@
 package quota
 
 import (
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/alibaba/pouch/pkg/system"
@@ -28,3 +30,84 @@ func Test_getDevID(t *testing.T) {
 		t.Fatalf("getDevID error expect %d got %d", expectID, gotID)
 	}
 }
+
+func TestParseQuotaReport(t *testing.T) {
+	output := `*** Report for project quotas on device /dev/sdb1
+Block grace time: 7days; Inode grace time: 7days
+                        Block limits                File limits
+Project         used    soft    hard  grace    used  soft  hard  grace
+----------------------------------------------------------------------
+#0        --  494472       0       0            938     0     0
+#16777220 +- 2048576       0 2048575              9     0     0
+#16777221 --      28       0 3048576              8     0  1000
+#16777222 ++    4096    1024    8192  6days      20    10    30  none
+
+*** Report for project quotas on device /dev/sdc1
+Block grace time: 7days; Inode grace time: 7days
+                        Block limits                File limits
+Project         used    soft    hard  grace    used  soft  hard  grace
+----------------------------------------------------------------------
+#16777221 --       4       0 1048576              2     0  1000
+`
+
+	report, err := parseQuotaReport(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the usage of the same quota id on different devices is kept apart.
+	expects := []QuotaUsage{
+		{QuotaID: 0, Device: "/dev/sdb1", Size: 494472 * 1024, Inodes: 938},
+		{QuotaID: 16777220, Device: "/dev/sdb1", Size: 2048576 * 1024, HardLimit: 2048575 * 1024, Inodes: 9},
+		{QuotaID: 16777221, Device: "/dev/sdb1", Size: 28 * 1024, HardLimit: 3048576 * 1024, Inodes: 8, InodesHardLimit: 1000},
+		{QuotaID: 16777222, Device: "/dev/sdb1", Size: 4096 * 1024, SoftLimit: 1024 * 1024, HardLimit: 8192 * 1024,
+			Inodes: 20, InodesSoftLimit: 10, InodesHardLimit: 30},
+		{QuotaID: 16777221, Device: "/dev/sdc1", Size: 4 * 1024, HardLimit: 1048576 * 1024, Inodes: 2, InodesHardLimit: 1000},
+	}
+	if len(report) != len(expects) {
+		t.Fatalf("expect %d usages in report, got %d", len(expects), len(report))
+	}
+	for i, expect := range expects {
+		if *report[i] != expect {
+			t.Fatalf("expect usage %+v, got %+v", expect, *report[i])
+		}
+	}
+
+	// one usage per device is returned for a quota id.
+	usages := filterQuotaUsage(report, 16777221)
+	if len(usages) != 2 || *usages[0] != expects[2] || *usages[1] != expects[4] {
+		t.Fatalf("expect usages of quota id 16777221 on two devices, got %d", len(usages))
+	}
+	if usages := filterQuotaUsage(report, 16777223); len(usages) != 0 {
+		t.Fatalf("expect no usage of quota id 16777223, got %d", len(usages))
+	}
+}
+
+func TestAllocatedQuotaIDs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "quota")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "allocated.json")
+	if err := SetAllocatedQuotaIDsFile(file); err != nil {
+		t.Fatal(err)
+	}
+	defer SetAllocatedQuotaIDsFile("")
+
+	recordAllocatedQuotaID(16777220)
+	recordAllocatedQuotaID(16777221)
+	releaseAllocatedQuotaID(16777221)
+
+	// the allocated quota ids are loaded from file.
+	if err := SetAllocatedQuotaIDsFile(file); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := AllocatedQuotaIDTime(16777220); !ok {
+		t.Fatal("expect quota id 16777220 allocated")
+	}
+	if _, ok := AllocatedQuotaIDTime(16777221); ok {
+		t.Fatal("expect quota id 16777221 released")
+	}
+}
@@ -1,11 +1,16 @@
 package quota
 
+// InodesKey is the key of disk quota to set the inode limit, the limit is
+// applied to all the directories having disk quota.
+const InodesKey = "inodes"
+
 // QMap defines the path set quota size and quota id.
 type QMap struct {
 	Source      string
 	Destination string
 	Expression  string
 	Size        string
+	Inodes      uint64
 	QuotaID     uint32
 }
 
@@ -23,3 +28,16 @@ type MountInfo struct {
 	FsType     string
 	DeviceID   uint64
 }
+
+// QuotaUsage defines the usage and limits of quota id on a device, the sizes
+// are in bytes.
+type QuotaUsage struct {
+	QuotaID         uint32
+	Device          string
+	Size            uint64
+	SoftLimit       uint64
+	HardLimit       uint64
+	Inodes          uint64
+	InodesSoftLimit uint64
+	InodesHardLimit uint64
+}
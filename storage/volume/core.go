@@ -5,13 +5,17 @@ import (
 	"fmt"
 	"path"
 	"reflect"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/alibaba/pouch/apis/filters"
+	"github.com/alibaba/pouch/pkg/bytefmt"
 	"github.com/alibaba/pouch/pkg/errtypes"
 	"github.com/alibaba/pouch/pkg/kmutex"
 	"github.com/alibaba/pouch/pkg/log"
 	metastore "github.com/alibaba/pouch/pkg/meta"
+	"github.com/alibaba/pouch/pkg/utils"
 	"github.com/alibaba/pouch/storage/volume/driver"
 	"github.com/alibaba/pouch/storage/volume/types"
 
@@ -23,6 +27,7 @@ type Core struct {
 	Config
 	store *metastore.Store
 	lock  *kmutex.KMutex
+	usage *usageCache
 }
 
 // NewCore returns Core struct instance with volume config.
@@ -30,6 +35,7 @@ func NewCore(cfg Config) (*Core, error) {
 	c := &Core{
 		Config: cfg,
 		lock:   kmutex.New(),
+		usage:  newUsageCache(),
 	}
 
 	// initialize volume driver alias.
@@ -98,14 +104,18 @@ func (c *Core) getVolume(ctx context.Context, id types.VolumeContext) (*types.Vo
 			return nil, err
 		}
 
-		// if the driver implements Getter interface.
+		// if the driver implements Getter interface, check the volume
+		// still exists in driver and refresh its mount path, the meta
+		// such as labels and references is kept.
 		if d, ok := dv.(driver.Getter); ok {
 			curV, err := d.Get(ctx, id.Name)
 			if err != nil {
 				return nil, errtypes.ErrVolumeNotFound
 			}
 
-			return curV, nil
+			if curV.Path() != "" {
+				v.SetPath(curV.Path())
+			}
 		}
 
 		return v, nil
@@ -184,11 +194,25 @@ func (c *Core) CreateVolume(ctx context.Context, id types.VolumeContext) (*types
 		return nil, err
 	}
 
+	// parse the io limits, which are applied to containers using volume.
+	limits := types.VolumeConfig{}
+	if err := types.ParseIOLimits(id.Options, &limits); err != nil {
+		return nil, errors.Wrap(errtypes.ErrInvalidParam, err.Error())
+	}
+
 	volume, err = dv.Create(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	if volume.Spec.VolumeConfig == nil {
+		volume.Spec.VolumeConfig = &types.VolumeConfig{}
+	}
+	volume.Spec.WriteBPS = limits.WriteBPS
+	volume.Spec.ReadBPS = limits.ReadBPS
+	volume.Spec.WriteIOPS = limits.WriteIOPS
+	volume.Spec.ReadIOPS = limits.ReadIOPS
+
 	// create the meta
 	if err := c.store.Put(volume); err != nil {
 		return nil, err
@@ -215,7 +239,10 @@ func (c *Core) ListVolumes(ctx context.Context, filter filters.Args) ([]*types.V
 		return nil, err
 	}
 
-	var realVolumes = map[string]*types.Volume{}
+	var (
+		realVolumes = map[string]*types.Volume{}
+		listed      = map[string]bool{}
+	)
 
 	for _, dv := range drivers {
 		d, ok := dv.(driver.Lister)
@@ -228,6 +255,7 @@ func (c *Core) ListVolumes(ctx context.Context, filter filters.Args) ([]*types.V
 			log.With(ctx).Warnf("volume driver %s list error: %v", dv.Name(ctx), err)
 			continue
 		}
+		listed[dv.Name(ctx)] = true
 
 		for _, v := range vList {
 			realVolumes[v.Name] = v
@@ -252,6 +280,20 @@ func (c *Core) ListVolumes(ctx context.Context, filter filters.Args) ([]*types.V
 
 		rv, ok := realVolumes[name]
 		if !ok {
+			// the global volume may be deleted on other host, or be
+			// missing from the list of plugin temporarily, so the meta is
+			// kept and the volume is reported as missing.
+			if d.StoreMode(ctx).CentralCreateDelete() && listed[v.Spec.Backend] {
+				log.With(ctx).Warnf("global volume %s not exist in driver %s", name, v.Spec.Backend)
+				if v.Status == nil {
+					v.Status = &types.VolumeStatus{}
+				}
+				v.Status.Phase = types.VolumePhaseUnknown
+				v.Status.Reason = types.VolumeReasonMissing
+				v.Status.Message = fmt.Sprintf("volume %s not found in driver %s", name, v.Spec.Backend)
+				retVolumes = append(retVolumes, v)
+			}
+
 			// real volume not exist, ignore it
 			continue
 		}
@@ -330,6 +372,7 @@ func (c *Core) RemoveVolume(ctx context.Context, id types.VolumeContext) error {
 	if err := dv.Remove(ctx, v); err != nil {
 		return err
 	}
+	c.usage.remove(id.Name)
 
 	return c.store.Remove(id.Name)
 }
@@ -347,6 +390,97 @@ func (c *Core) VolumePath(ctx context.Context, id types.VolumeContext) (string,
 	return c.volumePath(ctx, v, dv)
 }
 
+// VolumeUsage returns the used size(bytes) of volume, which is reported by
+// the volume driver if supported, otherwise it is calculated by walking the
+// volume path on host. A negative size means the usage is not available.
+func (c *Core) VolumeUsage(ctx context.Context, id types.VolumeContext) (int64, error) {
+	c.lock.Lock(id.Name)
+	v, dv, err := c.getVolumeDriver(ctx, id)
+	if err != nil {
+		c.lock.Unlock(id.Name)
+		return 0, errors.Wrap(err, fmt.Sprintf("Get volume: %s usage", id.String()))
+	}
+
+	if d, ok := dv.(driver.Usager); ok {
+		size, err := d.Usage(ctx, v)
+		if err == nil {
+			c.lock.Unlock(id.Name)
+			return size, nil
+		}
+		log.With(ctx).Debugf("volume driver %s does not report usage of volume %s: %v", v.Driver(), v.Name, err)
+	}
+
+	if !dv.StoreMode(ctx).IsLocal() {
+		c.lock.Unlock(id.Name)
+		return -1, nil
+	}
+
+	p, err := c.volumePath(ctx, v, dv)
+	c.lock.Unlock(id.Name)
+	if err != nil {
+		return 0, err
+	}
+
+	// walk the volume without lock, since it may take a long time.
+	return c.usage.get(ctx, id.Name, p)
+}
+
+// UpdateVolume changes the size and labels of volume. The size is changed
+// by driver which implements Resizer, the label with empty value is removed.
+func (c *Core) UpdateVolume(ctx context.Context, id types.VolumeContext, size string, labels map[string]string) (*types.Volume, error) {
+	c.lock.Lock(id.Name)
+	defer c.lock.Unlock(id.Name)
+
+	v, dv, err := c.getVolumeDriver(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if size != "" {
+		sizeInt, err := bytefmt.ToBytes(size)
+		if err != nil {
+			return nil, errors.Wrapf(errtypes.ErrInvalidParam, "invalid size %s: %v", size, err)
+		}
+
+		d, ok := dv.(driver.Resizer)
+		if !ok {
+			return nil, errors.Wrapf(errtypes.ErrNotImplemented, "volume driver %s does not support resize", v.Driver())
+		}
+
+		size = strconv.FormatUint(sizeInt, 10)
+		if err := d.Resize(ctx, v, size); err != nil {
+			return nil, errors.Wrapf(err, "failed to resize volume %s", v.Name)
+		}
+
+		// store the size in bytes, the same as creating volume does.
+		if v.Spec.VolumeConfig == nil {
+			v.Spec.VolumeConfig = &types.VolumeConfig{}
+		}
+		v.Spec.Size = size
+	}
+
+	if v.Labels == nil {
+		v.Labels = map[string]string{}
+	}
+	for key, value := range labels {
+		if value == "" {
+			delete(v.Labels, key)
+			continue
+		}
+		v.Labels[key] = value
+	}
+
+	now := time.Now()
+	v.ModifyTimestamp = &now
+
+	// update meta info.
+	if err := c.store.Put(v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
 // AttachVolume to enable a volume on local host.
 func (c *Core) AttachVolume(ctx context.Context, id types.VolumeContext, extra map[string]string) (*types.Volume, error) {
 	c.lock.Lock(id.Name)
@@ -357,12 +491,47 @@ func (c *Core) AttachVolume(ctx context.Context, id types.VolumeContext, extra m
 		return nil, err
 	}
 
+	refs := v.References()
+
 	// merge extra to volume spec extra.
 	for key, value := range extra {
 		v.Spec.Extra[key] = value
 	}
+	// persist the reference count, drivers which implement AttachDetach
+	// are attached on the first reference and detached on the last one.
+	v.SetOption(types.OptionRefCount, strconv.Itoa(len(v.References())))
+
+	if d, ok := dv.(driver.MountUnmount); ok {
+		mountIDs := splitIDs(v.Option(types.OptionMountIDs))
+
+		var mid string
+		switch {
+		case id.MountID == "":
+			mid = string(v.UID)
+		case utils.StringInSlice(mountIDs, id.MountID):
+			// the volume has been mounted for the caller, mount it again
+			// since the plugin may lose the mount after it or the host
+			// restarts, and the mount path in reply is the current one.
+			mid = id.MountID
+		case utils.StringInSlice(refs, id.MountID):
+			// the caller referenced volume before the volume is mounted
+			// for each caller, keep mounting it with uid of volume.
+			mid = string(v.UID)
+		default:
+			mid = id.MountID
+			v.SetOption(types.OptionMountIDs, strings.Join(append(mountIDs, mid), ","))
+		}
 
-	if d, ok := dv.(driver.AttachDetach); ok {
+		if mid != "" {
+			mountPath, err := d.Mount(ctx, v, mid)
+			if err != nil {
+				return nil, err
+			}
+			if mountPath != "" {
+				v.SetPath(mountPath)
+			}
+		}
+	} else if d, ok := dv.(driver.AttachDetach); ok {
 		if err := d.Attach(ctx, v); err != nil {
 			return nil, err
 		}
@@ -386,14 +555,42 @@ func (c *Core) DetachVolume(ctx context.Context, id types.VolumeContext, extra m
 		return nil, err
 	}
 
+	refs := v.References()
+
 	// merge extra to volume spec extra.
 	for key, value := range extra {
 		v.Spec.Extra[key] = value
 	}
+	// persist the reference count.
+	v.SetOption(types.OptionRefCount, strconv.Itoa(len(v.References())))
 
-	// if volume has referance, skip to detach volume.
+	// drivers which implement MountUnmount are unmounted for each caller,
+	// otherwise if volume has referance, skip to detach volume.
 	ref := v.Option(types.OptionRef)
-	if d, ok := dv.(driver.AttachDetach); ok && ref == "" {
+	if d, ok := dv.(driver.MountUnmount); ok {
+		mountIDs := splitIDs(v.Option(types.OptionMountIDs))
+
+		var mid string
+		switch {
+		case id.MountID == "":
+			mid = string(v.UID)
+		case utils.StringInSlice(mountIDs, id.MountID):
+			mid = id.MountID
+			v.SetOption(types.OptionMountIDs, strings.Join(removeID(mountIDs, id.MountID), ","))
+		case utils.StringInSlice(refs, id.MountID):
+			// the volume mounted with uid before is unmounted when the
+			// last caller referencing it that way detaches.
+			if len(legacyReferences(v.References(), mountIDs)) == 0 {
+				mid = string(v.UID)
+			}
+		}
+
+		if mid != "" {
+			if err := d.Unmount(ctx, v, mid); err != nil {
+				return nil, err
+			}
+		}
+	} else if d, ok := dv.(driver.AttachDetach); ok && ref == "" {
 		if err := d.Detach(ctx, v); err != nil {
 			return nil, err
 		}
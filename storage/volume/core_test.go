@@ -7,6 +7,7 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/alibaba/pouch/apis/filters"
@@ -462,3 +463,466 @@ func TestDetachVolume(t *testing.T) {
 		t.Fatal("expect get driver not found error, but err is nil")
 	}
 }
+
+func TestVolumeRefCount(t *testing.T) {
+	driverName := "fake_driver_refcount"
+	volid := types.VolumeContext{Name: "vol-refcount", Driver: driverName}
+
+	dir, err := ioutil.TempDir("", "TestVolumeRefCount")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	core, err := createVolumeCore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	driver.Register(driver.NewFakeDriver(driverName))
+	defer driver.Unregister(driverName)
+
+	ctx := context.Background()
+	if _, err := core.CreateVolume(ctx, volid); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		detach bool
+		ref    string
+		count  string
+	}{
+		{ref: "c1", count: "1"},
+		{ref: "c1,c2", count: "2"},
+		{detach: true, ref: "c2", count: "1"},
+		{detach: true, ref: "", count: "0"},
+	} {
+		extra := map[string]string{types.OptionRef: c.ref}
+		if c.detach {
+			_, err = core.DetachVolume(ctx, volid, extra)
+		} else {
+			_, err = core.AttachVolume(ctx, volid, extra)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		v, err := core.GetVolume(ctx, volid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := v.Option(types.OptionRefCount); got != c.count {
+			t.Fatalf("expect refcount %s with ref %q, but got %s", c.count, c.ref, got)
+		}
+	}
+}
+
+type fakeResizeDriver struct {
+	driver.Driver
+	size string
+}
+
+func (f *fakeResizeDriver) Resize(ctx context.Context, v *types.Volume, size string) error {
+	f.size = size
+	return nil
+}
+
+func TestUpdateVolume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestUpdateVolume")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	core, err := createVolumeCore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	// driver does not support resize.
+	driverName := "fake_driver_update"
+	driver.Register(driver.NewFakeDriver(driverName))
+	defer driver.Unregister(driverName)
+
+	volid := types.VolumeContext{Name: "vol-update", Driver: driverName, Labels: map[string]string{"a": "1", "b": "2"}}
+	if _, err := core.CreateVolume(ctx, volid); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := core.UpdateVolume(ctx, volid, "1g", nil); !errtypes.IsNotImplemented(err) {
+		t.Fatalf("expect not implemented error, but got %v", err)
+	}
+
+	v, err := core.UpdateVolume(ctx, volid, "", map[string]string{"a": "", "c": "3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(v.Labels) != 2 || v.Labels["b"] != "2" || v.Labels["c"] != "3" {
+		t.Fatalf("unexpected labels after update: %v", v.Labels)
+	}
+
+	// driver supports resize.
+	resizeDriverName := "fake_driver_resize"
+	resizer := &fakeResizeDriver{Driver: driver.NewFakeDriver(resizeDriverName)}
+	driver.Register(resizer)
+	defer driver.Unregister(resizeDriverName)
+
+	volid = types.VolumeContext{Name: "vol-resize", Driver: resizeDriverName}
+	if _, err := core.CreateVolume(ctx, volid); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := core.UpdateVolume(ctx, volid, "size", nil); !errtypes.IsInvalidParam(err) {
+		t.Fatalf("expect invalid param error, but got %v", err)
+	}
+
+	if _, err := core.UpdateVolume(ctx, volid, "1k", nil); err != nil {
+		t.Fatal(err)
+	}
+	if resizer.size != "1024" {
+		t.Fatalf("expect resize to 1024, but got %s", resizer.size)
+	}
+
+	v, err = core.GetVolume(ctx, volid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Size() != "1024" {
+		t.Fatalf("expect size 1024 in meta, but got %s", v.Size())
+	}
+}
+
+type fakeUsageDriver struct {
+	driver.Driver
+	root  string
+	usage int64
+	err   error
+}
+
+func (f *fakeUsageDriver) Path(ctx context.Context, v *types.Volume) (string, error) {
+	return path.Join(f.root, v.Name), nil
+}
+
+func (f *fakeUsageDriver) Usage(ctx context.Context, v *types.Volume) (int64, error) {
+	return f.usage, f.err
+}
+
+func TestVolumeUsage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestVolumeUsage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	core, err := createVolumeCore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	driverName := "fake_driver_usage"
+	usager := &fakeUsageDriver{Driver: driver.NewFakeDriver(driverName), root: dir, usage: 4096}
+	driver.Register(usager)
+	defer driver.Unregister(driverName)
+
+	volid := types.VolumeContext{Name: "vol-usage", Driver: driverName}
+	if _, err := core.CreateVolume(ctx, volid); err != nil {
+		t.Fatal(err)
+	}
+
+	// usage is reported by driver.
+	size, err := core.VolumeUsage(ctx, volid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size != 4096 {
+		t.Fatalf("expect usage 4096 reported by driver, but got %d", size)
+	}
+
+	// usage is calculated by walking the volume, hard links are counted once.
+	volPath := path.Join(dir, volid.Name)
+	if err := os.MkdirAll(path.Join(volPath, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path.Join(volPath, "file1"), make([]byte, 100), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path.Join(volPath, "sub", "file2"), make([]byte, 200), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Link(path.Join(volPath, "file1"), path.Join(volPath, "sub", "link1")); err != nil {
+		t.Fatal(err)
+	}
+
+	usager.err = fmt.Errorf("not reported")
+	size, err = core.VolumeUsage(ctx, volid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size != 300 {
+		t.Fatalf("expect usage 300 by walking volume, but got %d", size)
+	}
+
+	// the walked usage is cached.
+	if err := ioutil.WriteFile(path.Join(volPath, "file3"), make([]byte, 100), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if size, _ = core.VolumeUsage(ctx, volid); size != 300 {
+		t.Fatalf("expect cached usage 300, but got %d", size)
+	}
+
+	if err := core.RemoveVolume(ctx, volid); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := core.usage.entries[volid.Name]; ok {
+		t.Fatal("expect cached usage is removed with volume")
+	}
+}
+
+type fakeMountDriver struct {
+	driver.Driver
+	mounted   []string
+	unmounted []string
+	// root is the directory of mount path replied, default is /plugin.
+	root string
+}
+
+func (f *fakeMountDriver) Mount(ctx context.Context, v *types.Volume, id string) (string, error) {
+	f.mounted = append(f.mounted, id)
+	if f.root != "" {
+		return f.root + "/" + v.Name, nil
+	}
+	return "/plugin/" + v.Name, nil
+}
+
+func (f *fakeMountDriver) Unmount(ctx context.Context, v *types.Volume, id string) error {
+	f.unmounted = append(f.unmounted, id)
+	return nil
+}
+
+func TestVolumeMountID(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestVolumeMountID")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	core, err := createVolumeCore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	driverName := "fake_driver_mount"
+	mounter := &fakeMountDriver{Driver: driver.NewFakeDriver(driverName)}
+	driver.Register(mounter)
+	defer driver.Unregister(driverName)
+
+	ctx := context.Background()
+	volid := types.VolumeContext{Name: "vol-mount", Driver: driverName}
+	if _, err := core.CreateVolume(ctx, volid); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		detach  bool
+		mountID string
+		ref     string
+	}{
+		{mountID: "c1", ref: "c1"},
+		{mountID: "c2", ref: "c1,c2"},
+		// the volume is mounted for c2 again.
+		{mountID: "c2", ref: "c1,c2"},
+		{detach: true, mountID: "c1", ref: "c2"},
+		{detach: true, mountID: "c2", ref: ""},
+	} {
+		id := volid
+		id.MountID = c.mountID
+		extra := map[string]string{types.OptionRef: c.ref}
+		if c.detach {
+			_, err = core.DetachVolume(ctx, id, extra)
+		} else {
+			_, err = core.AttachVolume(ctx, id, extra)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got := strings.Join(mounter.mounted, ","); got != "c1,c2,c2" {
+		t.Fatalf("expect volume mounted for c1,c2,c2, but got %s", got)
+	}
+	if got := strings.Join(mounter.unmounted, ","); got != "c1,c2" {
+		t.Fatalf("expect volume unmounted for c1,c2, but got %s", got)
+	}
+
+	v, err := core.GetVolume(ctx, volid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Path() != "/plugin/vol-mount" {
+		t.Fatalf("expect mount path /plugin/vol-mount, but got %s", v.Path())
+	}
+}
+
+func TestVolumeRemount(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestVolumeRemount")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	core, err := createVolumeCore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	driverName := "fake_driver_remount"
+	mounter := &fakeMountDriver{Driver: driver.NewFakeDriver(driverName)}
+	driver.Register(mounter)
+	defer driver.Unregister(driverName)
+
+	ctx := context.Background()
+	volid := types.VolumeContext{Name: "vol-remount", Driver: driverName}
+	if _, err := core.CreateVolume(ctx, volid); err != nil {
+		t.Fatal(err)
+	}
+
+	id := volid
+	id.MountID = "c1"
+	extra := map[string]string{types.OptionRef: "c1"}
+	if _, err := core.AttachVolume(ctx, id, extra); err != nil {
+		t.Fatal(err)
+	}
+
+	// the plugin restarts and mounts the volume at another path.
+	mounter.root = "/plugin-restarted"
+	v, err := core.AttachVolume(ctx, id, extra)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := strings.Join(mounter.mounted, ","); got != "c1,c1" {
+		t.Fatalf("expect volume mounted for c1 twice, but got %s", got)
+	}
+	if v.Path() != "/plugin-restarted/vol-remount" {
+		t.Fatalf("expect mount path /plugin-restarted/vol-remount, but got %s", v.Path())
+	}
+	if got := v.Option(types.OptionMountIDs); got != "c1" {
+		t.Fatalf("expect mount ids c1, but got %s", got)
+	}
+}
+
+func TestVolumeLegacyMount(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestVolumeLegacyMount")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	core, err := createVolumeCore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	driverName := "fake_driver_legacy_mount"
+	mounter := &fakeMountDriver{Driver: driver.NewFakeDriver(driverName)}
+	driver.Register(mounter)
+	defer driver.Unregister(driverName)
+
+	ctx := context.Background()
+	volid := types.VolumeContext{Name: "vol-legacy", Driver: driverName}
+	v, err := core.CreateVolume(ctx, volid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// l1 and l2 referenced the volume mounted with its uid.
+	v.SetOption(types.OptionRef, "l1,l2")
+	if err := core.store.Put(v); err != nil {
+		t.Fatal(err)
+	}
+	uid := string(v.UID)
+
+	for _, c := range []struct {
+		detach  bool
+		mountID string
+		ref     string
+	}{
+		{mountID: "l1", ref: "l1,l2"},
+		{mountID: "c1", ref: "l1,l2,c1"},
+		{detach: true, mountID: "l1", ref: "l2,c1"},
+		{detach: true, mountID: "c1", ref: "l2"},
+		{detach: true, mountID: "l2", ref: ""},
+	} {
+		id := volid
+		id.MountID = c.mountID
+		extra := map[string]string{types.OptionRef: c.ref}
+		if c.detach {
+			_, err = core.DetachVolume(ctx, id, extra)
+		} else {
+			_, err = core.AttachVolume(ctx, id, extra)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got := strings.Join(mounter.mounted, ","); got != uid+",c1" {
+		t.Fatalf("expect volume mounted for %s,c1, but got %s", uid, got)
+	}
+	if got := strings.Join(mounter.unmounted, ","); got != "c1,"+uid {
+		t.Fatalf("expect volume unmounted for c1,%s, but got %s", uid, got)
+	}
+}
+
+type fakeGlobalDriver struct {
+	driver.Driver
+}
+
+func (f *fakeGlobalDriver) StoreMode(ctx context.Context) driver.VolumeStoreMode {
+	return driver.RemoteStore | driver.CreateDeleteInCentral
+}
+
+func (f *fakeGlobalDriver) List(ctx context.Context) ([]*types.Volume, error) {
+	return nil, nil
+}
+
+func TestListMissingGlobalVolume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestListMissingGlobalVolume")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	core, err := createVolumeCore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	driverName := "fake_driver_global"
+	driver.Register(&fakeGlobalDriver{Driver: driver.NewFakeDriver(driverName)})
+	defer driver.Unregister(driverName)
+
+	ctx := context.Background()
+	volid := types.VolumeContext{Name: "vol-global", Driver: driverName}
+	if _, err := core.CreateVolume(ctx, volid); err != nil {
+		t.Fatal(err)
+	}
+
+	// the volume not listed by driver is reported as missing, and its
+	// meta is kept.
+	for i := 0; i < 2; i++ {
+		volumes, err := core.ListVolumes(ctx, filters.NewArgs())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(volumes) != 1 || volumes[0].Name != "vol-global" {
+			t.Fatalf("expect volume vol-global listed, but got %v", volumes)
+		}
+		if volumes[0].Status.Reason != types.VolumeReasonMissing {
+			t.Fatalf("expect volume reported as missing, but got %s", volumes[0].Status.Reason)
+		}
+	}
+}
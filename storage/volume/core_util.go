@@ -3,7 +3,9 @@ package volume
 import (
 	"context"
 	"path"
+	"strings"
 
+	"github.com/alibaba/pouch/pkg/utils"
 	"github.com/alibaba/pouch/storage/volume/driver"
 	"github.com/alibaba/pouch/storage/volume/types"
 
@@ -21,3 +23,35 @@ func (c *Core) volumePath(ctx context.Context, v *types.Volume, dv driver.Driver
 
 	return p, nil
 }
+
+// splitIDs splits the comma separated ids.
+func splitIDs(ids string) []string {
+	if ids == "" {
+		return nil
+	}
+	return strings.Split(ids, ",")
+}
+
+// removeID returns the ids without id.
+func removeID(ids []string, id string) []string {
+	var res []string
+	for _, i := range ids {
+		if i != id {
+			res = append(res, i)
+		}
+	}
+	return res
+}
+
+// legacyReferences returns the references for which the volume is not
+// mounted with their own ids, they are left by the versions mounting
+// volume with uid of volume.
+func legacyReferences(refs, mountIDs []string) []string {
+	var res []string
+	for _, ref := range refs {
+		if !utils.StringInSlice(mountIDs, ref) {
+			res = append(res, ref)
+		}
+	}
+	return res
+}
@@ -34,6 +34,14 @@ const (
 	UseLocalMetaStore VolumeStoreMode = 8
 )
 
+const (
+	// LocalScope defines the volumes of driver are only visible on local host.
+	LocalScope = "local"
+
+	// GlobalScope defines the volumes of driver are visible to all hosts.
+	GlobalScope = "global"
+)
+
 // VolumeStoreMode defines volume store mode type.
 type VolumeStoreMode int
 
@@ -81,6 +89,15 @@ func (m VolumeStoreMode) UseLocalMeta() bool {
 	return (m & UseLocalMetaStore) != 0
 }
 
+// Scope returns the scope of volume driver, the volumes of driver which are
+// created and deleted in central are visible to all hosts.
+func Scope(ctx context.Context, d Driver) string {
+	if d.StoreMode(ctx).CentralCreateDelete() {
+		return GlobalScope
+	}
+	return LocalScope
+}
+
 // driverTable contains all volume drivers
 type driverTable struct {
 	sync.Mutex
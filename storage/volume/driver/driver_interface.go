@@ -45,6 +45,30 @@ type AttachDetach interface {
 	Detach(context.Context, *types.Volume) error
 }
 
+// MountUnmount represents volume mount/unmount interface, the driver mounts
+// volume for each caller, such as container, with the id of caller.
+type MountUnmount interface {
+	// Mount a volume for the caller with id, returns the mount path.
+	Mount(context.Context, *types.Volume, string) (string, error)
+
+	// Unmount a volume for the caller with id.
+	Unmount(context.Context, *types.Volume, string) error
+}
+
+// Resizer represents volume resize interface.
+type Resizer interface {
+	// Resize changes the size(bytes) of volume online.
+	Resize(context.Context, *types.Volume, string) error
+}
+
+// Usager represents volume usage interface.
+type Usager interface {
+	// Usage returns the used size(bytes) of volume, a negative size means
+	// the usage is not available. The error means the driver can not report
+	// the usage cheaply, the caller may calculate it by walking the volume.
+	Usage(context.Context, *types.Volume) (int64, error)
+}
+
 // Formator represents volume format interface.
 type Formator interface {
 	// Format a volume.
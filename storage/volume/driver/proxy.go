@@ -177,7 +177,9 @@ func (proxy *remoteDriverProxy) Capabilities() (*remoteVolumeCapability, error)
 	var req remoteVolumeCapabilitiesReq
 	var resp remoteVolumeCapabilitiesResp
 
-	if err := proxy.client.CallService(remoteVolumeCapabilitiesService, &req, &resp, true); err != nil {
+	// request without retry, the driver requests it again later if the
+	// plugin is not ready.
+	if err := proxy.client.CallService(remoteVolumeCapabilitiesService, &req, &resp, false); err != nil {
 		return nil, err
 	}
 
@@ -2,6 +2,9 @@ package driver
 
 import (
 	"context"
+	"net/http"
+	"sync"
+	"time"
 
 	"github.com/alibaba/pouch/pkg/log"
 	"github.com/alibaba/pouch/pkg/utils"
@@ -9,21 +12,34 @@ import (
 	"github.com/alibaba/pouch/storage/volume/types"
 )
 
+// scopeRetryInterval is the interval to request the capabilities of plugin
+// again after the request fails.
+const scopeRetryInterval = 30 * time.Second
+
 // remoteDriverWrapper represents a volume driver.
 type remoteDriverWrapper struct {
 	driverName string
 	proxy      *remoteDriverProxy
+
+	// scope is the scope reported by plugin capabilities, which is resolved
+	// when the driver is created. If the plugin is not ready, it is
+	// requested again after scopeRetryAt until the plugin replies.
+	scope        string
+	scopeRetryAt time.Time
+	scopeLock    sync.Mutex
 }
 
 // NewRemoteDriverWrapper returns a remote driver
 func NewRemoteDriverWrapper(name string, plugin *plugins.Plugin) Driver {
-	return &remoteDriverWrapper{
+	r := &remoteDriverWrapper{
 		driverName: name,
 		proxy: &remoteDriverProxy{
 			Name:   name,
 			client: plugin.Client(),
 		},
 	}
+	r.getScope(context.Background())
+	return r
 }
 
 // Name returns the volume driver's name.
@@ -31,11 +47,51 @@ func (r *remoteDriverWrapper) Name(ctx context.Context) string {
 	return r.driverName
 }
 
-// StoreMode returns the volume driver's store mode.
+// StoreMode returns the volume driver's store mode, the volumes of plugin
+// with global scope are created and deleted in central.
 func (r *remoteDriverWrapper) StoreMode(ctx context.Context) VolumeStoreMode {
+	if r.getScope(ctx) == GlobalScope {
+		return RemoteStore | CreateDeleteInCentral
+	}
 	return RemoteStore | UseLocalMetaStore
 }
 
+// getScope returns the scope of plugin, local scope is used if plugin does
+// not implement capabilities. The scope is cached once plugin replies, so a
+// plugin of global scope is never treated as local after that.
+func (r *remoteDriverWrapper) getScope(ctx context.Context) string {
+	r.scopeLock.Lock()
+	defer r.scopeLock.Unlock()
+
+	if r.scope != "" {
+		return r.scope
+	}
+	if time.Now().Before(r.scopeRetryAt) {
+		return LocalScope
+	}
+
+	capability, err := r.proxy.Capabilities()
+	if err != nil {
+		// the plugin replies not found if it does not implement
+		// capabilities, otherwise request it again after a while.
+		if e, ok := err.(*plugins.ErrPluginStatus); ok && e.StatusCode == http.StatusNotFound {
+			r.scope = LocalScope
+			return r.scope
+		}
+
+		r.scopeRetryAt = time.Now().Add(scopeRetryInterval)
+		log.With(ctx).Warnf("driver wrapper [%s] failed to get capabilities, use local scope until %s: %v",
+			r.driverName, r.scopeRetryAt.Format(time.RFC3339), err)
+		return LocalScope
+	}
+
+	r.scope = LocalScope
+	if capability != nil && capability.Scope == GlobalScope {
+		r.scope = GlobalScope
+	}
+	return r.scope
+}
+
 // Create a remote volume.
 func (r *remoteDriverWrapper) Create(ctx context.Context, id types.VolumeContext) (*types.Volume, error) {
 	log.With(ctx).Debugf("driver wrapper [%s] creates volume: %s", r.Name(ctx), id.Name)
@@ -113,17 +169,16 @@ func (r *remoteDriverWrapper) Options() map[string]types.Option {
 	return map[string]types.Option{}
 }
 
-// Attach a remote volume.
-func (r *remoteDriverWrapper) Attach(ctx context.Context, v *types.Volume) error {
-	log.With(ctx).Debugf("driver wrapper [%s] attach volume: %s", r.Name(ctx), v.Name)
+// Mount a remote volume for the caller with id.
+func (r *remoteDriverWrapper) Mount(ctx context.Context, v *types.Volume, id string) (string, error) {
+	log.With(ctx).Debugf("driver wrapper [%s] mount volume: %s, id: %s", r.Name(ctx), v.Name, id)
 
-	_, err := r.proxy.Mount(v.Name, v.UID)
-	return err
+	return r.proxy.Mount(v.Name, id)
 }
 
-// Detach a remote volume.
-func (r *remoteDriverWrapper) Detach(ctx context.Context, v *types.Volume) error {
-	log.With(ctx).Debugf("driver wrapper [%s] detach volume: %s", r.Name(ctx), v.Name)
+// Unmount a remote volume for the caller with id.
+func (r *remoteDriverWrapper) Unmount(ctx context.Context, v *types.Volume, id string) error {
+	log.With(ctx).Debugf("driver wrapper [%s] unmount volume: %s, id: %s", r.Name(ctx), v.Name, id)
 
-	return r.proxy.Unmount(v.Name, v.UID)
+	return r.proxy.Unmount(v.Name, id)
 }
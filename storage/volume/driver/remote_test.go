@@ -0,0 +1,216 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alibaba/pouch/storage/plugins"
+	"github.com/alibaba/pouch/storage/volume/types"
+)
+
+// newTestRemoteDriver returns a remote driver connecting to the fake plugin
+// serving on unix socket.
+func newTestRemoteDriver(t *testing.T, mux *http.ServeMux) (*remoteDriverWrapper, func()) {
+	dir, err := ioutil.TempDir("", "TestRemoteDriver")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sock := filepath.Join(dir, "plugin.sock")
+	l, err := net.Listen("unix", sock)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+
+	server := httptest.NewUnstartedServer(mux)
+	server.Listener = l
+	server.Start()
+
+	cleanup := func() {
+		server.Close()
+		os.RemoveAll(dir)
+	}
+
+	client, err := plugins.NewPluginClient("unix://"+sock, nil)
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+
+	return &remoteDriverWrapper{
+		driverName: "remote",
+		proxy: &remoteDriverProxy{
+			Name:   "remote",
+			client: client,
+		},
+	}, cleanup
+}
+
+func TestRemoteDriverScope(t *testing.T) {
+	ctx := context.Background()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(remoteVolumeCapabilitiesService, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.docker.plugins.v1+json")
+		fmt.Fprintln(w, `{"Capabilities": {"Scope": "global"}}`)
+	})
+
+	d, cleanup := newTestRemoteDriver(t, mux)
+	defer cleanup()
+
+	if scope := Scope(ctx, d); scope != GlobalScope {
+		t.Fatalf("expected scope %s, but got %s", GlobalScope, scope)
+	}
+	if !d.StoreMode(ctx).CentralCreateDelete() {
+		t.Fatal("expected volumes of global driver are created and deleted in central")
+	}
+	if !d.StoreMode(ctx).Valid() {
+		t.Fatalf("expected valid store mode, but got %v", d.StoreMode(ctx))
+	}
+
+	// the plugin without capabilities is local scope.
+	d, cleanup = newTestRemoteDriver(t, http.NewServeMux())
+	defer cleanup()
+
+	if scope := Scope(ctx, d); scope != LocalScope {
+		t.Fatalf("expected scope %s, but got %s", LocalScope, scope)
+	}
+	if !d.StoreMode(ctx).UseLocalMeta() {
+		t.Fatal("expected local driver to use local meta store")
+	}
+}
+
+func TestRemoteDriverScopeRetry(t *testing.T) {
+	ctx := context.Background()
+
+	ready := false
+	mux := http.NewServeMux()
+	mux.HandleFunc(remoteVolumeCapabilitiesService, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.docker.plugins.v1+json")
+		if !ready {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprintln(w, `{"Capabilities": {"Scope": "global"}}`)
+	})
+
+	d, cleanup := newTestRemoteDriver(t, mux)
+	defer cleanup()
+
+	// the scope is not cached if plugin fails to reply.
+	if scope := Scope(ctx, d); scope != LocalScope {
+		t.Fatalf("expected scope %s, but got %s", LocalScope, scope)
+	}
+
+	// the capabilities is requested again after the retry interval.
+	ready = true
+	if scope := Scope(ctx, d); scope != LocalScope {
+		t.Fatalf("expected scope %s before retry, but got %s", LocalScope, scope)
+	}
+	d.scopeRetryAt = time.Time{}
+	if scope := Scope(ctx, d); scope != GlobalScope {
+		t.Fatalf("expected scope %s, but got %s", GlobalScope, scope)
+	}
+}
+
+func TestRemoteDriverMountUnmount(t *testing.T) {
+	ctx := context.Background()
+
+	var mounted, unmounted []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(remoteVolumeMountService, func(w http.ResponseWriter, r *http.Request) {
+		var req remoteVolumeMountReq
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), 500)
+			return
+		}
+		mounted = append(mounted, req.Name+"/"+req.ID)
+
+		w.Header().Set("Content-Type", "application/vnd.docker.plugins.v1+json")
+		fmt.Fprintln(w, `{"Mountpoint": "/plugin/volume"}`)
+	})
+	mux.HandleFunc(remoteVolumeUnmountService, func(w http.ResponseWriter, r *http.Request) {
+		var req remoteVolumeUnmountReq
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), 500)
+			return
+		}
+		unmounted = append(unmounted, req.Name+"/"+req.ID)
+
+		w.Header().Set("Content-Type", "application/vnd.docker.plugins.v1+json")
+		fmt.Fprintln(w, `{}`)
+	})
+
+	d, cleanup := newTestRemoteDriver(t, mux)
+	defer cleanup()
+
+	v := types.NewVolumeFromContext("", "", types.NewVolumeContext("volume", "remote", nil, nil))
+
+	mountPath, err := d.Mount(ctx, v, "c1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mountPath != "/plugin/volume" {
+		t.Fatalf("expected mount path /plugin/volume, but got %s", mountPath)
+	}
+	if _, err := d.Mount(ctx, v, "c2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Unmount(ctx, v, "c1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if fmt.Sprint(mounted) != "[volume/c1 volume/c2]" {
+		t.Fatalf("unexpected mount requests: %v", mounted)
+	}
+	if fmt.Sprint(unmounted) != "[volume/c1]" {
+		t.Fatalf("unexpected unmount requests: %v", unmounted)
+	}
+}
+
+func TestRemoteDriverListGet(t *testing.T) {
+	ctx := context.Background()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(remoteVolumeListService, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.docker.plugins.v1+json")
+		fmt.Fprintln(w, `{"Volumes": [{"Name": "v1", "Mountpoint": "/plugin/v1"}, {"Name": "v2"}]}`)
+	})
+	mux.HandleFunc(remoteVolumeGetService, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.docker.plugins.v1+json")
+		fmt.Fprintln(w, `{"Volume": {"Name": "v1", "Mountpoint": "/plugin/v1", "Status": {"size": "10G"}}}`)
+	})
+
+	d, cleanup := newTestRemoteDriver(t, mux)
+	defer cleanup()
+
+	vList, err := d.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vList) != 2 {
+		t.Fatalf("expected 2 volumes, but got %d", len(vList))
+	}
+	if vList[0].Name != "v1" || vList[0].Path() != "/plugin/v1" || vList[0].Driver() != "remote" {
+		t.Fatalf("unexpected volume: %s %s %s", vList[0].Name, vList[0].Path(), vList[0].Driver())
+	}
+
+	v, err := d.Get(ctx, "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Name != "v1" || v.Path() != "/plugin/v1" {
+		t.Fatalf("unexpected volume: %s %s", v.Name, v.Path())
+	}
+}
@@ -165,3 +165,39 @@ func (p *Local) Detach(ctx context.Context, v *types.Volume) error {
 
 	return nil
 }
+
+// Resize changes the disk quota of local volume.
+func (p *Local) Resize(ctx context.Context, v *types.Volume, size string) error {
+	log.With(ctx).Debugf("Local resize volume: %s, size: %s", v.Name, size)
+	mountPath := v.Path()
+
+	if st, err := os.Stat(mountPath); err != nil {
+		return err
+	} else if !st.IsDir() {
+		return fmt.Errorf("mount path is not a dir %s", mountPath)
+	}
+
+	allocated := quota.GetQuotaIDInFileAttr(mountPath) == 0
+	if err := quota.SetDiskQuota(mountPath, size, 0); err != nil {
+		return err
+	}
+
+	// the new quota id is only set on the volume directory, set it on the
+	// existing data to count them in the limit.
+	if allocated {
+		if quotaID := quota.GetQuotaIDInFileAttr(mountPath); quotaID != 0 {
+			return quota.SetFileAttrRecursive(mountPath, quotaID)
+		}
+	}
+	return nil
+}
+
+// Usage returns the used size of local volume, which is read from the disk
+// quota set on the volume.
+func (p *Local) Usage(ctx context.Context, v *types.Volume) (int64, error) {
+	size, err := quota.GetQuotaUsedSize(v.Path())
+	if err != nil {
+		return 0, err
+	}
+	return int64(size), nil
+}
@@ -0,0 +1,286 @@
+// +build linux
+
+package mount
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/alibaba/pouch/pkg/log"
+	"github.com/alibaba/pouch/storage/volume/driver"
+	"github.com/alibaba/pouch/storage/volume/types"
+
+	"github.com/docker/docker/pkg/mount"
+)
+
+var (
+	defaultDataPath = "/var/lib/pouch/volume"
+
+	// supportedTypes are the filesystem types could be mounted by driver.
+	supportedTypes = map[string]bool{"nfs": true, "cifs": true, "bind": true}
+)
+
+const (
+	optionType   = "type"
+	optionDevice = "device"
+	optionOpts   = "o"
+)
+
+func init() {
+	if err := driver.Register(&Mount{}); err != nil {
+		panic(err)
+	}
+}
+
+// Mount represents mount volume driver, which mounts the remote filesystem
+// or host directory when volume is attached by the first container, and
+// unmounts it when volume is detached by the last container.
+type Mount struct {
+	DataPath string
+}
+
+// Name returns mount volume driver's name.
+func (p *Mount) Name(ctx context.Context) string {
+	return "mount"
+}
+
+// StoreMode returns mount volume driver's store mode.
+func (p *Mount) StoreMode(ctx context.Context) driver.VolumeStoreMode {
+	return driver.LocalStore | driver.UseLocalMetaStore
+}
+
+// Create a mount volume.
+func (p *Mount) Create(ctx context.Context, id types.VolumeContext) (*types.Volume, error) {
+	log.With(ctx).Debugf("Mount create volume: %s", id.Name)
+
+	if _, _, _, err := parseMountOptions(id.Options); err != nil {
+		return nil, err
+	}
+
+	mountPath := p.mountPath(id.Name)
+	if err := os.MkdirAll(mountPath, 0755); err != nil {
+		return nil, fmt.Errorf("error creating %q directory: %v", mountPath, err)
+	}
+
+	return types.NewVolumeFromContext(mountPath, "", id), nil
+}
+
+// Remove a mount volume, the data on the remote filesystem or host
+// directory is kept.
+func (p *Mount) Remove(ctx context.Context, v *types.Volume) error {
+	log.With(ctx).Debugf("Mount remove volume: %s", v.Name)
+	mountPath := v.Path()
+
+	if err := unmount(mountPath); err != nil {
+		return err
+	}
+
+	if err := os.Remove(mountPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove %q directory failed, err: %v", mountPath, err)
+	}
+
+	return nil
+}
+
+// Path returns mount volume's path.
+func (p *Mount) Path(ctx context.Context, v *types.Volume) (string, error) {
+	log.With(ctx).Debugf("Mount volume mount path: %s", v.Name)
+
+	if mp := v.Path(); mp != "" {
+		return mp, nil
+	}
+
+	mountPath := p.mountPath(v.Name)
+	v.SetPath(mountPath)
+
+	return mountPath, nil
+}
+
+// Options returns mount volume's options.
+func (p *Mount) Options() map[string]types.Option {
+	return map[string]types.Option{
+		optionType:   {Value: "", Desc: "filesystem type to mount, nfs, cifs or bind"},
+		optionDevice: {Value: "", Desc: "device to mount, such as host:/export, //host/share or host directory"},
+		optionOpts:   {Value: "", Desc: "comma separated mount options, such as addr=host,vers=4,ro"},
+	}
+}
+
+// Config is used to pass the daemon volume configure for mount driver.
+func (p *Mount) Config(ctx context.Context, cfg map[string]interface{}) error {
+	p.DataPath = cfg["volume-meta-dir"].(string)
+
+	return nil
+}
+
+// Attach a mount volume, the filesystem is mounted if it is not mounted.
+func (p *Mount) Attach(ctx context.Context, v *types.Volume) error {
+	log.With(ctx).Debugf("Mount attach volume: %s, refcount: %s", v.Name, v.Option(types.OptionRefCount))
+	mountPath := v.Path()
+
+	mounted, err := mount.Mounted(mountPath)
+	if err != nil {
+		return fmt.Errorf("failed to check mountpoint %q, err: %v", mountPath, err)
+	}
+	if mounted {
+		return nil
+	}
+
+	fsType, device, opts, err := parseMountOptions(v.Options())
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(mountPath, 0755); err != nil {
+		return fmt.Errorf("error creating %q directory: %v", mountPath, err)
+	}
+
+	if err := mount.Mount(device, mountPath, fsType, opts); err != nil {
+		return fmt.Errorf("failed to mount %s %q on %q, err: %v", fsType, device, mountPath, err)
+	}
+
+	return nil
+}
+
+// Detach a mount volume, it is called when the last reference is released.
+func (p *Mount) Detach(ctx context.Context, v *types.Volume) error {
+	log.With(ctx).Debugf("Mount detach volume: %s", v.Name)
+	return unmount(v.Path())
+}
+
+// Usage returns the usage of mount volume is not available when it is not
+// mounted, otherwise the usage should be calculated by walking the volume.
+func (p *Mount) Usage(ctx context.Context, v *types.Volume) (int64, error) {
+	mounted, err := mount.Mounted(v.Path())
+	if err != nil {
+		return 0, fmt.Errorf("failed to check mountpoint %q, err: %v", v.Path(), err)
+	}
+	if !mounted {
+		return -1, nil
+	}
+	return 0, fmt.Errorf("usage of mount volume is not reported by driver")
+}
+
+// unmount unmounts the path if it is a mountpoint.
+func unmount(mountPath string) error {
+	mounted, err := mount.Mounted(mountPath)
+	if err != nil {
+		return fmt.Errorf("failed to check mountpoint %q, err: %v", mountPath, err)
+	}
+	if !mounted {
+		return nil
+	}
+
+	if err := mount.Unmount(mountPath); err != nil {
+		return fmt.Errorf("failed to umount %q, err: %v", mountPath, err)
+	}
+	return nil
+}
+
+func (p *Mount) mountPath(name string) string {
+	if p.DataPath != "" {
+		return path.Join(p.DataPath, name)
+	}
+	return path.Join(defaultDataPath, name)
+}
+
+// parseMountOptions returns the filesystem type, device and mount options
+// of volume. The address of server is resolved for nfs and cifs, since the
+// kernel does not resolve host name.
+func parseMountOptions(options map[string]string) (string, string, string, error) {
+	fsType := options[optionType]
+	device := options[optionDevice]
+	opts := options[optionOpts]
+
+	if !supportedTypes[fsType] {
+		return "", "", "", fmt.Errorf("invalid mount type %q, should be one of nfs, cifs or bind", fsType)
+	}
+	if device == "" {
+		return "", "", "", fmt.Errorf("device of mount volume cannot be empty")
+	}
+
+	switch fsType {
+	case "bind":
+		if !path.IsAbs(device) {
+			return "", "", "", fmt.Errorf("device %q of bind mount must be an absolute path", device)
+		}
+		if st, err := os.Stat(device); err != nil || !st.IsDir() {
+			return "", "", "", fmt.Errorf("device %q of bind mount must be an existing directory", device)
+		}
+
+		// the type of bind mount is ignored by kernel.
+		return "none", device, joinOptions("bind", opts), nil
+	case "nfs", "cifs":
+		addr, err := serverAddress(fsType, device, opts)
+		if err != nil {
+			return "", "", "", err
+		}
+		return fsType, device, replaceOption(opts, "addr", addr), nil
+	}
+
+	return fsType, device, opts, nil
+}
+
+// serverAddress returns the ip address of server, which is specified by
+// addr option or the host of device.
+func serverAddress(fsType, device, opts string) (string, error) {
+	host := optionValue(opts, "addr")
+	if host == "" {
+		if fsType == "nfs" {
+			// device format is host:/export
+			if i := strings.Index(device, ":/"); i > 0 {
+				host = device[:i]
+			}
+		} else {
+			// device format is //host/share
+			parts := strings.SplitN(strings.TrimPrefix(device, "//"), "/", 2)
+			if strings.HasPrefix(device, "//") && parts[0] != "" {
+				host = parts[0]
+			}
+		}
+	}
+	if host == "" {
+		return "", fmt.Errorf("failed to get server address of %s device %q, use addr option to specify it", fsType, device)
+	}
+
+	ip, err := net.ResolveIPAddr("ip", strings.Trim(host, "[]"))
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve server address %q: %v", host, err)
+	}
+	return ip.String(), nil
+}
+
+// optionValue returns the value of key in comma separated mount options.
+func optionValue(opts, key string) string {
+	for _, opt := range strings.Split(opts, ",") {
+		if kv := strings.SplitN(opt, "=", 2); len(kv) == 2 && kv[0] == key {
+			return kv[1]
+		}
+	}
+	return ""
+}
+
+// replaceOption sets the value of key in comma separated mount options.
+func replaceOption(opts, key, value string) string {
+	var res []string
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == "" || strings.HasPrefix(opt, key+"=") {
+			continue
+		}
+		res = append(res, opt)
+	}
+	return joinOptions(append(res, key+"="+value)...)
+}
+
+func joinOptions(opts ...string) string {
+	var res []string
+	for _, opt := range opts {
+		if opt != "" {
+			res = append(res, opt)
+		}
+	}
+	return strings.Join(res, ",")
+}
@@ -0,0 +1,89 @@
+// +build linux
+
+package mount
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMountOptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestParseMountOptions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name    string
+		options map[string]string
+		fsType  string
+		device  string
+		opts    string
+		wantErr bool
+	}{
+		{
+			name:    "bind",
+			options: map[string]string{"type": "bind", "device": dir, "o": "ro"},
+			fsType:  "none",
+			device:  dir,
+			opts:    "bind,ro",
+		},
+		{
+			name:    "bindNotExist",
+			options: map[string]string{"type": "bind", "device": dir + "/notexist"},
+			wantErr: true,
+		},
+		{
+			name:    "nfsAddrFromDevice",
+			options: map[string]string{"type": "nfs", "device": "127.0.0.1:/export", "o": "vers=4"},
+			fsType:  "nfs",
+			device:  "127.0.0.1:/export",
+			opts:    "vers=4,addr=127.0.0.1",
+		},
+		{
+			name:    "nfsIPv6",
+			options: map[string]string{"type": "nfs", "device": "[::1]:/export"},
+			fsType:  "nfs",
+			device:  "[::1]:/export",
+			opts:    "addr=::1",
+		},
+		{
+			name:    "cifsAddrOption",
+			options: map[string]string{"type": "cifs", "device": "//fileserver/share", "o": "addr=127.0.0.1,username=foo"},
+			fsType:  "cifs",
+			device:  "//fileserver/share",
+			opts:    "username=foo,addr=127.0.0.1",
+		},
+		{
+			name:    "nfsNoAddr",
+			options: map[string]string{"type": "nfs", "device": "export"},
+			wantErr: true,
+		},
+		{
+			name:    "unknownType",
+			options: map[string]string{"type": "ext4", "device": "/dev/sdb"},
+			wantErr: true,
+		},
+		{
+			name:    "emptyDevice",
+			options: map[string]string{"type": "nfs"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsType, device, opts, err := parseMountOptions(tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseMountOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, tt.fsType, fsType)
+			assert.Equal(t, tt.device, device)
+			assert.Equal(t, tt.opts, opts)
+		})
+	}
+}
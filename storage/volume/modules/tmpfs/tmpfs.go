@@ -165,3 +165,39 @@ func (p *Tmpfs) Detach(ctx context.Context, v *types.Volume) error {
 
 	return nil
 }
+
+// Resize changes the size of tmpfs volume, the mounted tmpfs is remounted
+// with the new size.
+func (p *Tmpfs) Resize(ctx context.Context, v *types.Volume, size string) error {
+	log.With(ctx).Debugf("Tmpfs resize volume: %s, size: %s", v.Name, size)
+	mountPath := v.Path()
+
+	if !utils.IsMountpoint(mountPath) {
+		return nil
+	}
+
+	err := syscall.Mount("shm", mountPath, "tmpfs",
+		uintptr(syscall.MS_REMOUNT|syscall.MS_NOEXEC|syscall.MS_NOSUID|syscall.MS_NODEV),
+		fmt.Sprintf("mode=1777,size=%s", size))
+	if err != nil {
+		return fmt.Errorf("remounting shm tmpfs: %s %v", mountPath, err)
+	}
+
+	return nil
+}
+
+// Usage returns the used size of tmpfs volume, the volume which is not
+// mounted has no data.
+func (p *Tmpfs) Usage(ctx context.Context, v *types.Volume) (int64, error) {
+	mountPath := v.Path()
+
+	if !utils.IsMountpoint(mountPath) {
+		return 0, nil
+	}
+
+	var stfs syscall.Statfs_t
+	if err := syscall.Statfs(mountPath, &stfs); err != nil {
+		return 0, fmt.Errorf("failed to statfs %q, err: %v", mountPath, err)
+	}
+	return int64(stfs.Blocks-stfs.Bfree) * int64(stfs.Bsize), nil
+}
@@ -10,6 +10,30 @@ var (
 	// OptionRef defines the reference of containers.
 	OptionRef = "ref"
 
+	// OptionRefCount defines the number of containers referencing the volume.
+	OptionRefCount = "refcount"
+
+	// OptionMountIDs defines the ids of containers for which the volume is
+	// mounted by driver.
+	OptionMountIDs = "mountids"
+
+	// OptionWriteBPS defines the write rate limit(bytes per second) of volume.
+	OptionWriteBPS = "wbps"
+
+	// OptionReadBPS defines the read rate limit(bytes per second) of volume.
+	OptionReadBPS = "rbps"
+
+	// OptionWriteIOPS defines the write rate limit(IO per second) of volume.
+	OptionWriteIOPS = "wiops"
+
+	// OptionReadIOPS defines the read rate limit(IO per second) of volume.
+	OptionReadIOPS = "riops"
+
+	// StateOptions defines the options written when volume is attached or
+	// detached, which record the state of volume instead of its config,
+	// including the users of tmpfs volume.
+	StateOptions = []string{OptionRef, OptionRefCount, OptionMountIDs, "ids", "reqID", "freeTime"}
+
 	// DefaultBackend defines the default volume backend.
 	DefaultBackend = "local"
 )
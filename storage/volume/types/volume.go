@@ -69,6 +69,9 @@ var (
 	VolumePhaseFailed VolumePhase = "Failed"
 )
 
+// VolumeReasonMissing represents the volume is not found in its driver.
+const VolumeReasonMissing = "Missing"
+
 // VolumeConfig represents volume config.
 type VolumeConfig struct {
 	Size       string `json:"size"`
@@ -135,6 +138,15 @@ func (v *Volume) Options() map[string]string {
 	return v.Spec.Extra
 }
 
+// References returns the containers referencing the volume.
+func (v *Volume) References() []string {
+	ref := v.Option(OptionRef)
+	if ref == "" {
+		return nil
+	}
+	return strings.Split(ref, ",")
+}
+
 // Driver return driver's name of the volume.
 func (v *Volume) Driver() string {
 	return v.Spec.Backend
@@ -200,6 +212,10 @@ type VolumeContext struct {
 	Driver  string
 	Options map[string]string
 	Labels  map[string]string
+
+	// MountID is the id of caller attaching or detaching volume, such as
+	// container id, the driver mounting volume for each caller uses it.
+	MountID string
 }
 
 // NewVolumeContext returns VolumeContext instance.
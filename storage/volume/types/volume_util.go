@@ -1,8 +1,11 @@
 package types
 
 import (
+	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/alibaba/pouch/pkg/bytefmt"
 	"github.com/alibaba/pouch/storage/volume/types/meta"
 
 	"github.com/pborman/uuid"
@@ -43,3 +46,39 @@ func NewVolumeFromContext(mountPath, size string, id VolumeContext) *Volume {
 
 	return v
 }
+
+// ParseIOLimits parses the io limits in volume options into config, the bps
+// limits can be human readable size, such as 10m.
+func ParseIOLimits(options map[string]string, config *VolumeConfig) error {
+	for _, limit := range []struct {
+		key   string
+		bytes bool
+		value *int64
+	}{
+		{key: OptionWriteBPS, bytes: true, value: &config.WriteBPS},
+		{key: OptionReadBPS, bytes: true, value: &config.ReadBPS},
+		{key: OptionWriteIOPS, value: &config.WriteIOPS},
+		{key: OptionReadIOPS, value: &config.ReadIOPS},
+	} {
+		s, ok := options[limit.key]
+		if !ok || s == "" {
+			continue
+		}
+
+		var (
+			v   uint64
+			err error
+		)
+		if limit.bytes {
+			v, err = bytefmt.ToBytes(s)
+		} else {
+			v, err = strconv.ParseUint(s, 10, 63)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid %s option %q: %v", limit.key, s, err)
+		}
+		*limit.value = int64(v)
+	}
+
+	return nil
+}
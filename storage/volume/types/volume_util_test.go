@@ -43,3 +43,28 @@ func TestNewVolumeFromContext(t *testing.T) {
 		}
 	}
 }
+
+func TestParseIOLimits(t *testing.T) {
+	config := &VolumeConfig{}
+	err := ParseIOLimits(map[string]string{
+		OptionWriteBPS:  "10m",
+		OptionReadBPS:   "1024",
+		OptionWriteIOPS: "200",
+	}, config)
+	if err != nil {
+		t.Fatalf("ParseIOLimits error: %v", err)
+	}
+	if config.WriteBPS != 10*1024*1024 || config.ReadBPS != 1024 || config.WriteIOPS != 200 || config.ReadIOPS != 0 {
+		t.Errorf("ParseIOLimits got unexpected config: %+v", config)
+	}
+
+	for _, options := range []map[string]string{
+		{OptionWriteBPS: "fast"},
+		{OptionReadIOPS: "-1"},
+		{OptionWriteIOPS: "1k"},
+	} {
+		if err := ParseIOLimits(options, &VolumeConfig{}); err == nil {
+			t.Errorf("ParseIOLimits(%v) expect error, but got nil", options)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package volume
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// usageWalkRate is the number of files could be visited per second when
+	// walking the volume, which reduces the io pressure on host.
+	usageWalkRate = 10000
+
+	// usageCacheTimeout is the time that the walked usage of volume is
+	// kept, the volume is not walked again in the period.
+	usageCacheTimeout = 30 * time.Second
+)
+
+// usageCache calculates the used size of volume directory by walking it,
+// the walks of all volumes share a rate limiter, and the results are cached.
+type usageCache struct {
+	sync.Mutex
+	limiter *rate.Limiter
+	entries map[string]usageEntry
+}
+
+type usageEntry struct {
+	size      int64
+	timestamp time.Time
+}
+
+func newUsageCache() *usageCache {
+	return &usageCache{
+		limiter: rate.NewLimiter(rate.Limit(usageWalkRate), usageWalkRate/10),
+		entries: map[string]usageEntry{},
+	}
+}
+
+// get returns the used size of volume, the cached size is returned if it
+// is not timeout.
+func (u *usageCache) get(ctx context.Context, name, dir string) (int64, error) {
+	u.Lock()
+	entry, ok := u.entries[name]
+	u.Unlock()
+	if ok && time.Since(entry.timestamp) < usageCacheTimeout {
+		return entry.size, nil
+	}
+
+	size, err := dirSize(ctx, dir, u.limiter)
+	if err != nil {
+		return 0, err
+	}
+
+	u.Lock()
+	u.entries[name] = usageEntry{size: size, timestamp: time.Now()}
+	u.Unlock()
+
+	return size, nil
+}
+
+// remove drops the cached size of volume.
+func (u *usageCache) remove(name string) {
+	u.Lock()
+	delete(u.entries, name)
+	u.Unlock()
+}
+
+// dirSize returns the total size of regular files in dir, the hard links
+// of same file are counted once.
+func dirSize(ctx context.Context, dir string, limiter *rate.Limiter) (int64, error) {
+	var size int64
+	inodes := map[uint64]struct{}{}
+
+	err := filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			// the file may be removed during the walk.
+			if os.IsNotExist(err) && p != dir {
+				return nil
+			}
+			return err
+		}
+
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		if st, ok := fi.Sys().(*syscall.Stat_t); ok && st.Nlink > 1 {
+			if _, exist := inodes[st.Ino]; exist {
+				return nil
+			}
+			inodes[st.Ino] = struct{}{}
+		}
+
+		size += fi.Size()
+		return nil
+	})
+
+	return size, err
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"os"
 	"strings"
 
 	"github.com/alibaba/pouch/test/command"
@@ -73,3 +74,35 @@ func (suite *PouchPullSuite) TestPullInWrongWay(c *check.C) {
 		c.Assert(res.Stderr(), check.NotNil)
 	}
 }
+
+// TestPullWithPlatform tests "pouch pull --platform" pulls the image of other
+// platform, which can be filtered by platform.
+func (suite *PouchPullSuite) TestPullWithPlatform(c *check.C) {
+	image := environment.BusyboxRepo + ":latest"
+
+	command.PouchRun("pull", "--platform", "linux/arm64", image).Assert(c, icmd.Success)
+	defer command.PouchRun("rmi", "-f", image)
+
+	output := command.PouchRun("image", "inspect", "-f", "{{.Os}}/{{.Architecture}}", image).Stdout()
+	c.Assert(strings.TrimSpace(output), check.Equals, "linux/arm64")
+
+	output = command.PouchRun("images", "--filter", "platform=linux/arm64").Stdout()
+	c.Assert(strings.Contains(output, image), check.Equals, true)
+
+	output = command.PouchRun("images", "--filter", "platform=linux/s390x").Stdout()
+	c.Assert(strings.Contains(output, image), check.Equals, false)
+
+	// the image can't be executed without qemu registered in binfmt_misc.
+	res := command.PouchRun("run", "--rm", "--platform", "linux/arm64", image, "true")
+	if _, err := os.Stat("/proc/sys/fs/binfmt_misc/qemu-aarch64"); err == nil {
+		res.Assert(c, icmd.Success)
+	} else {
+		c.Assert(res.Error, check.NotNil)
+		c.Assert(strings.Contains(res.Stderr(), "without binfmt_misc handler registered"), check.Equals, true)
+	}
+
+	command.PouchRun("pull", "--platform", "linux/foo/bar/baz", image).Assert(c, icmd.Expected{
+		ExitCode: 1,
+		Err:      "invalid platform",
+	})
+}
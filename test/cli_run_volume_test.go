@@ -78,6 +78,64 @@ func (suite *PouchRunVolumeSuite) TestRunWithTmpFSVolume(c *check.C) {
 	c.Assert(strings.Contains(res.Stdout(), "1.0M"), check.Equals, true)
 }
 
+// TestRunWithMount tests running container with structured mounts.
+func (suite *PouchRunVolumeSuite) TestRunWithMount(c *check.C) {
+	cname := "TestRunWithMount"
+
+	res := command.PouchRun("run", "--name", cname,
+		"--mount", "type=volume,source="+cname+",target=/data",
+		"--mount", "type=bind,source=/tmp,target=/host,readonly",
+		"--mount", "type=tmpfs,target=/cache,tmpfs-size=1m",
+		busyboxImage, "sh", "-c", "touch /data/test && df -h /cache && ! touch /host/"+cname)
+	defer func() {
+		DelContainerForceMultyTime(c, cname)
+		command.PouchRun("volume", "rm", cname)
+	}()
+	res.Assert(c, icmd.Success)
+
+	c.Assert(strings.Contains(res.Stdout(), "1.0M"), check.Equals, true)
+
+	icmd.RunCommand("stat",
+		DefaultVolumeMountPath+"/"+cname+"/test").Assert(c, icmd.Success)
+}
+
+// TestRunWithVolumeSubpath tests mounting the subdirectory of volume.
+func (suite *PouchRunVolumeSuite) TestRunWithVolumeSubpath(c *check.C) {
+	cname := "TestRunWithVolumeSubpath"
+
+	command.PouchRun("volume", "create", "--name", cname).Assert(c, icmd.Success)
+	defer func() {
+		command.PouchRun("volume", "rm", cname)
+	}()
+
+	res := command.PouchRun("run", "--name", cname,
+		"-v", cname+":/data:subpath=logs/app",
+		"--mount", "type=volume,source="+cname+",target=/usr,volume-subpath=conf,volume-nocopy",
+		busyboxImage, "sh", "-c", "touch /data/test && ls /usr")
+	defer DelContainerForceMultyTime(c, cname)
+	res.Assert(c, icmd.Success)
+
+	// the content of image is not copied with nocopy.
+	c.Assert(strings.TrimSpace(res.Stdout()), check.Equals, "")
+
+	icmd.RunCommand("stat",
+		DefaultVolumeMountPath+"/"+cname+"/logs/app/test").Assert(c, icmd.Success)
+	icmd.RunCommand("stat",
+		DefaultVolumeMountPath+"/"+cname+"/conf").Assert(c, icmd.Success)
+}
+
+// TestRunWithMountConflictBinds tests the mount conflicting with binds.
+func (suite *PouchRunVolumeSuite) TestRunWithMountConflictBinds(c *check.C) {
+	cname := "TestRunWithMountConflictBinds"
+
+	res := command.PouchRun("run", "--name", cname, "-v", "/tmp:/data",
+		"--mount", "type=tmpfs,target=/data", busyboxImage, "true")
+	defer DelContainerForceMultyTime(c, cname)
+
+	c.Assert(res.ExitCode, check.Not(check.Equals), 0)
+	c.Assert(strings.Contains(res.Stderr(), "specified in both Binds and Mounts"), check.Equals, true)
+}
+
 //TestRunWithVolumeCopyData tests binds copying data
 //Pouch volumes should copy data, but host bind mount should not.
 func (suite *PouchRunVolumeSuite) TestRunWithVolumeCopyData(c *check.C) {
@@ -417,6 +475,35 @@ func (suite *PouchRunVolumeSuite) TestRunWithDiskQuota(c *check.C) {
 	c.Assert(found, check.Equals, true)
 }
 
+// TestRunWithDiskQuotaInodes tests running container with inode limit, and
+// the usage of disk quota is reported in inspect.
+func (suite *PouchRunVolumeSuite) TestRunWithDiskQuotaInodes(c *check.C) {
+	if !environment.IsDiskQuota() {
+		c.Skip("Host does not support disk quota")
+	}
+
+	cname := "TestRunWithDiskQuotaInodes"
+	ret := command.PouchRun("run", "-d", "--disk-quota", "/=2000m", "--disk-quota", "inodes=10000",
+		"--name", cname, busyboxImage, "top")
+
+	defer DelContainerForceMultyTime(c, cname)
+	ret.Assert(c, icmd.Success)
+
+	ctr, err := apiClient.ContainerGet(context.Background(), cname)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(ctr.DiskQuotaUsage), check.Equals, 1)
+
+	usage := ctr.DiskQuotaUsage[0]
+	c.Assert(usage.Destinations, check.DeepEquals, []string{"/"})
+	c.Assert(usage.HardLimit, check.Equals, uint64(2000*1024*1024))
+	c.Assert(usage.InodesHardLimit, check.Equals, uint64(10000))
+	c.Assert(usage.Inodes > 0, check.Equals, true)
+
+	// invalid inode limit
+	command.PouchRun("run", "--disk-quota", "/=2000m", "--disk-quota", "inodes=foo",
+		busyboxImage, "true").Assert(c, icmd.Expected{ExitCode: 1, Err: "inodes(foo) must be a positive integer"})
+}
+
 func (suite *PouchRunVolumeSuite) TestRunCopyDataWithDR(c *check.C) {
 	cname := "TestRunCopyDataWithDR_Container"
 	vname := "TestRunCopyDataWithDR_Volume"
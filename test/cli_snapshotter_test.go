@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/alibaba/pouch/test/environment"
@@ -129,6 +130,66 @@ func (suite *PouchSnapshotterSuite) TestAllowMultiSnapshotter(c *check.C) {
 	dcfg.KillDaemon()
 }
 
+// TestImageUnpackWithoutMultiSnapshotter tests unpacking image into other snapshotter
+// is not allowed if multiple snapshotters are not allowed.
+func (suite *PouchSnapshotterSuite) TestImageUnpackWithoutMultiSnapshotter(c *check.C) {
+	dcfg, err := StartDefaultDaemon("--snapshotter", "overlayfs")
+	c.Assert(err, check.IsNil)
+
+	defer dcfg.KillDaemon()
+
+	result := RunWithSpecifiedDaemon(dcfg, "pull", busyboxImage)
+	c.Assert(result.ExitCode, check.Equals, 0)
+	// clean busybox image
+	defer RunWithSpecifiedDaemon(dcfg, "rmi", busyboxImage)
+
+	result = RunWithSpecifiedDaemon(dcfg, "image", "unpack", busyboxImage)
+	c.Assert(result.ExitCode, check.Equals, 0)
+
+	result = RunWithSpecifiedDaemon(dcfg, "image", "unpack", "--snapshotter", "native", busyboxImage)
+	c.Assert(result.ExitCode, check.Not(check.Equals), 0)
+	c.Assert(result.Stderr(), check.Matches, "(?s).*multiple snapshotters are not allowed.*")
+}
+
+// TestContainerMigrateSnapshot tests moving the writable layer of container to other snapshotter.
+func (suite *PouchSnapshotterSuite) TestContainerMigrateSnapshot(c *check.C) {
+	dcfg, err := StartDefaultDaemon("--snapshotter", "overlayfs", "--allow-multi-snapshotter")
+	c.Assert(err, check.IsNil)
+
+	defer dcfg.KillDaemon()
+
+	result := RunWithSpecifiedDaemon(dcfg, "pull", busyboxImage)
+	c.Assert(result.ExitCode, check.Equals, 0)
+	// clean busybox image
+	defer RunWithSpecifiedDaemon(dcfg, "rmi", busyboxImage)
+
+	name := "TestContainerMigrateSnapshot"
+	result = RunWithSpecifiedDaemon(dcfg, "run", "--name", name, busyboxImage, "sh", "-c", "cat /data.txt 2>/dev/null || echo hello > /data.txt")
+	c.Assert(result.ExitCode, check.Equals, 0)
+	defer RunWithSpecifiedDaemon(dcfg, "rm", "-f", name)
+
+	result = RunWithSpecifiedDaemon(dcfg, "container", "migrate-snapshot", "--snapshotter", "native", name)
+	c.Assert(result.ExitCode, check.Equals, 0, check.Commentf("stderr: %s", result.Stderr()))
+
+	result = RunWithSpecifiedDaemon(dcfg, "inspect", "-f", "{{.Snapshotter.Name}}", name)
+	c.Assert(result.ExitCode, check.Equals, 0)
+	c.Assert(strings.TrimSpace(result.Stdout()), check.Equals, "native")
+
+	// the image has been unpacked into native snapshotter.
+	names, err := checkSnapshotsDir(dcfg.HomeDir, "native")
+	c.Assert(err, check.IsNil)
+	c.Assert(len(names) > 0, check.Equals, true)
+
+	// the changes of writable layer are kept.
+	result = RunWithSpecifiedDaemon(dcfg, "start", "-a", name)
+	c.Assert(result.ExitCode, check.Equals, 0)
+	c.Assert(strings.TrimSpace(result.Stdout()), check.Equals, "hello")
+
+	result = RunWithSpecifiedDaemon(dcfg, "info")
+	c.Assert(result.ExitCode, check.Equals, 0)
+	c.Assert(result.Stdout(), check.Matches, "(?s).*Snapshotters:.* native: status=ok.*")
+}
+
 // checkSnapshotsDir returns snapshots directory names by given snapshotter name
 func checkSnapshotsDir(homeDir string, snapshotter string) ([]string, error) {
 	const snapshotterPrefix = "io.containerd.snapshotter.v1."
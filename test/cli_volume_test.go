@@ -158,6 +158,107 @@ func (suite *PouchVolumeSuite) TestVolumeUsingByContainer(c *check.C) {
 	command.PouchRun("volume", "rm", volumeName).Assert(c, icmd.Success)
 }
 
+// TestVolumeMountDriverBind tests the mount driver mounts the device when
+// the first container attaches and unmounts it when the last one detaches.
+func (suite *PouchVolumeSuite) TestVolumeMountDriverBind(c *check.C) {
+	funcname := "TestVolumeMountDriverBind"
+	volumeName := "volume_" + funcname
+	device := "/tmp/" + funcname
+
+	icmd.RunCommand("mkdir", "-p", device).Assert(c, icmd.Success)
+	defer icmd.RunCommand("rm", "-rf", device)
+	icmd.RunCommand("touch", device+"/data").Assert(c, icmd.Success)
+
+	command.PouchRun("volume", "create", "--name", volumeName, "-d", "mount", "-o", "type=bind", "-o", "device="+device).Assert(c, icmd.Success)
+	defer command.PouchRun("volume", "rm", volumeName)
+
+	mountpoint := DefaultVolumeMountPath + "/" + volumeName
+
+	command.PouchRun("run", "-d", "-v", volumeName+":/mnt", "--name", funcname+"1", busyboxImage, "top").Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, funcname+"1")
+	command.PouchRun("run", "-d", "-v", volumeName+":/mnt", "--name", funcname+"2", busyboxImage, "top").Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, funcname+"2")
+
+	command.PouchRun("exec", funcname+"1", "ls", "/mnt/data").Assert(c, icmd.Success)
+	icmd.RunCommand("mountpoint", mountpoint).Assert(c, icmd.Success)
+
+	res := command.PouchRun("volume", "inspect", "-f", "{{.Status.refcount}}", volumeName)
+	res.Assert(c, icmd.Success)
+	c.Assert(strings.TrimSpace(res.Stdout()), check.Equals, "2")
+
+	// the device is still mounted until the last container detaches.
+	command.PouchRun("rm", "-f", funcname+"1").Assert(c, icmd.Success)
+	icmd.RunCommand("mountpoint", mountpoint).Assert(c, icmd.Success)
+
+	command.PouchRun("rm", "-f", funcname+"2").Assert(c, icmd.Success)
+	c.Assert(icmd.RunCommand("mountpoint", mountpoint).ExitCode, check.Not(check.Equals), 0)
+
+	command.PouchRun("volume", "rm", volumeName).Assert(c, icmd.Success)
+	icmd.RunCommand("stat", device+"/data").Assert(c, icmd.Success)
+}
+
+// TestVolumeBackupRestoreClone tests backup, restore and clone a volume.
+func (suite *PouchVolumeSuite) TestVolumeBackupRestoreClone(c *check.C) {
+	funcname := "TestVolumeBackupRestoreClone"
+	volumeName := "volume_" + funcname
+	cloneName := volumeName + "_clone"
+	backup := "/tmp/" + funcname + ".tar"
+
+	command.PouchRun("volume", "create", "--name", volumeName, "-o", "opt.size=1g").Assert(c, icmd.Success)
+	defer command.PouchRun("volume", "rm", volumeName)
+	defer icmd.RunCommand("rm", "-f", backup)
+
+	command.PouchRun("run", "-d", "-v", volumeName+":/mnt", "--name", funcname, busyboxImage, "top").Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, funcname)
+	command.PouchRun("exec", funcname, "sh", "-c", "echo hello > /mnt/data && chown 1000:1000 /mnt/data").Assert(c, icmd.Success)
+
+	command.PouchRun("volume", "backup", "--pause", "-o", backup, volumeName).Assert(c, icmd.Success)
+	res := command.PouchRun("ps", "-a", "--filter", "name="+funcname)
+	res.Assert(c, icmd.Success)
+	c.Assert(strings.Contains(res.Stdout(), "Paused"), check.Equals, false)
+
+	command.PouchRun("exec", funcname, "rm", "/mnt/data").Assert(c, icmd.Success)
+	command.PouchRun("volume", "restore", "-i", backup, volumeName).Assert(c, icmd.Success)
+	command.PouchRun("exec", funcname, "grep", "hello", "/mnt/data").Assert(c, icmd.Success)
+
+	// the volume mounted read-write by running container can't be cloned
+	// without pausing the container.
+	res = command.PouchRun("volume", "clone", volumeName, cloneName)
+	c.Assert(res.ExitCode, check.Not(check.Equals), 0)
+
+	command.PouchRun("volume", "clone", "--pause", volumeName, cloneName).Assert(c, icmd.Success)
+	defer command.PouchRun("volume", "rm", cloneName)
+
+	res = command.PouchRun("run", "--rm", "-v", cloneName+":/mnt", busyboxImage, "stat", "-c", "%u:%g", "/mnt/data")
+	res.Assert(c, icmd.Success)
+	c.Assert(strings.TrimSpace(res.Stdout()), check.Equals, "1000:1000")
+
+	// clone to an existing volume fails.
+	res = command.PouchRun("volume", "clone", "--pause", volumeName, cloneName)
+	c.Assert(res.ExitCode, check.Not(check.Equals), 0)
+}
+
+// TestVolumeUsage tests the usage data of volume.
+func (suite *PouchVolumeSuite) TestVolumeUsage(c *check.C) {
+	funcname := "TestVolumeUsage"
+	volumeName := "volume_" + funcname
+
+	command.PouchRun("volume", "create", "--name", volumeName).Assert(c, icmd.Success)
+	defer command.PouchRun("volume", "rm", volumeName)
+
+	command.PouchRun("run", "-d", "-v", volumeName+":/mnt", "--name", funcname, busyboxImage, "top").Assert(c, icmd.Success)
+	defer DelContainerForceMultyTime(c, funcname)
+	command.PouchRun("exec", funcname, "dd", "if=/dev/zero", "of=/mnt/data", "bs=1k", "count=1024").Assert(c, icmd.Success)
+
+	res := command.PouchRun("volume", "inspect", "-f", "{{.UsageData.Size}} {{.UsageData.RefCount}}", volumeName)
+	res.Assert(c, icmd.Success)
+	c.Assert(strings.TrimSpace(res.Stdout()), check.Equals, "1048576 1")
+
+	res = command.PouchRun("volume", "ls", "--filter", "name="+volumeName, "--format", "{{.Name}} {{.UsageData.RefCount}}")
+	res.Assert(c, icmd.Success)
+	c.Assert(strings.TrimSpace(res.Stdout()), check.Equals, volumeName+" 1")
+}
+
 // TestVolumePluginUsingByContainer tests creating container using the plugin volume.
 func (suite *PouchVolumeSuite) TestVolumePluginUsingByContainer(c *check.C) {
 	funcname := "TestVolumePluginUsingByContainer"